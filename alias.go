@@ -0,0 +1,136 @@
+package enum
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// AddAlias registers alternate names for the entry with the given name. Aliases are
+// accepted by Parse, which returns the entry under its canonical name, but they do not
+// appear in Names, Values, or the JSON forms. It is thread-safe, using a write lock to
+// protect state modifications.
+//
+// Panics if the name does not exist, or if an alias is already used as a name or alias.
+// Use TryAddAlias to receive these conditions as an error.
+//
+// Example:
+//
+//	g := Weekdays()
+//	g.AddAlias("Wednesday", "Midweek")
+//	v, _ := g.Parse("Midweek") // Value[int]{value: 3, name: "Wednesday"}
+func (g *Generator[T]) AddAlias(name string, aliases ...string) {
+	if err := g.TryAddAlias(name, aliases...); err != nil {
+		g.raise(err)
+	}
+}
+
+// Aliases returns the aliases registered for the entry with the given name,
+// sorted alphabetically. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Aliases(name string) []string {
+	g.rlock()
+	defer g.runlock()
+	val, ok := g.aliasTargetLocked(name)
+	if !ok {
+		return nil
+	}
+	var out []string
+	for alias, v := range g.aliases {
+		if v == val {
+			out = append(out, alias)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TryAddAlias is like AddAlias but returns an error instead of panicking.
+// No alias is registered unless all of them are valid.
+func (g *Generator[T]) TryAddAlias(name string, aliases ...string) error {
+	err := g.lockValidated(context.Background(), func() []Pair[T] {
+		val, ok := g.aliasTargetLocked(name)
+		if !ok {
+			return nil
+		}
+		pairs := make([]Pair[T], len(aliases))
+		for i, alias := range aliases {
+			pairs[i] = Pair[T]{Name: alias, Value: val}
+		}
+		return pairs
+	})
+	if err != nil {
+		return err
+	}
+	defer g.unlock()
+
+	val, ok := g.aliasTargetLocked(name)
+	if !ok {
+		return fmt.Errorf("enum: cannot alias unknown name %q", name)
+	}
+	return g.addAliasLocked(name, val, aliases)
+}
+
+// aliasTargetLocked returns the value of the entry name, which for an overlay may be an
+// entry of the parent. The caller must hold the lock.
+func (g *Generator[T]) aliasTargetLocked(name string) (T, bool) {
+	if val, ok := g.nameMap[name]; ok {
+		return val, true
+	}
+	if g.parent != nil {
+		v, ok := g.parentName(name, false)
+		return v.value, ok
+	}
+	var zero T
+	return zero, false
+}
+
+// canonicalLocked returns the canonical name of val, the target of an alias, which for
+// an overlay may be an entry of the parent. The caller must hold the read lock.
+func (g *Generator[T]) canonicalLocked(val T) string {
+	if name, ok := g.valueMap[val]; ok {
+		return name
+	}
+	return g.parentCanonical(val)
+}
+
+// addAliasLocked implements TryAddAlias for the entry name with value val. The caller
+// must hold the write lock.
+func (g *Generator[T]) addAliasLocked(name string, val T, aliases []string) error {
+	seen := make(map[string]bool, len(aliases))
+	matched := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		if existing, exists := g.nameMap[alias]; exists {
+			return fmt.Errorf("enum: alias %q already exists as a name with value %s", alias, g.formatValue(existing))
+		}
+		if existing, exists := g.aliases[alias]; exists {
+			return fmt.Errorf("enum: alias %q already exists for %q", alias, g.valueMap[existing])
+		}
+		if seen[alias] {
+			return fmt.Errorf("enum: alias %q is repeated", alias)
+		}
+		if err := g.checkName(alias); err != nil {
+			return err
+		}
+		if err := g.checkParentName(alias); err != nil {
+			return err
+		}
+		if err := g.checkLiteralLocked(alias, val); err != nil {
+			return err
+		}
+		if err := g.checkMatchLocked(alias, "", matched); err != nil {
+			return err
+		}
+		seen[alias] = true
+	}
+
+	if g.aliases == nil {
+		g.aliases = make(map[string]T, len(aliases))
+	}
+	for _, alias := range aliases {
+		g.aliases[alias] = val
+		g.indexName(alias)
+	}
+	g.journalLocked(journalLine{Op: "alias", Name: name, Aliases: aliases}, val)
+	g.bump()
+	return nil
+}
@@ -0,0 +1,64 @@
+package enum
+
+import "unsafe"
+
+const (
+	// valuesChunk is the size beyond which the values slice doubles when full, rather than
+	// growing by append's smaller factor, so that populating a large Generator without
+	// WithCapacityHint reallocates and copies only a few times.
+	valuesChunk = 1024
+
+	// arenaChunk is the size of the byte buffers names are copied into under WithNameArena.
+	arenaChunk = 64 << 10
+)
+
+// WithNameArena makes the Generator copy the names passed to Next into large shared
+// byte buffers instead of keeping the caller's strings. When a Generator is populated
+// with many names built on the fly (e.g., 500k interned identifiers), this replaces one
+// small heap object per name with one object per 64 KiB of names, reducing GC work.
+// Behavior is otherwise unchanged.
+//
+// Buffers are never compacted, so the bytes of removed or renamed names stay allocated
+// until the Generator is garbage collected. Prefer it for large, append-mostly sets.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithCapacityHint[int](500_000), WithNameArena[int]())
+func WithNameArena[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.arena = &nameArena{}
+	}
+}
+
+// nameArena stores strings contiguously in append-only chunks.
+type nameArena struct {
+	buf []byte
+}
+
+// intern returns a copy of s backed by the arena. The caller must hold the write lock.
+func (a *nameArena) intern(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	if len(s) > arenaChunk/4 {
+		return string([]byte(s)) // Large names get their own allocation.
+	}
+	if len(a.buf)+len(s) > cap(a.buf) {
+		a.buf = make([]byte, 0, arenaChunk)
+	}
+	start := len(a.buf)
+	a.buf = append(a.buf, s...)
+	// The bytes are never modified once appended, so the string stays valid and immutable.
+	return unsafe.String(&a.buf[start], len(s))
+}
+
+// appendValue appends entry to the values slice, doubling it when full once it holds
+// valuesChunk entries. The caller must hold the write lock.
+func (g *Generator[T]) appendValue(entry Value[T]) {
+	if n := len(g.values); n == cap(g.values) && n >= valuesChunk {
+		values := make([]Value[T], n, 2*n)
+		copy(values, g.values)
+		g.values = values
+	}
+	g.values = append(g.values, entry)
+}
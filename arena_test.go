@@ -0,0 +1,88 @@
+package enum
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+func TestGenerator_WithNameArena(t *testing.T) {
+	g := NewGenerator[int](WithNameArena[int]())
+	buf := []byte("Name")
+	for i := 0; i < 3000; i++ {
+		g.Next(string(strconv.AppendInt(buf[:4], int64(i), 10)))
+	}
+	long := string(make([]byte, arenaChunk))
+	g.Next(long)
+
+	if g.Len() != 3001 {
+		t.Fatalf("Expected 3001 entries, got %d", g.Len())
+	}
+	for _, i := range []int{0, 1500, 2999} {
+		name := fmt.Sprintf("Name%d", i)
+		if v, err := g.Parse(name); err != nil || v.Get() != i || v.String() != name {
+			t.Errorf("Parse(%q): got %v, err: %v", name, v, err)
+		}
+	}
+	if name, _ := g.Name(3000); name != long {
+		t.Error("Expected long name to be stored intact")
+	}
+	if err := g.CheckConsistency(); err != nil {
+		t.Error(err)
+	}
+
+	c := g.Clone()
+	c.Next("Extra")
+	if !c.ContainsName("Name42") || !c.ContainsName("Extra") || g.ContainsName("Extra") {
+		t.Error("Expected clone to keep names and allocate new ones separately")
+	}
+}
+
+func TestGenerator_ValuesGrowth(t *testing.T) {
+	g := NewGenerator[int]()
+	prev := 0
+	for i := 0; i < 8*valuesChunk; i++ {
+		g.Next(strconv.Itoa(i))
+		if c := cap(g.values); c != prev {
+			if prev >= valuesChunk && c != 2*prev {
+				t.Fatalf("Expected capacity to double from %d, got %d", prev, c)
+			}
+			prev = c
+		}
+	}
+	if prev < 8*valuesChunk {
+		t.Errorf("Expected capacity of at least %d, got %d", 8*valuesChunk, prev)
+	}
+}
+
+func benchmarkPopulate500k(b *testing.B, opts ...Option[int]) {
+	const n = 500_000
+	raw := make([][]byte, n)
+	for i := range raw {
+		raw[i] = []byte("Identifier" + strconv.Itoa(i))
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	var live runtime.MemStats
+	for i := 0; i < b.N; i++ {
+		g := NewGenerator[int](opts...)
+		for _, name := range raw {
+			g.Next(string(name)) // Names decoded on the fly, as from a file or the network.
+		}
+		b.StopTimer()
+		runtime.GC()
+		runtime.ReadMemStats(&live)
+		runtime.KeepAlive(g)
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(live.HeapObjects), "live-objects")
+}
+
+func BenchmarkGenerator_Populate500k(b *testing.B) {
+	b.Run("Default", func(b *testing.B) { benchmarkPopulate500k(b) })
+	b.Run("WithHint", func(b *testing.B) { benchmarkPopulate500k(b, WithCapacityHint[int](500_000)) })
+	b.Run("WithHintAndArena", func(b *testing.B) {
+		benchmarkPopulate500k(b, WithCapacityHint[int](500_000), WithNameArena[int]())
+	})
+}
@@ -1,28 +1,33 @@
 package enum
 
 import (
+	"bytes"
+	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 )
 
-// Basic represents a simple, integer-based enum value with a name and a centralized
-// registry for managing all values of a given enum set. It is designed for
+// Basic represents a simple, integer-based enum value with a name, belonging to a
+// BasicRegistry that manages all values of a given enum set. It is designed for
 // straightforward enum definitions where integer values are automatically assigned
 // (starting from 0) or customized via With. Unlike Value[T], Basic is not generic,
 // offering better performance for integer-based enums by avoiding generics overhead.
 // It supports JSON marshaling/unmarshaling and SQL driver integration for seamless
 // use in serialization and database operations.
 //
-// Basic is thread-safe, using Generator[int] internally to manage value-to-name and
-// name-to-value mappings. It is ideal for simple enums (e.g., status codes) where
-// type safety across different enum sets is less critical than ease of use.
+// A Basic is a value: obtain it from its registry (Add, Parse, Values, or Empty for
+// one to decode into). The zero Basic has no registry; String and Get work on it, but
+// Validate, UnmarshalJSON, and Scan return errors wrapping ErrNilRegistry. Like Value,
+// the zero Basic and Empty are unset (see IsSet) and marshal to JSON null; tag struct
+// fields `json:",omitzero"` to omit them instead.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending") // value: 0
 //	active := b.Add("Active")   // value: 1
 //	fmt.Println(pending.Get(), pending.String()) // Output: 0, "Pending"
@@ -32,49 +37,211 @@ type Basic struct {
 	name  string          // Human-readable name of the enum value.
 	value int             // Integer value of the enum.
 	meta  *Generator[int] // Internal registry for value-to-name mappings.
+	set   bool            // Whether the value holds an entry; see IsSet.
 }
 
-// NewBasic creates a new enum registry for Basic values. It initializes a Generator[int]
-// with automatic numbering starting from 0. Each call to Add on the returned Basic
-// instance creates a new enum value with the next sequential integer.
+// BasicRegistry is the registry of a Basic enum set, created by NewBasicRegistry. It defines
+// values with Add and resolves them with Parse. It is thread-safe, using Generator[int]
+// internally to manage value-to-name and name-to-value mappings.
+//
+// A BasicRegistry is a handle: copies of it share the same underlying Generator, whose
+// copy check still applies, so copying the struct is safe.
+type BasicRegistry struct {
+	meta *Generator[int] // Shared by all values created from the registry.
+}
+
+// NewBasicRegistry creates a new enum registry for Basic values. It initializes a
+// Generator[int] with automatic numbering starting from 0. Each call to Add on the
+// returned registry creates a new enum value with the next sequential integer.
 //
-// Returns a Basic instance ready to define enum values via Add or With.
+// Optional Generator options (e.g., WithLabel, WithLogger) configure the registry.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending") // value: 0
 //	active := b.Add("Active")   // value: 1
-func NewBasic() *Basic {
-	// Note: We use a pointer here for the initial instance so that the `meta`
-	// field can be shared across all enum values created from it.
-	return &Basic{
-		meta: NewNumeric[int](0),
+func NewBasicRegistry(opts ...Option[int]) *BasicRegistry {
+	return &BasicRegistry{
+		meta: NewGenerator[int](append([]Option[int]{WithStart(0)}, opts...)...),
 	}
 }
 
+// NewBasic creates a new enum registry for Basic values, returned as a *Basic whose
+// deprecated Add, Values, and FromValue methods reach the registry.
+//
+// Deprecated: Use NewBasicRegistry, whose *BasicRegistry separates the registry from
+// its values and accepts options.
+func NewBasic() *Basic {
+	return &Basic{meta: NewBasicRegistry().meta}
+}
+
 // Add defines a new enum value with the given name, automatically assigning the next
 // sequential integer value (starting from 0 for the first value). It updates the
 // internal registry to map the value to the name and vice versa.
 //
 // Returns a new Basic instance representing the enum value.
 //
-// Panics if the name is already used in the registry.
+// Panics if the name is already used in the registry. Use TryAdd to receive this
+// condition as an error.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending") // value: 0
 //	active := b.Add("Active")   // value: 1
 //	// b.Add("Pending") // Panics because the name is already used.
-func (e *Basic) Add(name string) Basic {
-	// The underlying Generator's Next() method is thread-safe.
-	v := e.meta.Next(name)
+func (r *BasicRegistry) Add(name string) Basic {
+	e, err := r.TryAdd(name)
+	if err != nil {
+		r.meta.raise(err)
+	}
+	return e
+}
+
+// TryAdd is like Add but returns an error instead of panicking.
+func (r *BasicRegistry) TryAdd(name string) (Basic, error) {
+	// The underlying Generator's TryNext() method is thread-safe.
+	v, err := r.meta.TryNext(name)
+	if err != nil {
+		return Basic{}, err
+	}
 	return Basic{
-		name:  v.String(),
+		name:  v.name,
 		value: v.Get(),
-		meta:  e.meta,
+		meta:  r.meta,
+		set:   true,
+	}, nil
+}
+
+// Values returns a slice of all enum values in the registry.
+// Each value is a Basic instance belonging to the registry.
+//
+// Example:
+//
+//	b := NewBasicRegistry()
+//	b.Add("Pending")
+//	b.Add("Active")
+//	values := b.Values() // Returns [{name: "Pending", value: 0}, {name: "Active", value: 1}]
+func (r *BasicRegistry) Values() []Basic {
+	values := r.meta.Values()
+	result := make([]Basic, len(values))
+	for i, v := range values {
+		result[i] = Basic{name: v.name, value: v.Get(), meta: r.meta, set: true}
+	}
+	return result
+}
+
+// Parse resolves a name, alias, or integer literal to the matching Basic value.
+// Returns an error wrapping ErrUnknownValue if nothing matches.
+//
+// Example:
+//
+//	b := NewBasicRegistry()
+//	b.Add("Pending")
+//	v, err := b.Parse("Pending") // Returns Basic{name: "Pending", value: 0}
+func (r *BasicRegistry) Parse(s string) (Basic, error) {
+	v, err := r.meta.Parse(s)
+	if err != nil {
+		if errors.Is(err, ErrUnknownValue) {
+			return Basic{}, err
+		}
+		return Basic{}, fmt.Errorf("%w: %s: %w", ErrUnknownValue, s, err)
+	}
+	return Basic{name: v.name, value: v.Get(), meta: r.meta, set: true}, nil
+}
+
+// Empty returns a Basic with no value that belongs to the registry, ready to be
+// decoded into with UnmarshalJSON or Scan.
+//
+// Example:
+//
+//	b := NewBasicRegistry()
+//	b.Add("Pending")
+//	e := b.Empty()
+//	err := json.Unmarshal([]byte("0"), &e) // Sets e to {name: "Pending", value: 0}
+func (r *BasicRegistry) Empty() Basic {
+	return Basic{meta: r.meta}
+}
+
+// FromValue creates a Basic instance from a Value[int], adding it to the registry.
+// This is a convenience method that chains Add() and With().
+//
+// Panics if the value or name already exists in the registry, or if the name is
+// rejected by NewValueChecked. Use TryFromValue to receive these conditions as an error.
+//
+// Example:
+//
+//	v := NewValue(10, "Pending")
+//	b := NewBasicRegistry()
+//	pending := b.FromValue(v) // Returns Basic{name: "Pending", value: 10}
+func (r *BasicRegistry) FromValue(v Value[int]) Basic {
+	e, err := r.TryFromValue(v)
+	if err != nil {
+		r.meta.raise(err)
+	}
+	return e
+}
+
+// TryFromValue is like FromValue but returns an error instead of panicking. Nothing is
+// added to the registry on error.
+func (r *BasicRegistry) TryFromValue(v Value[int]) (Basic, error) {
+	entry, err := r.meta.addValue(v.name, v.Get())
+	if err != nil {
+		return Basic{}, err
+	}
+	return Basic{name: entry.name, value: entry.value, meta: r.meta, set: true}, nil
+}
+
+// addValue adds name with the given value rather than the next in the sequence,
+// consuming a sequence slot as Next does. It implements BasicRegistry.TryFromValue and
+// Typed.TryAddValue.
+func (g *Generator[T]) addValue(name string, value T) (Value[T], error) {
+	if _, err := NewValueChecked(value, name); err != nil {
+		return Value[T]{}, err
+	}
+	if err := g.checkName(name); err != nil {
+		return Value[T]{}, err
+	}
+	if err := g.validate(context.Background(), name, value); err != nil {
+		return Value[T]{}, err
+	}
+	g.lock()
+	defer g.unlock()
+	if existing, exists := g.nameMap[name]; exists {
+		return Value[T]{}, fmt.Errorf("enum: name %q already exists with value %s", name, g.formatValue(existing))
+	}
+	if existing, ok := g.valueMap[value]; ok {
+		return Value[T]{}, fmt.Errorf("enum: value %s already used for %q", g.formatValue(value), existing)
+	}
+	if err := g.checkLiteralLocked(name, value); err != nil {
+		return Value[T]{}, err
+	}
+	if err := g.checkMatchLocked(name, "", nil); err != nil {
+		return Value[T]{}, err
+	}
+	if err := g.checkParent(name, value); err != nil {
+		return Value[T]{}, err
+	}
+	g.advance() // Consume a sequence slot, as Add does.
+	if g.arena != nil {
+		name = g.arena.intern(name)
+	}
+	entry := g.entry(value, name)
+	g.appendValue(entry)
+	g.addName(value, name)
+	g.nameMap[name] = value
+	g.record(ChangeAdd, name, "", value, "")
+	g.bump()
+	return entry, nil
+}
+
+// Registry returns the registry the value belongs to, or nil for the zero Basic.
+func (e Basic) Registry() *BasicRegistry {
+	if e.meta == nil {
+		return nil
 	}
+	return &BasicRegistry{meta: e.meta}
 }
 
 // With assigns a custom integer value to the enum, updating the internal registry.
@@ -84,115 +251,247 @@ func (e *Basic) Add(name string) Basic {
 //
 // Returns a new Basic instance with the custom value.
 //
-// Panics if the value is already used in the registry.
+// Panics if the value is already used in the registry. Use TryWith to receive this
+// condition as an error.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending") // value: 0
 //	custom := pending.With(100) // Reassigns Pending to value 100
 //	fmt.Println(custom.Get())    // Output: 100
 func (e Basic) With(v int) Basic {
-	e.meta.mu.Lock()
-	defer e.meta.mu.Unlock()
+	if e.meta == nil {
+		panic(fmt.Errorf("enum: With called on a zero Basic: %w; create values with NewBasicRegistry().Add", ErrNilRegistry))
+	}
+	b, err := e.TryWith(v)
+	if err != nil {
+		e.meta.raise(err)
+	}
+	return b
+}
+
+// TryWith is like With but returns an error instead of panicking, including an error
+// wrapping ErrNilRegistry for the zero Basic.
+func (e Basic) TryWith(v int) (Basic, error) {
+	if e.meta == nil {
+		return Basic{}, fmt.Errorf("cannot assign a value to Basic enum: %w (obtain it from a BasicRegistry, e.g., with Add)", ErrNilRegistry)
+	}
+	if err := e.meta.validate(context.Background(), e.name, v); err != nil {
+		return Basic{}, err
+	}
+	e.meta.lock()
+	defer e.meta.unlock()
+	if err := e.meta.revalueLocked(e.name, e.value, v); err != nil {
+		return Basic{}, err
+	}
+	return Basic{
+		name:  e.name,
+		value: v,
+		meta:  e.meta,
+		set:   true,
+	}, nil
+}
 
-	if existing, ok := e.meta.valueMap[v]; ok {
-		panic(fmt.Sprintf("value %d already used for %q", v, existing))
+// revalueLocked implements Basic.TryWith, giving name the value v in place of old.
+// The caller must hold the write lock.
+func (g *Generator[T]) revalueLocked(name string, old, v T) error {
+	if existing, ok := g.valueMap[v]; ok {
+		if g.logger != nil {
+			g.log(slog.LevelWarn, "enum duplicate value", slog.Any(LogKeyValue, v), slog.String(LogKeyName, name))
+		}
+		return fmt.Errorf("enum: value %s already used for %q", g.formatValue(v), existing)
+	}
+	if err := g.checkLiteralLocked(name, v); err != nil {
+		return err
 	}
 
 	// Remove old mappings if they exist. This check ensures we only remove
 	// the value if it's still associated with the correct name, preventing
 	// incorrect deletions in complex scenarios.
-	if oldName, ok := e.meta.valueMap[e.value]; ok && oldName == e.name {
-		delete(e.meta.valueMap, e.value)
-		delete(e.meta.nameMap, e.name)
-		// Note: We don't remove from the `e.meta.values` slice for simplicity
+	if oldValue, ok := g.nameMap[name]; ok && oldValue == old {
+		g.dropName(old, name)
+		delete(g.nameMap, name)
+		// Note: We don't remove from the `values` slice for simplicity
 		// and performance, as it would require a linear scan. The lookup maps
 		// are the source of truth for all critical operations.
 	}
 
 	// Add new mappings
-	e.meta.valueMap[v] = e.name
-	e.meta.nameMap[e.name] = v
-	e.meta.values = append(e.meta.values, NewValue(v, e.name))
+	g.addName(v, name)
+	g.nameMap[name] = v
+	g.values = append(g.values, g.entry(v, name))
+	if g.journal != nil {
+		line := journalLine{Op: "revalue", Name: name}
+		line.OldValue, _ = marshalValue(old, g.marshal)
+		g.journalLocked(line, v)
+	}
+	g.bump()
+	return nil
+}
 
-	return Basic{
-		name:  e.name,
-		value: v,
-		meta:  e.meta,
+// DefaultEmptyNameFormat is the format Basic.String applies to the value of an unset
+// Basic, unless the registry sets another with WithEmptyNameFormat.
+const DefaultEmptyNameFormat = "Basic(%d)"
+
+// WithEmptyNameFormat sets the fmt format, applied to the integer value, that Basic.String
+// returns for unset values of the registry (see Basic.IsSet), such as after Scan(nil).
+// The default is DefaultEmptyNameFormat; "%d" yields the bare number.
+//
+// Example:
+//
+//	b := NewBasicRegistry(WithEmptyNameFormat("Status(%d)"))
+//	fmt.Println(b.Empty().String()) // Output: Status(0)
+func WithEmptyNameFormat(format string) Option[int] {
+	return func(g *Generator[int]) {
+		g.emptyName = format
 	}
 }
 
-// String returns the human-readable name of the enum value.
+// String returns the human-readable name of the enum value. An unset Basic (the zero
+// Basic, Empty, or one reset by JSON null or SQL NULL) has no name, so String formats its
+// value instead, as "Basic(0)" by default (see WithEmptyNameFormat). Entries registered
+// with an empty name keep it.
 //
 // Implements fmt.Stringer.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
-//	fmt.Println(pending.String()) // Output: "Pending"
+//	fmt.Println(pending.String())   // Output: "Pending"
+//	fmt.Println(b.Empty().String()) // Output: "Basic(0)"
 func (e Basic) String() string {
-	return e.name
+	if e.name != "" || e.set {
+		return e.name
+	}
+	format := DefaultEmptyNameFormat
+	if e.meta != nil && e.meta.emptyName != "" {
+		format = e.meta.emptyName
+	}
+	return fmt.Sprintf(format, e.value)
 }
 
 // Get returns the integer value of the enum.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
 //	fmt.Println(pending.Get()) // Output: 0
 func (e Basic) Get() int {
 	return e.value
 }
 
+// IsSet reports whether the Basic holds an entry: true for values obtained from the
+// registry or successfully unmarshaled or scanned, false for the zero Basic, for Empty,
+// and after unmarshaling JSON null or scanning SQL NULL.
+func (e Basic) IsSet() bool {
+	return e.set
+}
+
+// IsZero reports whether the Basic is unset (see IsSet), so a struct field tagged
+// `json:",omitzero"` (Go 1.24+) is omitted when unset and kept when it holds an entry
+// whose value is 0.
+func (e Basic) IsZero() bool {
+	return !e.set
+}
+
+// AtLeast reports whether e's value is at or above other's. Values from different
+// registries are not comparable, so AtLeast returns false for them.
+//
+// Example:
+//
+//	b := NewBasicRegistry()
+//	low := b.Add("Low")   // value: 0
+//	high := b.Add("High") // value: 1
+//	fmt.Println(high.AtLeast(low)) // Output: true
+func (e Basic) AtLeast(other Basic) bool {
+	return e.meta == other.meta && e.value >= other.value
+}
+
 // Validate checks if the enum value is valid by verifying its presence in the registry.
-// Returns nil if the value exists, or an error if it does not.
+// Returns nil if the value exists, an error wrapping ErrUnknownValue if it does not,
+// or an error wrapping ErrNilRegistry if e does not belong to a registry.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
 //	err := pending.Validate() // Returns nil
-//	invalid := Basic{value: 999, meta: b.meta}
-//	err = invalid.Validate()  // Returns error: "invalid enum value: 999"
+//	err = Basic{}.Validate()  // Returns an error wrapping ErrNilRegistry
 func (e Basic) Validate() error {
+	if e.meta == nil {
+		return fmt.Errorf("cannot validate Basic enum: %w (obtain it from a BasicRegistry, e.g., with Empty)", ErrNilRegistry)
+	}
 	if _, ok := e.meta.Name(e.value); !ok {
-		return fmt.Errorf("invalid enum value: %d", e.value)
+		if e.meta.logger != nil {
+			e.meta.log(slog.LevelDebug, "enum validate failed", slog.Int(LogKeyValue, e.value))
+		}
+		return fmt.Errorf("%w: %d", ErrUnknownValue, e.value)
 	}
 	return nil
 }
 
-// MarshalJSON implements json.Marshaler, serializing the enum value to its integer value.
+// MarshalJSON implements json.Marshaler, serializing the enum value to its integer value,
+// or to a string on 64-bit platforms if its registry was created with WithInt64AsString.
+// An unset Basic (see IsSet) is marshaled as null, unless its registry was created with
+// WithUnsetAsZero.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
 //	data, _ := pending.MarshalJSON()
 //	fmt.Println(string(data)) // Output: 0
 func (e Basic) MarshalJSON() ([]byte, error) {
-	return json.Marshal(e.value)
+	var flags marshalFlags
+	if e.meta != nil {
+		flags = e.meta.marshal
+	}
+	if !e.set && flags&marshalUnsetAsZero == 0 {
+		return []byte("null"), nil
+	}
+	return marshalValue(e.value, flags)
 }
 
 // UnmarshalJSON implements json.Unmarshaler, deserializing an integer value from JSON
 // and updating the Basic instance with the corresponding name from the registry.
-// Returns an error if the value is not found in the registry, if the `meta` field is nil,
-// or if JSON parsing fails.
+// Both 0 and "0" are accepted, and a JSON string may also hold a registered name
+// (e.g., "Pending"). JSON null resets the Basic to unset.
+// Returns an error wrapping ErrUnknownValue if the value is not found in the registry,
+// wrapping ErrNilRegistry if e does not belong to a registry, or if JSON parsing fails.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	b.Add("Pending")
-//	data := []byte("0")
-//	var e2 Basic
-//	e2.meta = b.meta // IMPORTANT: The registry must be assigned before unmarshaling.
-//	err := e2.UnmarshalJSON(data) // Sets e2 to {name: "Pending", value: 0}
+//	e2 := b.Empty() // IMPORTANT: The value must belong to a registry before unmarshaling.
+//	err := e2.UnmarshalJSON([]byte("0")) // Sets e2 to {name: "Pending", value: 0}
 func (e *Basic) UnmarshalJSON(data []byte) error {
 	if e.meta == nil {
-		return errors.New("cannot unmarshal into Basic enum with nil registry (meta)")
+		return fmt.Errorf("cannot unmarshal into Basic enum: %w (obtain it from a BasicRegistry, e.g., with Empty)", ErrNilRegistry)
 	}
+	trimmed := bytes.TrimSpace(data)
+	if string(trimmed) == "null" {
+		e.value, e.name, e.set = 0, "", false
+		return nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return err
+		}
+		v, err := e.meta.Parse(s)
+		if err != nil {
+			return fmt.Errorf("%w: %q", ErrUnknownValue, s)
+		}
+		e.value = v.Get()
+		e.name = v.name
+		e.set = true
+		return nil
+	}
+
 	var val int
 	if err := json.Unmarshal(data, &val); err != nil {
 		return err
@@ -200,45 +499,118 @@ func (e *Basic) UnmarshalJSON(data []byte) error {
 
 	name, exists := e.meta.Name(val)
 	if !exists {
-		return fmt.Errorf("invalid enum value: %d", val)
+		return fmt.Errorf("%w: %d", ErrUnknownValue, val)
 	}
 	e.value = val
 	e.name = name
+	e.set = true
 	return nil
 }
 
-// Value implements driver.Valuer, returning the enum value as an int64 for SQL storage.
+// UnmarshalText implements encoding.TextUnmarshaler for text-first config formats.
+// If e belongs to a registry, text is resolved like a JSON string (a name, alias, or
+// integer literal). Otherwise, as for a zero Basic field in a config struct, only the
+// name is recorded and e stays unset until ResolveStruct resolves it.
+//
+// Example:
+//
+//	var cfg struct{ Level Basic }
+//	_ = cfg.Level.UnmarshalText([]byte("High")) // Name only; see ResolveStruct.
+func (e *Basic) UnmarshalText(text []byte) error {
+	if e.meta == nil {
+		e.value, e.name, e.set = 0, string(text), false
+		return nil
+	}
+	v, err := e.meta.Parse(string(text))
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrUnknownValue, text)
+	}
+	e.value, e.name, e.set = v.Get(), v.name, true
+	return nil
+}
+
+// WithSQLNames makes Basic values from the registry store their name instead of their
+// number in SQL: Value returns the name as a string, and Scan resolves text through the
+// registry's names first, falling back to a numeric value. Numeric columns written before
+// a migration therefore still scan.
+//
+// Example:
+//
+//	b := NewBasicRegistry(WithSQLNames())
+//	pending := b.Add("Pending")
+//	val, _ := pending.Value() // Returns "Pending"
+func WithSQLNames() Option[int] {
+	return func(g *Generator[int]) {
+		g.sqlNames = true
+	}
+}
+
+// Value implements driver.Valuer, returning the enum value as an int64 for SQL storage,
+// or its name if the registry was created with WithSQLNames.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
 //	val, _ := pending.Value() // Returns int64(0)
 func (e Basic) Value() (driver.Value, error) {
+	if e.meta != nil && e.meta.sqlNames {
+		if e.name != "" {
+			return e.name, nil
+		}
+		name, ok := e.meta.Name(e.value)
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownValue, e.value)
+		}
+		return name, nil
+	}
 	return int64(e.value), nil
 }
 
 // Scan implements sql.Scanner, parsing an SQL value (int64, float64, string, or []byte)
 // into the Basic instance. Updates the name and value based on the registry.
-// Returns an error if the value is invalid, unsupported, or if the `meta` field is nil.
+// Returns an error wrapping ErrUnknownValue if the value is invalid, wrapping ErrNilRegistry
+// if e does not belong to a registry, or if the source type is unsupported.
+//
+// If the registry was created with WithSQLNames, text is resolved as a name first
+// (including aliases), then as a number.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	b.Add("Pending")
-//	var e2 Basic
-//	e2.meta = b.meta // IMPORTANT: The registry must be assigned before scanning.
+//	e2 := b.Empty() // IMPORTANT: The value must belong to a registry before scanning.
 //	err := e2.Scan(int64(0)) // Sets e2 to {name: "Pending", value: 0}
 func (e *Basic) Scan(value interface{}) error {
 	if e.meta == nil {
-		return errors.New("cannot scan into Basic enum with nil registry (meta)")
+		return fmt.Errorf("cannot scan into Basic enum: %w (obtain it from a BasicRegistry, e.g., with Empty)", ErrNilRegistry)
 	}
 	if value == nil {
 		// Set to zero value if DB is NULL
 		e.value = 0
 		e.name = ""
+		e.set = false
 		return nil
 	}
+	if e.meta.sqlNames {
+		var text string
+		switch v := value.(type) {
+		case string:
+			text = v
+		case []byte:
+			text = string(v)
+		}
+		if text != "" {
+			v, err := e.meta.Parse(text)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrUnknownValue, text)
+			}
+			e.value = v.Get()
+			e.name = v.name
+			e.set = true
+			return nil
+		}
+	}
 	var val int
 	switch v := value.(type) {
 	case int64:
@@ -249,13 +621,13 @@ func (e *Basic) Scan(value interface{}) error {
 		var err error
 		val, err = strconv.Atoi(string(v))
 		if err != nil {
-			return fmt.Errorf("invalid enum value: %s", string(v))
+			return fmt.Errorf("%w: %s: %w", ErrUnknownValue, string(v), err)
 		}
 	case string:
 		var err error
 		val, err = strconv.Atoi(v)
 		if err != nil {
-			return fmt.Errorf("invalid enum value: %s", v)
+			return fmt.Errorf("%w: %s: %w", ErrUnknownValue, v, err)
 		}
 	default:
 		return fmt.Errorf("unsupported type for scan: %T", value)
@@ -263,54 +635,64 @@ func (e *Basic) Scan(value interface{}) error {
 
 	name, exists := e.meta.Name(val)
 	if !exists {
-		return fmt.Errorf("invalid enum value: %d", val)
+		return fmt.Errorf("%w: %d", ErrUnknownValue, val)
 	}
 	e.value = val
 	e.name = name
+	e.set = true
 	return nil
 }
 
-// Values returns a slice of all enum values in the registry.
-// Each value is a Basic instance with the same meta registry.
-//
-// Example:
-//
-//	b := NewBasic()
-//	b.Add("Pending")
-//	b.Add("Active")
-//	values := b.Values() // Returns [{name: "Pending", value: 0}, {name: "Active", value: 1}]
-func (e *Basic) Values() []Basic {
-	values := e.meta.Values()
-	result := make([]Basic, len(values))
-	for i, v := range values {
-		result[i] = Basic{name: v.String(), value: v.Get(), meta: e.meta}
-	}
-	return result
-}
-
 // ToValue converts a Basic instance to a Value[int] for compatibility with generic
 // enum operations in the package.
 //
 // Example:
 //
-//	b := NewBasic()
+//	b := NewBasicRegistry()
 //	pending := b.Add("Pending")
 //	v := pending.ToValue() // Returns Value[int]{value: 0, name: "Pending"}
 func (e Basic) ToValue() Value[int] {
-	return NewValue(e.value, e.name)
+	v := NewValue(e.value, e.name)
+	if e.meta != nil {
+		v.flags = e.meta.marshal
+	}
+	return v
 }
 
-// FromValue creates a Basic instance from a Value[int], adding it to the registry.
-// This is a convenience method that chains Add() and With().
+// registry returns the registry behind a Basic for the deprecated registry methods.
+// It panics with a hint when e is the zero Basic.
+func (e *Basic) registry(method string) *BasicRegistry {
+	if e == nil || e.meta == nil {
+		panic(fmt.Errorf("enum: Basic.%s called on a zero Basic: %w; create a registry with NewBasicRegistry and call %s on it", method, ErrNilRegistry, method))
+	}
+	return &BasicRegistry{meta: e.meta}
+}
+
+// Add is like BasicRegistry.Add.
 //
-// Panics if the value or name already exists in the registry.
+// Deprecated: Call Add on the *BasicRegistry returned by NewBasicRegistry. Add is only
+// valid on the *Basic returned by NewBasic: calling it on a value such as one returned
+// by Add used to register the name silently into that value's registry, and now panics.
 //
-// Example:
+// Panics if e is the zero Basic or a value rather than a registry.
+func (e *Basic) Add(name string) Basic {
+	r := e.registry("Add")
+	if e.name != "" {
+		panic(fmt.Errorf("enum: Basic.Add(%q) called on the value %q; call Add on its registry (see Basic.Registry)", name, e.name))
+	}
+	return r.Add(name)
+}
+
+// Values is like BasicRegistry.Values.
 //
-//	v := NewValue(10, "Pending")
-//	b := NewBasic()
-//	pending := b.FromValue(v) // Returns Basic{name: "Pending", value: 10}
+// Deprecated: Call Values on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) Values() []Basic {
+	return e.registry("Values").Values()
+}
+
+// FromValue is like BasicRegistry.FromValue.
+//
+// Deprecated: Call FromValue on the *BasicRegistry returned by NewBasicRegistry.
 func (e *Basic) FromValue(v Value[int]) Basic {
-	// Add creates an entry with a temporary value, which With then corrects.
-	return e.Add(v.String()).With(v.Get())
+	return e.registry("FromValue").FromValue(v)
 }
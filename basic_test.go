@@ -2,6 +2,9 @@ package enum
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -156,3 +159,308 @@ func TestBasicEnum(t *testing.T) {
 		}
 	})
 }
+
+func TestBasic_UnmarshalJSON_Tolerant(t *testing.T) {
+	status := NewBasicRegistry()
+	status.Add("Pending")
+	status.Add("Active")
+
+	testCases := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{`1`, "Active", false},
+		{`"1"`, "Active", false},
+		{`"Pending"`, "Pending", false},
+		{`"Closed"`, "", true},
+		{`"1`, "", true},
+		{`7`, "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			result := Basic{meta: status.meta}
+			err := json.Unmarshal([]byte(tc.input), &result)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if !tc.wantErr && result.String() != tc.want {
+				t.Errorf("Expected %q, got %q", tc.want, result.String())
+			}
+		})
+	}
+}
+
+func TestBasic_SentinelErrors(t *testing.T) {
+	status := NewBasicRegistry()
+	status.Add("Pending")
+
+	t.Run("Nil registry", func(t *testing.T) {
+		var b Basic
+		if err := b.UnmarshalJSON([]byte("0")); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("UnmarshalJSON: expected ErrNilRegistry, got %v", err)
+		}
+		if err := b.Scan(int64(0)); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Scan: expected ErrNilRegistry, got %v", err)
+		}
+		if err := b.Validate(); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Validate: expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("Unknown value", func(t *testing.T) {
+		b := Basic{meta: status.meta}
+		if err := b.UnmarshalJSON([]byte("9")); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("UnmarshalJSON: expected ErrUnknownValue, got %v", err)
+		}
+		if err := b.UnmarshalJSON([]byte(`"Closed"`)); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("UnmarshalJSON name: expected ErrUnknownValue, got %v", err)
+		}
+		if err := b.Scan(int64(9)); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Scan: expected ErrUnknownValue, got %v", err)
+		}
+		invalid := Basic{value: 9, meta: status.meta}
+		if err := invalid.Validate(); !errors.Is(err, ErrUnknownValue) || errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Validate: expected only ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("Scan wraps strconv error", func(t *testing.T) {
+		b := Basic{meta: status.meta}
+		err := b.Scan("abc")
+		var numErr *strconv.NumError
+		if !errors.Is(err, ErrUnknownValue) || !errors.As(err, &numErr) {
+			t.Errorf("Expected ErrUnknownValue wrapping *strconv.NumError, got %v", err)
+		}
+		if err := b.Scan([]byte("abc")); !errors.As(err, &numErr) {
+			t.Errorf("Expected *strconv.NumError for bytes, got %v", err)
+		}
+	})
+}
+
+func TestBasic_FromValue_Checked(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected panic with ErrInvalidName, got %v", err)
+		}
+	}()
+	NewBasicRegistry().FromValue(NewValue(3, " Padded"))
+}
+
+func TestBasic_SQLNames(t *testing.T) {
+	b := NewBasicRegistry(WithSQLNames())
+	pending := b.Add("Pending")
+	active := b.Add("Active")
+
+	t.Run("Value emits name", func(t *testing.T) {
+		if v, err := active.Value(); err != nil || v != "Active" {
+			t.Errorf("Expected \"Active\", got %v, err: %v", v, err)
+		}
+		if v, err := (Basic{value: 1, meta: b.meta}).Value(); err != nil || v != "Active" {
+			t.Errorf("Expected name resolved from value, got %v, err: %v", v, err)
+		}
+		if _, err := (Basic{value: 9, meta: b.meta}).Value(); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("Scan name", func(t *testing.T) {
+		for _, src := range []any{"Active", []byte("Active")} {
+			e := Basic{meta: b.meta}
+			if err := e.Scan(src); err != nil || e != active {
+				t.Errorf("Scan(%v): expected Active, got %v, err: %v", src, e, err)
+			}
+		}
+	})
+
+	t.Run("Scan mixed numeric rows", func(t *testing.T) {
+		for _, src := range []any{int64(0), "0", []byte("0"), float64(0)} {
+			e := Basic{meta: b.meta}
+			if err := e.Scan(src); err != nil || e != pending {
+				t.Errorf("Scan(%v): expected Pending, got %v, err: %v", src, e, err)
+			}
+		}
+	})
+
+	t.Run("Scan unknown", func(t *testing.T) {
+		e := Basic{meta: b.meta}
+		if err := e.Scan("Closed"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("Default stays numeric", func(t *testing.T) {
+		n := NewBasicRegistry()
+		a := n.Add("A")
+		if v, _ := a.Value(); v != int64(0) {
+			t.Errorf("Expected int64(0), got %v", v)
+		}
+	})
+}
+
+func TestBasicRegistry(t *testing.T) {
+	status := NewBasicRegistry()
+	pending := status.Add("Pending")
+	status.Add("Active")
+
+	t.Run("Parse", func(t *testing.T) {
+		if v, err := status.Parse("Pending"); err != nil || v != pending {
+			t.Errorf("Expected Pending, got %v, err: %v", v, err)
+		}
+		if _, err := status.Parse("Closed"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("Empty decodes", func(t *testing.T) {
+		e := status.Empty()
+		if err := json.Unmarshal([]byte(`"Active"`), &e); err != nil || e.Get() != 1 {
+			t.Errorf("Expected Active, got %v, err: %v", e, err)
+		}
+		e = status.Empty()
+		if err := e.Scan(int64(0)); err != nil || e != pending {
+			t.Errorf("Expected Pending, got %v, err: %v", e, err)
+		}
+	})
+
+	t.Run("Registry of value", func(t *testing.T) {
+		r := pending.Registry()
+		if r == nil || r.meta != status.meta {
+			t.Fatal("Expected the value's registry")
+		}
+		if (Basic{}).Registry() != nil {
+			t.Error("Expected nil registry for the zero Basic")
+		}
+	})
+}
+
+func TestBasic_ZeroValueMisuse(t *testing.T) {
+	expectPanic := func(t *testing.T, want string, target error, fn func()) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected panic with an error mentioning %q, got %v", want, err)
+			}
+			if target != nil && !errors.Is(err, target) {
+				t.Errorf("Expected panic wrapping %v, got %v", target, err)
+			}
+		}()
+		fn()
+	}
+
+	t.Run("Add on zero", func(t *testing.T) {
+		var b Basic
+		expectPanic(t, "NewBasic", ErrNilRegistry, func() { b.Add("X") })
+	})
+	t.Run("Add on value", func(t *testing.T) {
+		v := NewBasicRegistry().Add("Pending")
+		expectPanic(t, "call Add on its registry", nil, func() { v.Add("Active") })
+	})
+	t.Run("Values on zero", func(t *testing.T) {
+		var b Basic
+		expectPanic(t, "Basic.Values", ErrNilRegistry, func() { b.Values() })
+	})
+	t.Run("With on zero", func(t *testing.T) {
+		expectPanic(t, "zero Basic", ErrNilRegistry, func() { Basic{}.With(3) })
+	})
+	t.Run("Decode hints at Empty", func(t *testing.T) {
+		var b Basic
+		if err := b.Scan(int64(0)); !errors.Is(err, ErrNilRegistry) || !strings.Contains(err.Error(), "Empty") {
+			t.Errorf("Expected ErrNilRegistry with a hint, got %v", err)
+		}
+	})
+}
+
+func TestBasic_IsSet(t *testing.T) {
+	status := NewBasicRegistry()
+	pending := status.Add("Pending") // value: 0
+	status.Add("Active")
+
+	t.Run("State", func(t *testing.T) {
+		if !pending.IsSet() || (Basic{}).IsSet() || status.Empty().IsSet() {
+			t.Error("Expected registry entries to be set, and the zero Basic and Empty unset")
+		}
+		if p, _ := status.Parse("Active"); !p.IsSet() {
+			t.Error("Expected Parse to return a set Basic")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type order struct {
+			Status Basic `json:"status"`
+		}
+		data, err := json.Marshal(order{Status: status.Empty()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"status":null}` {
+			t.Errorf("Expected unset as null, got %s", data)
+		}
+		if data, _ := json.Marshal(order{Status: pending}); string(data) != `{"status":0}` {
+			t.Errorf("Expected set zero values to be kept, got %s", data)
+		}
+
+		back := pending
+		if err := json.Unmarshal([]byte("null"), &back); err != nil || back.IsSet() || back.String() != "Basic(0)" {
+			t.Errorf("Expected null to unset, got %+v, err: %v", back, err)
+		}
+		if err := json.Unmarshal([]byte("0"), &back); err != nil || !back.IsSet() || back.String() != "Pending" {
+			t.Errorf("Expected 0 to set Pending, got %+v, err: %v", back, err)
+		}
+
+		if data, _ := json.Marshal(NewBasicRegistry(WithUnsetAsZero[int]()).Empty()); string(data) != "0" {
+			t.Errorf("Expected unset as zero under WithUnsetAsZero, got %s", data)
+		}
+		if data, _ := json.Marshal(Basic{}); string(data) != "null" {
+			t.Errorf("Expected the zero Basic as null, got %s", data)
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		e := pending
+		if err := e.Scan(nil); err != nil || e.IsSet() {
+			t.Errorf("Expected Scan(nil) to unset, got %+v, err: %v", e, err)
+		}
+		if err := e.Scan(int64(0)); err != nil || !e.IsSet() {
+			t.Errorf("Expected Scan(0) to set, got %+v, err: %v", e, err)
+		}
+	})
+}
+
+func TestBasic_StringFallback(t *testing.T) {
+	t.Run("Default format", func(t *testing.T) {
+		status := NewBasicRegistry()
+		pending := status.Add("Pending")
+		if got := (Basic{}).String(); got != "Basic(0)" {
+			t.Errorf("Expected zero Basic as Basic(0), got %q", got)
+		}
+		if err := pending.Scan(nil); err != nil || pending.String() != "Basic(0)" {
+			t.Errorf("Expected Scan(nil) to format as Basic(0), got %q, err: %v", pending.String(), err)
+		}
+	})
+
+	t.Run("WithEmptyNameFormat", func(t *testing.T) {
+		status := NewBasicRegistry(WithEmptyNameFormat("%d"))
+		status.Add("Pending")
+		if got := status.Empty().String(); got != "0" {
+			t.Errorf("Expected custom format, got %q", got)
+		}
+		if got := status.meta.Clone().emptyName; got != "%d" {
+			t.Errorf("Expected Clone to keep the format, got %q", got)
+		}
+	})
+
+	t.Run("Empty-named entry", func(t *testing.T) {
+		status := NewBasicRegistry()
+		blank := status.Add("")
+		if got := blank.String(); got != "" {
+			t.Errorf("Expected registered empty name to be kept, got %q", got)
+		}
+		parsed, err := status.Parse("0")
+		if err != nil || parsed.String() != "" {
+			t.Errorf("Expected parsed entry to keep its empty name, got %q, err: %v", parsed.String(), err)
+		}
+	})
+}
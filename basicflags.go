@@ -0,0 +1,265 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// NewBasicFlags creates a Basic registry for bit flags: each call to Add assigns the
+// next power of two (1, 2, 4, ...), as NewBitFlagGenerator does. Values combine into a
+// BasicMask with Or or Mask.
+//
+// Optional Generator options (e.g., WithLabel, WithLogger) configure the registry.
+//
+// Example:
+//
+//	perms := NewBasicFlags()
+//	read := perms.Add("Read")   // value: 1
+//	write := perms.Add("Write") // value: 2
+//	rw := read.Or(write)
+//	fmt.Println(rw.Get(), rw) // Output: 3 Read|Write
+func NewBasicFlags(opts ...Option[int]) *BasicRegistry {
+	base := []Option[int]{
+		WithStart(1),
+		withBuiltinIncrementer(func(x int) int { return x << 1 }, IncrementerBitFlag),
+	}
+	return &BasicRegistry{
+		meta: NewGenerator[int](append(base, opts...)...),
+	}
+}
+
+// BasicMask is a combination of Basic flags from one registry, stored as the bitwise OR
+// of their values. It marshals to JSON as the array of set flag names (e.g.,
+// ["Read","Write"]) and to SQL as the combined integer.
+//
+// The zero BasicMask has no registry; it adopts the registry of the first flag passed to
+// Set, while UnmarshalJSON and Scan return errors wrapping ErrNilRegistry. Obtain one to
+// decode into with BasicRegistry.Mask.
+type BasicMask struct {
+	bits int
+	meta *Generator[int]
+}
+
+// Mask returns the combination of flags, or an empty mask belonging to the registry
+// if none are given. Returns an error if a flag belongs to another registry or is not
+// registered.
+//
+// Example:
+//
+//	perms := NewBasicFlags()
+//	read := perms.Add("Read")
+//	m, err := perms.Mask(read) // m.Get() == 1
+func (r *BasicRegistry) Mask(flags ...Basic) (BasicMask, error) {
+	m := BasicMask{meta: r.meta}
+	if err := m.Set(flags...); err != nil {
+		return BasicMask{}, err
+	}
+	return m, nil
+}
+
+// Or returns the mask combining e with others.
+//
+// Panics if a value belongs to another registry or is not registered, as combining
+// flags of different enums is a programming error. Use BasicRegistry.Mask to get an
+// error instead; under WithErrorMode, Or records the error and returns an empty mask.
+//
+// Example:
+//
+//	rw := read.Or(write) // rw.Has(read) && rw.Has(write)
+func (e Basic) Or(others ...Basic) BasicMask {
+	m := BasicMask{meta: e.meta}
+	if err := m.Set(append([]Basic{e}, others...)...); err != nil {
+		if e.meta == nil {
+			panic(err)
+		}
+		e.meta.raise(err)
+		return BasicMask{meta: e.meta}
+	}
+	return m
+}
+
+// Get returns the combined integer value of the mask.
+func (m BasicMask) Get() int {
+	return m.bits
+}
+
+// Registry returns the registry the mask belongs to, or nil for the zero BasicMask.
+func (m BasicMask) Registry() *BasicRegistry {
+	if m.meta == nil {
+		return nil
+	}
+	return &BasicRegistry{meta: m.meta}
+}
+
+// Has reports whether every bit of flag is set in the mask. It returns false for
+// flags of another registry.
+func (m BasicMask) Has(flag Basic) bool {
+	return m.meta != nil && flag.meta == m.meta && m.bits&flag.value == flag.value
+}
+
+// Set adds flags to the mask. It returns an error if a flag belongs to another
+// registry or is not registered, leaving the mask unchanged.
+func (m *BasicMask) Set(flags ...Basic) error {
+	combined, err := m.combine(flags)
+	if err != nil {
+		return err
+	}
+	m.bits |= combined
+	return nil
+}
+
+// Clear removes flags from the mask. It returns an error if a flag belongs to
+// another registry or is not registered, leaving the mask unchanged.
+func (m *BasicMask) Clear(flags ...Basic) error {
+	combined, err := m.combine(flags)
+	if err != nil {
+		return err
+	}
+	m.bits &^= combined
+	return nil
+}
+
+// combine validates flags against the mask's registry, adopting the registry of the
+// first flag for the zero BasicMask, and returns their bitwise OR.
+func (m *BasicMask) combine(flags []Basic) (int, error) {
+	meta := m.meta
+	var combined int
+	for _, flag := range flags {
+		if flag.meta == nil {
+			return 0, fmt.Errorf("enum: cannot combine %q: %w", flag.name, ErrNilRegistry)
+		}
+		if meta == nil {
+			meta = flag.meta
+		}
+		if flag.meta != meta {
+			return 0, fmt.Errorf("enum: cannot combine %q: it belongs to another registry", flag.name)
+		}
+		if err := flag.Validate(); err != nil {
+			return 0, err
+		}
+		combined |= flag.value
+	}
+	m.meta = meta
+	return combined, nil
+}
+
+// Names returns the names of the single-bit flags set in the mask, in definition order.
+// Composite entries (e.g., ReadWrite = Read|Write) are not listed.
+func (m BasicMask) Names() []string {
+	if m.meta == nil {
+		return nil
+	}
+	var names []string
+	for _, v := range m.meta.Values() {
+		if v.value > 0 && bits.OnesCount(uint(v.value)) == 1 && m.bits&v.value != 0 {
+			names = append(names, v.name)
+		}
+	}
+	return names
+}
+
+// String returns the names of the set flags joined with "|", or "0" for an empty mask.
+//
+// Implements fmt.Stringer.
+func (m BasicMask) String() string {
+	if names := m.Names(); len(names) > 0 {
+		return strings.Join(names, "|")
+	}
+	return strconv.Itoa(m.bits)
+}
+
+// MarshalJSON implements json.Marshaler, writing the names of the set flags as an array.
+func (m BasicMask) MarshalJSON() ([]byte, error) {
+	names := m.Names()
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting an array of flag names or the
+// combined integer. Names are resolved with the registry's Parse, so aliases work.
+// Returns an error wrapping ErrUnknownValue for unknown names or bits, or wrapping
+// ErrNilRegistry if m does not belong to a registry.
+//
+// Example:
+//
+//	m, _ := perms.Mask()
+//	err := json.Unmarshal([]byte(`["Read","Write"]`), &m) // m.Get() == 3
+func (m *BasicMask) UnmarshalJSON(data []byte) error {
+	if m.meta == nil {
+		return fmt.Errorf("cannot unmarshal into BasicMask: %w (obtain it from a BasicRegistry, e.g., with Mask)", ErrNilRegistry)
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] != '[' {
+		var n int
+		if err := json.Unmarshal(trimmed, &n); err != nil {
+			return err
+		}
+		return m.setBits(n)
+	}
+	var names []string
+	if err := json.Unmarshal(trimmed, &names); err != nil {
+		return err
+	}
+	combined := 0
+	for _, name := range names {
+		v, err := m.meta.Parse(name)
+		if err != nil {
+			return fmt.Errorf("%w: %q", ErrUnknownValue, name)
+		}
+		combined |= v.value
+	}
+	m.bits = combined
+	return nil
+}
+
+// Value implements driver.Valuer, returning the combined integer as an int64.
+func (m BasicMask) Value() (driver.Value, error) {
+	return int64(m.bits), nil
+}
+
+// Scan implements sql.Scanner, reading the combined integer (int64, float64, string,
+// or []byte). A NULL resets the mask to empty. Returns an error wrapping ErrUnknownValue
+// if the integer has bits that are not registered flags, or wrapping ErrNilRegistry if
+// m does not belong to a registry.
+func (m *BasicMask) Scan(value interface{}) error {
+	if m.meta == nil {
+		return fmt.Errorf("cannot scan into BasicMask: %w (obtain it from a BasicRegistry, e.g., with Mask)", ErrNilRegistry)
+	}
+	var n int
+	switch v := value.(type) {
+	case nil:
+		m.bits = 0
+		return nil
+	case int64:
+		n = int(v)
+	case float64:
+		n = int(v)
+	case []byte:
+		return m.Scan(string(v))
+	case string:
+		var err error
+		n, err = strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrUnknownValue, v, err)
+		}
+	default:
+		return fmt.Errorf("unsupported type for scan: %T", value)
+	}
+	return m.setBits(n)
+}
+
+// setBits replaces the mask with n after checking that n only has registered flag bits.
+func (m *BasicMask) setBits(n int) error {
+	if err := m.meta.ValidateMask(n); err != nil {
+		return err
+	}
+	m.bits = n
+	return nil
+}
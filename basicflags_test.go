@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBasicFlags(t *testing.T) {
+	perms := NewBasicFlags()
+	read := perms.Add("Read")
+	write := perms.Add("Write")
+	exec := perms.Add("Exec")
+
+	t.Run("Add assigns bits", func(t *testing.T) {
+		if read.Get() != 1 || write.Get() != 2 || exec.Get() != 4 {
+			t.Errorf("Expected 1, 2, 4, got %d, %d, %d", read.Get(), write.Get(), exec.Get())
+		}
+	})
+
+	t.Run("Or and Has", func(t *testing.T) {
+		m := read.Or(exec)
+		if m.Get() != 5 {
+			t.Errorf("Expected 5, got %d", m.Get())
+		}
+		if !m.Has(read) || m.Has(write) || !m.Has(exec) {
+			t.Errorf("Unexpected membership in %v", m)
+		}
+		if m.String() != "Read|Exec" {
+			t.Errorf("Expected Read|Exec, got %q", m.String())
+		}
+	})
+
+	t.Run("Set and Clear", func(t *testing.T) {
+		m, err := perms.Mask()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Set(read, write); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Clear(read); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(m.Names(), []string{"Write"}) {
+			t.Errorf("Expected [Write], got %v", m.Names())
+		}
+	})
+
+	t.Run("Mixing registries", func(t *testing.T) {
+		other := NewBasicFlags().Add("Read")
+		if _, err := perms.Mask(read, other); err == nil {
+			t.Error("Expected an error when mixing registries")
+		}
+		m := read.Or()
+		if err := m.Set(other); err == nil {
+			t.Error("Expected Set to reject a flag of another registry")
+		}
+		if m.Get() != 1 || m.Has(other) {
+			t.Errorf("Expected the mask unchanged, got %v", m)
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Or to panic when mixing registries")
+			}
+		}()
+		read.Or(other)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(read.Or(write))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `["Read","Write"]` {
+			t.Errorf("Expected [\"Read\",\"Write\"], got %s", data)
+		}
+		m, _ := perms.Mask()
+		if err := json.Unmarshal([]byte(`["Exec","Read"]`), &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.Get() != 5 {
+			t.Errorf("Expected 5, got %d", m.Get())
+		}
+		if err := json.Unmarshal([]byte(`["Delete"]`), &m); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+		var zero BasicMask
+		if err := json.Unmarshal(data, &zero); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("Database Value/Scan", func(t *testing.T) {
+		val, err := read.Or(exec).Value()
+		if err != nil || val != int64(5) {
+			t.Fatalf("Expected int64(5), got %v (%v)", val, err)
+		}
+		m, _ := perms.Mask()
+		if err := m.Scan(val); err != nil {
+			t.Fatal(err)
+		}
+		if !m.Has(read) || !m.Has(exec) || m.Has(write) {
+			t.Errorf("Expected Read|Exec, got %v", m)
+		}
+		if err := m.Scan([]byte("3")); err != nil || m.Get() != 3 {
+			t.Errorf("Expected 3, got %d (%v)", m.Get(), err)
+		}
+		if err := m.Scan(int64(8)); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue for unknown bits, got %v", err)
+		}
+		if err := m.Scan(nil); err != nil || m.Get() != 0 {
+			t.Errorf("Expected NULL to empty the mask, got %d (%v)", m.Get(), err)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package enum
+
+import "fmt"
+
+// BijectionError reports an operation that would break the one-to-one mapping between
+// names and values. Either Names holds two names sharing Values[0], or Values holds
+// two values claimed by Names[0]. It wraps ErrNotBijective.
+type BijectionError struct {
+	Names  []string // The conflicting names, or the single name claimed twice.
+	Values []any    // The shared value, or the conflicting values.
+}
+
+// Error implements the error interface.
+func (e *BijectionError) Error() string {
+	if len(e.Names) > 1 {
+		return fmt.Sprintf("%v: names %q and %q both map to value %v", ErrNotBijective, e.Names[0], e.Names[1], e.Values[0])
+	}
+	return fmt.Sprintf("%v: name %q maps to values %v and %v", ErrNotBijective, e.Names[0], e.Values[0], e.Values[1])
+}
+
+// Unwrap returns ErrNotBijective, so errors.Is(err, ErrNotBijective) holds.
+func (e *BijectionError) Unwrap() error {
+	return ErrNotBijective
+}
+
+// WithBijective makes the Generator guarantee a strict one-to-one mapping between
+// names and values: Next fails when the next value already has a name, and NewMapped
+// panics on input where two names share a value. Failures carry a *BijectionError.
+// Aliases (see AddAlias) are alternate Parse inputs rather than names, and are not affected.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithIncrementer(func(int) int { return 0 }), WithBijective[int]())
+//	g.Next("Zero")
+//	_, err := g.TryNext("Nil") // err: enum: bijection violated: names "Zero" and "Nil" both map to value 0
+func WithBijective[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.bijective = true
+	}
+}
+
+// IsBijective reports whether every value currently has exactly one name, regardless
+// of whether WithBijective is set. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) IsBijective() bool {
+	g.rlock()
+	defer g.runlock()
+	return len(g.extraNames) == 0 && len(g.valueMap) == len(g.nameMap)
+}
+
+// sharedValueError returns the error for name claiming value, which already belongs to existing.
+func sharedValueError[T TypesValue](existing, name string, value T) error {
+	return &BijectionError{Names: []string{existing, name}, Values: []any{value}}
+}
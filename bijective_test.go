@@ -0,0 +1,86 @@
+package enum
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGenerator_Bijective(t *testing.T) {
+	t.Run("Next", func(t *testing.T) {
+		g := NewCyclic(2)
+		WithBijective[int]()(g)
+		g.Next("Zero")
+		g.Next("One")
+		_, err := g.TryNext("Two")
+		var be *BijectionError
+		if !errors.As(err, &be) || !errors.Is(err, ErrNotBijective) {
+			t.Fatalf("Expected BijectionError, got %v", err)
+		}
+		if be.Names[0] != "Zero" || be.Names[1] != "Two" || be.Values[0] != 0 {
+			t.Errorf("Unexpected error fields %+v", be)
+		}
+		if g.Len() != 2 || !g.IsBijective() {
+			t.Error("Expected failed Next to change nothing")
+		}
+	})
+
+	t.Run("NewMapped", func(t *testing.T) {
+		if g := NewMapped(map[string]int{"A": 1, "B": 2}, WithBijective[int]()); !g.IsBijective() {
+			t.Error("Expected bijective generator")
+		}
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, ErrNotBijective) {
+				t.Errorf("Expected panic with ErrNotBijective, got %v", err)
+			}
+		}()
+		NewMapped(map[string]int{"A": 1, "B": 1}, WithBijective[int]())
+	})
+
+	t.Run("NewMapped options", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 1}, WithLabel[int]("Letters"), WithStart(5))
+		if g.Label() != "Letters" {
+			t.Errorf("Expected label to be applied, got %q", g.Label())
+		}
+		if _, err := g.TryNext("B"); err == nil {
+			t.Error("Expected Next to stay unsupported")
+		}
+	})
+
+	t.Run("UnmarshalJSON duplicate names", func(t *testing.T) {
+		g := NewGenerator[int](WithBijective[int]())
+		g.Next("Keep")
+		err := g.UnmarshalJSON([]byte(`{"1":"Dup","2":"Dup"}`))
+		var be *BijectionError
+		if !errors.As(err, &be) || be.Names[0] != "Dup" || be.Values[0] != 1 || be.Values[1] != 2 {
+			t.Fatalf("Expected BijectionError for Dup, got %v", err)
+		}
+		if !g.ContainsName("Keep") || g.Len() != 1 {
+			t.Error("Expected failed unmarshal to leave the Generator unchanged")
+		}
+	})
+
+	t.Run("IsBijective", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Found": 302, "Moved": 302})
+		if g.IsBijective() {
+			t.Error("Expected shared value to break bijection")
+		}
+		g.Remove("Moved")
+		if !g.IsBijective() {
+			t.Error("Expected bijection after removing the second name")
+		}
+	})
+
+	t.Run("Basic", func(t *testing.T) {
+		b := NewBasicRegistry(WithBijective[int]())
+		a := b.Add("A")
+		a.With(1)
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, ErrNotBijective) {
+				t.Errorf("Expected panic with ErrNotBijective, got %v", err)
+			}
+		}()
+		b.Add("B") // The sequence reaches 1, which A now holds.
+	})
+}
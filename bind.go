@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// BindError describes a request parameter that could not be bound to an enum value.
+// It carries the parameter name, the raw input, and the names accepted by the
+// registry so that middleware can render consistent 400 responses.
+type BindError struct {
+	Param string   // Name of the query or form parameter.
+	Input string   // Raw value received, empty if the parameter was missing.
+	Valid []string // Names accepted by the registry, in definition order.
+	Err   error    // Underlying parse error, nil if the parameter was missing.
+}
+
+// Error implements the error interface.
+func (e *BindError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("missing parameter %q: expected one of [%s]", e.Param, strings.Join(e.Valid, ", "))
+	}
+	return fmt.Sprintf("invalid parameter %q value %q: expected one of [%s]", e.Param, e.Input, strings.Join(e.Valid, ", "))
+}
+
+// Unwrap returns the underlying parse error, if any.
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// BindQuery reads the query parameter key from r and parses it with g.
+// A missing or empty parameter is reported as a *BindError, as is a value
+// that does not match any name or value in the registry.
+//
+// Example:
+//
+//	status, err := enum.BindQuery(statuses, r, "status")
+//	if err != nil {
+//	    http.Error(w, err.Error(), http.StatusBadRequest)
+//	    return
+//	}
+func BindQuery[T TypesValue](g *Generator[T], r *http.Request, key string) (Value[T], error) {
+	return bindRequired(g, key, r.URL.Query().Get(key))
+}
+
+// BindQueryOptional is like BindQuery but treats a missing or empty parameter as
+// absent rather than an error. The boolean reports whether the parameter was present.
+func BindQueryOptional[T TypesValue](g *Generator[T], r *http.Request, key string) (Value[T], bool, error) {
+	return bindOptional(g, key, r.URL.Query().Get(key))
+}
+
+// BindForm reads the form parameter key from r and parses it with g.
+// The form is parsed with r.ParseForm, so both the request body and the
+// URL query are consulted, with body values taking precedence.
+func BindForm[T TypesValue](g *Generator[T], r *http.Request, key string) (Value[T], error) {
+	if err := r.ParseForm(); err != nil {
+		return Value[T]{}, &BindError{Param: key, Valid: g.Names(), Err: err}
+	}
+	return bindRequired(g, key, r.Form.Get(key))
+}
+
+// BindFormOptional is like BindForm but treats a missing or empty parameter as
+// absent rather than an error. The boolean reports whether the parameter was present.
+func BindFormOptional[T TypesValue](g *Generator[T], r *http.Request, key string) (Value[T], bool, error) {
+	if err := r.ParseForm(); err != nil {
+		return Value[T]{}, false, &BindError{Param: key, Valid: g.Names(), Err: err}
+	}
+	return bindOptional(g, key, r.Form.Get(key))
+}
+
+// bindRequired parses raw with g, reporting a missing parameter as an error.
+func bindRequired[T TypesValue](g *Generator[T], key, raw string) (Value[T], error) {
+	if raw == "" {
+		return Value[T]{}, &BindError{Param: key, Valid: g.Names()}
+	}
+	v, err := g.Parse(raw)
+	if err != nil {
+		return Value[T]{}, &BindError{Param: key, Input: raw, Valid: g.Names(), Err: err}
+	}
+	return v, nil
+}
+
+// bindOptional parses raw with g, reporting a missing parameter as absent.
+func bindOptional[T TypesValue](g *Generator[T], key, raw string) (Value[T], bool, error) {
+	if raw == "" {
+		return Value[T]{}, false, nil
+	}
+	v, err := bindRequired(g, key, raw)
+	if err != nil {
+		return Value[T]{}, true, err
+	}
+	return v, true, nil
+}
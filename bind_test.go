@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBindQuery(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+
+	t.Run("Present", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks?status=Active", nil)
+		v, err := BindQuery(g, r, "status")
+		if err != nil {
+			t.Fatalf("BindQuery failed: %v", err)
+		}
+		if v.Get() != 2 || v.String() != "Active" {
+			t.Errorf("Expected 2/Active, got %d/%s", v.Get(), v.String())
+		}
+	})
+
+	t.Run("Present by value", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks?status=1", nil)
+		v, err := BindQuery(g, r, "status")
+		if err != nil || v.String() != "Pending" {
+			t.Errorf("Expected Pending, got %q, err: %v", v.String(), err)
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks", nil)
+		_, err := BindQuery(g, r, "status")
+		var be *BindError
+		if !errors.As(err, &be) {
+			t.Fatalf("Expected *BindError, got %v", err)
+		}
+		if be.Param != "status" || be.Err != nil {
+			t.Errorf("Expected missing error for status, got %+v", be)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks?status=Closed", nil)
+		_, err := BindQuery(g, r, "status")
+		var be *BindError
+		if !errors.As(err, &be) {
+			t.Fatalf("Expected *BindError, got %v", err)
+		}
+		if be.Input != "Closed" || be.Err == nil {
+			t.Errorf("Expected invalid error for Closed, got %+v", be)
+		}
+		if len(be.Valid) != 2 {
+			t.Errorf("Expected 2 valid names, got %v", be.Valid)
+		}
+	})
+
+	t.Run("Optional absent", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks", nil)
+		_, ok, err := BindQueryOptional(g, r, "status")
+		if ok || err != nil {
+			t.Errorf("Expected absent without error, got ok=%v err=%v", ok, err)
+		}
+	})
+
+	t.Run("Optional invalid", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/tasks?status=Nope", nil)
+		_, ok, err := BindQueryOptional(g, r, "status")
+		if !ok || err == nil {
+			t.Errorf("Expected present with error, got ok=%v err=%v", ok, err)
+		}
+	})
+}
+
+func TestBindForm(t *testing.T) {
+	g := NewGenerator[string](WithStart("a"))
+	g.Next("First")
+
+	t.Run("Present", func(t *testing.T) {
+		body := url.Values{"kind": {"First"}}.Encode()
+		r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		v, err := BindForm(g, r, "kind")
+		if err != nil || v.Get() != "a" {
+			t.Errorf("Expected a, got %q, err: %v", v.Get(), err)
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(""))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if _, err := BindForm(g, r, "kind"); err == nil {
+			t.Error("Expected error for missing form parameter")
+		}
+		_, ok, err := BindFormOptional(g, r, "kind")
+		if ok || err != nil {
+			t.Errorf("Expected absent without error, got ok=%v err=%v", ok, err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		body := url.Values{"kind": {"Second"}}.Encode()
+		r := httptest.NewRequest("POST", "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		_, err := BindForm(g, r, "kind")
+		var be *BindError
+		if !errors.As(err, &be) {
+			t.Fatalf("Expected *BindError, got %v", err)
+		}
+		if !reflect.DeepEqual(be.Valid, []string{"First"}) {
+			t.Errorf("Expected valid names [First], got %v", be.Valid)
+		}
+	})
+}
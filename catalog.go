@@ -0,0 +1,263 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// CatalogEntry is the API-facing description of one enum value, as returned by Catalog.
+// It marshals to JSON as {"id":0,"code":"PENDING","label":"Pending",...}, followed by
+// "keys":{"alpha2":"US",...} if the value has secondary keys (see Keys).
+type CatalogEntry[T TypesValue] struct {
+	ID          T      `json:"id"`
+	Code        string `json:"code"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Deprecated  bool   `json:"deprecated"`
+
+	// keys holds the secondary keys as a JSON object with sorted members, keeping
+	// CatalogEntry comparable.
+	keys  string
+	flags marshalFlags // JSON encoding options of the Generator the entry describes.
+}
+
+// Keys returns the secondary keys of the entry's value by keyspace (see
+// Generator.AddKey), or nil if it has none.
+func (c CatalogEntry[T]) Keys() map[string]string {
+	if c.keys == "" {
+		return nil
+	}
+	var keys map[string]string
+	_ = json.Unmarshal([]byte(c.keys), &keys) // Always valid: written by encodeKeys or UnmarshalJSON.
+	return keys
+}
+
+// MarshalJSON implements json.Marshaler. The ID is a string for 64-bit integers if the
+// entry comes from a Generator created with WithInt64AsString.
+func (c CatalogEntry[T]) MarshalJSON() ([]byte, error) {
+	id, err := marshalValue(c.ID, c.flags)
+	if err != nil {
+		return nil, err
+	}
+	type plain CatalogEntry[T] // Drops the methods, avoiding recursion.
+	var keys json.RawMessage
+	if c.keys != "" {
+		keys = json.RawMessage(c.keys)
+	}
+	return json.Marshal(struct {
+		ID json.RawMessage `json:"id"`
+		plain
+		Keys json.RawMessage `json:"keys,omitempty"`
+	}{ID: id, plain: plain(c), Keys: keys})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the ID as a JSON number or string.
+func (c *CatalogEntry[T]) UnmarshalJSON(data []byte) error {
+	type plain CatalogEntry[T]
+	aux := struct {
+		ID json.RawMessage `json:"id"`
+		*plain
+		Keys map[string]string `json:"keys"`
+	}{plain: (*plain)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	id, err := unmarshalValue[T](aux.ID)
+	if err != nil {
+		return err
+	}
+	c.ID = id
+	c.keys = ""
+	if len(aux.Keys) > 0 {
+		b, _ := json.Marshal(aux.Keys)
+		c.keys = string(b)
+	}
+	return nil
+}
+
+// CatalogOption configures Catalog.
+type CatalogOption func(*catalogConfig)
+
+// catalogConfig holds the settings applied by CatalogOption values.
+type catalogConfig struct {
+	code       func(string) string
+	locale     string
+	version    string
+	hasVersion bool
+}
+
+// CatalogCode sets the transformation from an entry's name to its Code (e.g., UpperSnake).
+// By default, Code is the name unchanged.
+func CatalogCode(fn func(name string) string) CatalogOption {
+	return func(c *catalogConfig) {
+		c.code = fn
+	}
+}
+
+// CatalogLocale makes Label use the display name for locale (see SetDisplayName),
+// falling back to the name when no translation exists.
+func CatalogLocale(locale string) CatalogOption {
+	return func(c *catalogConfig) {
+		c.locale = locale
+	}
+}
+
+// UpperSnake converts a name to UPPER_SNAKE_CASE (e.g., "MovedTemporarily" ->
+// "MOVED_TEMPORARILY", "in progress" -> "IN_PROGRESS"). It is meant for CatalogCode.
+func UpperSnake(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == ' ' || r == '-' || r == '_' || r == '.':
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteByte('_')
+			}
+			continue
+		case unicode.IsUpper(r) && i > 0 && b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+// Catalog returns one CatalogEntry per value, in entry order, ready to be served as an
+// API DTO. Values with several names appear once, under their canonical name. Label is
+// the name (or its display name, see CatalogLocale) and Description falls back to the name.
+// Secondary keys added with AddKey are included (see CatalogEntry.Keys).
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Pending": 0, "Active": 1})
+//	g.SetDescription(0, "Awaiting payment")
+//	b, _ := json.Marshal(g.Catalog(CatalogCode(UpperSnake)))
+//	// [{"id":0,"code":"PENDING","label":"Pending","description":"Awaiting payment","deprecated":false},
+//	//  {"id":1,"code":"ACTIVE","label":"Active","description":"Active","deprecated":false}]
+func (g *Generator[T]) Catalog(opts ...CatalogOption) []CatalogEntry[T] {
+	var cfg catalogConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	g.rlock()
+	defer g.runlock()
+	pairs := g.pairsLocked(true)
+	out := make([]CatalogEntry[T], 0, len(pairs))
+	for _, pair := range pairs {
+		if cfg.hasVersion && !g.inVersionLocked(pair.Value, cfg.version) {
+			continue
+		}
+		ce := CatalogEntry[T]{
+			ID:          pair.Value,
+			Code:        pair.Name,
+			Label:       pair.Name,
+			Description: pair.Name,
+			Deprecated:  g.deprecated[pair.Value],
+			keys:        g.encodeKeys(pair.Value),
+			flags:       g.marshal,
+		}
+		if cfg.code != nil {
+			ce.Code = cfg.code(pair.Name)
+		}
+		if display, ok := g.display[cfg.locale][pair.Value]; ok && cfg.locale != "" {
+			ce.Label = display
+		}
+		if desc, ok := g.descriptions[pair.Value]; ok {
+			ce.Description = desc
+		}
+		out = append(out, ce)
+	}
+	return out
+}
+
+// SetDescription sets a human-readable description of value, shown by Catalog.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Panics if the value does not exist in the enum set. Use TrySetDescription to receive
+// this condition as an error.
+func (g *Generator[T]) SetDescription(value T, desc string) {
+	if err := g.TrySetDescription(value, desc); err != nil {
+		g.raise(err)
+	}
+}
+
+// TrySetDescription is like SetDescription but returns an error instead of panicking.
+func (g *Generator[T]) TrySetDescription(value T, desc string) error {
+	g.lock()
+	defer g.unlock()
+	if _, ok := g.valueMap[value]; !ok {
+		return fmt.Errorf("enum: cannot set description for unknown value %s", g.formatValue(value))
+	}
+	g.setDescriptionLocked(value, desc)
+	return nil
+}
+
+// setDescriptionLocked stores the description of an existing value. The caller must
+// hold the write lock.
+func (g *Generator[T]) setDescriptionLocked(value T, desc string) {
+	if g.descriptions == nil {
+		g.descriptions = make(map[T]string)
+	}
+	g.descriptions[value] = desc
+	g.journalLocked(journalLine{Op: "describe", Name: g.valueMap[value], Text: desc}, value)
+	g.bump()
+}
+
+// Description returns the description of value set with SetDescription.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Description(value T) (string, bool) {
+	g.rlock()
+	defer g.runlock()
+	desc, ok := g.descriptions[value]
+	return desc, ok
+}
+
+// Deprecate marks value as deprecated. Deprecated values keep working everywhere;
+// the flag is informational and reported by IsDeprecated and Catalog.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Panics if the value does not exist in the enum set. Use TryDeprecate to receive
+// this condition as an error.
+func (g *Generator[T]) Deprecate(value T) {
+	if err := g.TryDeprecate(value); err != nil {
+		g.raise(err)
+	}
+}
+
+// TryDeprecate is like Deprecate but returns an error instead of panicking.
+func (g *Generator[T]) TryDeprecate(value T) error {
+	g.lock()
+	defer g.unlock()
+	if _, ok := g.valueMap[value]; !ok {
+		return fmt.Errorf("enum: cannot deprecate unknown value %s", g.formatValue(value))
+	}
+	g.deprecateLocked(value)
+	return nil
+}
+
+// deprecateLocked marks an existing value as deprecated. The caller must hold the write
+// lock.
+func (g *Generator[T]) deprecateLocked(value T) {
+	if g.deprecated == nil {
+		g.deprecated = make(map[T]bool)
+	}
+	g.deprecated[value] = true
+	g.journalLocked(journalLine{Op: "deprecate", Name: g.valueMap[value]}, value)
+	g.bump()
+}
+
+// IsDeprecated reports whether value was marked with Deprecate.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) IsDeprecated(value T) bool {
+	g.rlock()
+	defer g.runlock()
+	return g.deprecated[value]
+}
@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGenerator_Catalog(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 0, "InProgress": 1, "Done": 2, "Finished": 2}, WithSortedEntries[int]())
+	g.SetDescription(0, "Awaiting payment")
+	g.SetDisplayName(1, "de-DE", "In Bearbeitung")
+	g.Deprecate(2)
+
+	t.Run("Defaults", func(t *testing.T) {
+		c := g.Catalog()
+		if len(c) != 3 {
+			t.Fatalf("Expected one entry per value, got %d", len(c))
+		}
+		want := CatalogEntry[int]{ID: 1, Code: "InProgress", Label: "InProgress", Description: "InProgress"}
+		if c[1] != want {
+			t.Errorf("Expected %+v, got %+v", want, c[1])
+		}
+		if c[0].Description != "Awaiting payment" || !c[2].Deprecated || c[2].Code != "Done" {
+			t.Errorf("Unexpected catalog %+v", c)
+		}
+	})
+
+	t.Run("Options and JSON", func(t *testing.T) {
+		b, err := json.Marshal(g.Catalog(CatalogCode(UpperSnake), CatalogLocale("de-DE")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `[{"id":0,"code":"PENDING","label":"Pending","description":"Awaiting payment","deprecated":false},` +
+			`{"id":1,"code":"IN_PROGRESS","label":"In Bearbeitung","description":"InProgress","deprecated":false},` +
+			`{"id":2,"code":"DONE","label":"Done","description":"Done","deprecated":true}]`
+		if string(b) != want {
+			t.Errorf("Unexpected JSON:\n got %s\nwant %s", b, want)
+		}
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		if d, ok := g.Description(0); !ok || d != "Awaiting payment" {
+			t.Errorf("Unexpected description %q", d)
+		}
+		if !g.IsDeprecated(2) || g.IsDeprecated(0) {
+			t.Error("IsDeprecated returned unexpected results")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for unknown value")
+			}
+		}()
+		g.Deprecate(9)
+	})
+}
+
+func TestUpperSnake(t *testing.T) {
+	tests := map[string]string{
+		"Pending":          "PENDING",
+		"MovedTemporarily": "MOVED_TEMPORARILY",
+		"HTTPStatus":       "HTTP_STATUS",
+		"in progress":      "IN_PROGRESS",
+		"already_SNAKE":    "ALREADY_SNAKE",
+		"Code2Go":          "CODE2_GO",
+		"":                 "",
+	}
+	for in, want := range tests {
+		if got := UpperSnake(in); got != want {
+			t.Errorf("UpperSnake(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
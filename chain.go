@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ChainPolicy decides what a Chain does when an input resolves in more than one of
+// its registries.
+type ChainPolicy int
+
+const (
+	// ChainFirstWins returns the match from the earliest registry in the chain.
+	ChainFirstWins ChainPolicy = iota
+	// ChainRejectAmbiguous fails with an error wrapping ErrAmbiguous, naming the
+	// registries that matched.
+	ChainRejectAmbiguous
+)
+
+// Chain parses input against several Generators in order, for fields that accept values
+// of more than one enum (e.g., a payment status or an order status). Build it once and
+// reuse it; it is immutable and safe for concurrent use.
+//
+// Unlike calling Parse on each Generator in turn, a Chain does not count or log the
+// misses of the registries it probes: WithStats and WithLogger only see the registry
+// whose match is returned, and a miss in every registry is counted by none.
+//
+// Example:
+//
+//	chain := NewChain(ChainRejectAmbiguous, paymentStatus, orderStatus)
+//	v, g, err := chain.Parse("Refunded") // g == paymentStatus
+type Chain[T TypesValue] struct {
+	gens   []*Generator[T]
+	policy ChainPolicy
+}
+
+// NewChain returns a Chain trying gens in the given order, resolving inputs that match
+// several of them according to policy.
+//
+// Panics if a Generator is nil.
+func NewChain[T TypesValue](policy ChainPolicy, gens ...*Generator[T]) *Chain[T] {
+	for i, g := range gens {
+		if g == nil {
+			panic(fmt.Sprintf("enum: NewChain: Generator %d is nil", i))
+		}
+	}
+	return &Chain[T]{gens: append([]*Generator[T](nil), gens...), policy: policy}
+}
+
+// ParseChain parses s with each of gens in order and returns the first match along
+// with the Generator that produced it. It is shorthand for a ChainFirstWins Chain; build
+// a Chain with NewChain to reuse it or to reject ambiguous input.
+//
+// Example:
+//
+//	v, g, err := ParseChain("Pending", paymentStatus, orderStatus)
+//	if err == nil && g == orderStatus {
+//	    // handle legacy order status
+//	}
+func ParseChain[T TypesValue](s string, gens ...*Generator[T]) (Value[T], *Generator[T], error) {
+	return (&Chain[T]{gens: gens}).Parse(s)
+}
+
+// Parse resolves s like Generator.Parse, trying each registry in order, and returns the
+// match along with the Generator that produced it. Returns an error wrapping
+// ErrUnknownValue if no registry matches, or wrapping ErrAmbiguous under
+// ChainRejectAmbiguous if several do.
+func (c *Chain[T]) Parse(s string) (Value[T], *Generator[T], error) {
+	var (
+		found Value[T]
+		owner *Generator[T]
+		index int
+	)
+	for i, g := range c.gens {
+		v, ok := g.probe(s)
+		if !ok {
+			continue
+		}
+		if owner != nil {
+			return Value[T]{}, nil, fmt.Errorf("%w: %q matches %s and %s", ErrAmbiguous, s, chainLabel(owner, index), chainLabel(g, i))
+		}
+		found, owner, index = v, g, i
+		if c.policy == ChainFirstWins {
+			break
+		}
+	}
+	if owner == nil {
+		return Value[T]{}, nil, fmt.Errorf("%w: %q", ErrUnknownValue, s)
+	}
+	if owner.stats != nil {
+		owner.stats.counter(found.value).parses.Add(1)
+	}
+	return found, owner, nil
+}
+
+// Generators returns a copy of the chain's registries, in the order they are tried.
+func (c *Chain[T]) Generators() []*Generator[T] {
+	return append([]*Generator[T](nil), c.gens...)
+}
+
+// probe is Parse without the miss bookkeeping of stats and logging.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) probe(s string) (Value[T], bool) {
+	g.rlock()
+	defer g.runlock()
+	v, err := g.parseLocked(s)
+	return v, err == nil
+}
+
+// chainLabel names the registry at position i of a chain in error messages: its label,
+// or its position if it has none.
+func chainLabel[T TypesValue](g *Generator[T], i int) string {
+	if g.label != "" {
+		return strconv.Quote(g.label)
+	}
+	return "registry #" + strconv.Itoa(i)
+}
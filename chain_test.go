@@ -0,0 +1,70 @@
+package enum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	payment := NewMapped(map[string]int{"Pending": 1, "Refunded": 2}, WithLabel[int]("payment"))
+	order := NewMapped(map[string]int{"Pending": 10, "Shipped": 11})
+
+	t.Run("ParseChain", func(t *testing.T) {
+		v, g, err := ParseChain("Shipped", payment, order)
+		if err != nil || g != order || v.Get() != 11 {
+			t.Errorf("Expected Shipped from order, got %v %p, err: %v", v, g, err)
+		}
+		v, g, err = ParseChain("Pending", payment, order)
+		if err != nil || g != payment || v.Get() != 1 {
+			t.Errorf("Expected first registry to win, got %v, err: %v", v, err)
+		}
+		if _, g, err := ParseChain("Bogus", payment, order); !errors.Is(err, ErrUnknownValue) || g != nil {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+		if _, _, err := ParseChain[int]("Pending"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue for an empty chain, got %v", err)
+		}
+	})
+
+	t.Run("RejectAmbiguous", func(t *testing.T) {
+		chain := NewChain(ChainRejectAmbiguous, payment, order)
+		_, _, err := chain.Parse("Pending")
+		if !errors.Is(err, ErrAmbiguous) || !errors.Is(err, ErrUnknownValue) {
+			t.Fatalf("Expected ErrAmbiguous, got %v", err)
+		}
+		if !strings.Contains(err.Error(), `"payment" and registry #1`) {
+			t.Errorf("Expected error to name both registries, got %v", err)
+		}
+		if v, g, err := chain.Parse("Refunded"); err != nil || g != payment || v.String() != "Refunded" {
+			t.Errorf("Expected unique match, got %v, err: %v", v, err)
+		}
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		a := NewMapped(map[string]int{"A": 1}, WithStats[int]())
+		b := NewMapped(map[string]int{"B": 2}, WithStats[int]())
+		NewChain(ChainFirstWins, a, b).Parse("B")
+		if misses := a.Stats().Misses; len(misses) != 0 {
+			t.Errorf("Expected probing not to count misses, got %v", misses)
+		}
+		if n := b.Stats().Values[0].Parses; n != 1 {
+			t.Errorf("Expected one parse counted on the matching registry, got %d", n)
+		}
+	})
+
+	t.Run("Generators", func(t *testing.T) {
+		chain := NewChain(ChainFirstWins, payment, order)
+		gens := chain.Generators()
+		gens[0] = nil
+		if chain.Generators()[0] != payment {
+			t.Error("Expected Generators to return a copy")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a nil Generator")
+			}
+		}()
+		NewChain[int](ChainFirstWins, nil)
+	})
+}
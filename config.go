@@ -0,0 +1,95 @@
+package enum
+
+// IncrementerKind names the built-in sequence a Generator uses, as reported by Config.
+type IncrementerKind string
+
+const (
+	// IncrementerNone is reported for generators that do not support Next
+	// (NewMapped, NewFromValues, LoadJSON, or after UnmarshalJSON).
+	IncrementerNone IncrementerKind = "none"
+	// IncrementerNumeric is the default for numeric types: add 1 (NewGenerator, NewNumeric).
+	IncrementerNumeric IncrementerKind = "numeric"
+	// IncrementerAlpha is the default for strings: "A", "B", ..., "Z", "AA" (NewAlpha).
+	IncrementerAlpha IncrementerKind = "alpha"
+	// IncrementerBitFlag shifts left by one (NewBitFlagGenerator).
+	IncrementerBitFlag IncrementerKind = "bitflag"
+	// IncrementerCyclic cycles through a fixed number of values (NewCyclic, NewCyclicT).
+	IncrementerCyclic IncrementerKind = "cyclic"
+	// IncrementerPrefixed appends an increasing number to a prefix (NewPrefixed).
+	IncrementerPrefixed IncrementerKind = "prefixed"
+	// IncrementerCustom is a function supplied with WithIncrementer.
+	IncrementerCustom IncrementerKind = "custom"
+)
+
+// GeneratorConfig describes the effective configuration and progress of a Generator,
+// for diagnostics and for reconstructing generators built by the package constructors.
+type GeneratorConfig[T TypesValue] struct {
+	Kind        ValueKind       `json:"kind"`              // Kind of T.
+	Start       T               `json:"start"`             // First value of the sequence (see WithStart).
+	Incrementer IncrementerKind `json:"incrementer"`       // Which sequence Next follows.
+	Modulus     T               `json:"modulus,omitempty"` // Cycle length, for IncrementerCyclic.
+	Prefix      string          `json:"prefix,omitempty"`  // Prefix, for IncrementerPrefixed.
+	Current     T               `json:"current"`           // Value the next call to Next will use.
+	Len         int             `json:"len"`               // Number of entries.
+	Mapped      bool            `json:"mapped"`            // Whether Next is unsupported.
+	Exhausted   bool            `json:"exhausted"`         // Whether the sequence ran out under OverflowError.
+	Overflow    OverflowPolicy  `json:"overflow"`          // See WithOverflowPolicy.
+	Bijective   bool            `json:"bijective"`         // See WithBijective.
+	Label       string          `json:"label,omitempty"`   // See WithLabel.
+	Unknown     string          `json:"unknown,omitempty"` // Sentinel name, see WithUnknown.
+	Version     uint64          `json:"version"`           // See Version.
+}
+
+// Config returns the Generator's effective configuration. It is thread-safe, using a
+// read lock for access.
+//
+// Example:
+//
+//	g := NewCyclicT[uint8](1, 12)
+//	g.Next("Jan")
+//	fmt.Printf("%+v\n", g.Config())
+//	// {Kind:uint8 Start:1 Incrementer:cyclic Modulus:12 Prefix: Current:2 Len:1 Mapped:false ...}
+func (g *Generator[T]) Config() GeneratorConfig[T] {
+	g.rlock()
+	defer g.runlock()
+	cfg := GeneratorConfig[T]{
+		Kind:        g.kind,
+		Start:       g.start,
+		Incrementer: g.incKind,
+		Current:     g.current,
+		Len:         len(g.values),
+		Mapped:      g.incrementer == nil,
+		Exhausted:   g.exhausted,
+		Overflow:    g.overflow,
+		Bijective:   g.bijective,
+		Label:       g.label,
+		Version:     g.version.Load(),
+	}
+	switch cfg.Incrementer {
+	case IncrementerCyclic:
+		cfg.Modulus = g.modulus
+	case IncrementerPrefixed:
+		cfg.Prefix = g.prefix
+	}
+	if g.hasUnknown {
+		cfg.Unknown = g.valueMap[g.unknown]
+	}
+	if cfg.Mapped {
+		cfg.Incrementer = IncrementerNone
+	}
+	return cfg
+}
+
+// withBuiltinIncrementer installs an incrementer of a package constructor, recording
+// its kind for Config. A later WithIncrementer replaces it and reports IncrementerCustom.
+func withBuiltinIncrementer[T TypesValue](inc func(T) T, kind IncrementerKind) Option[T] {
+	return func(g *Generator[T]) {
+		g.incrementer = inc
+		g.incKind = kind
+	}
+}
+
+// isString reports whether T is a string type.
+func isString[T TypesValue]() bool {
+	return kindOf[T]().IsString()
+}
@@ -0,0 +1,63 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGenerator_Config(t *testing.T) {
+	t.Run("Kinds", func(t *testing.T) {
+		tests := []struct {
+			name string
+			kind IncrementerKind
+			got  IncrementerKind
+		}{
+			{"NewGenerator", IncrementerNumeric, NewGenerator[int]().Config().Incrementer},
+			{"NewNumeric", IncrementerNumeric, NewNumeric(1.5).Config().Incrementer},
+			{"NewAlpha", IncrementerAlpha, NewAlpha().Config().Incrementer},
+			{"NewBitFlagGenerator", IncrementerBitFlag, NewBitFlagGenerator(1).Config().Incrementer},
+			{"NewCyclic", IncrementerCyclic, NewCyclic(3).Config().Incrementer},
+			{"NewPrefixed", IncrementerPrefixed, NewPrefixed("dog", 1).Config().Incrementer},
+			{"WithIncrementer", IncrementerCustom, NewGenerator(WithIncrementer(func(v int) int { return v + 2 })).Config().Incrementer},
+			{"NewMapped", IncrementerNone, NewMapped(map[string]int{"A": 1}).Config().Incrementer},
+		}
+		for _, tt := range tests {
+			if tt.got != tt.kind {
+				t.Errorf("%s: expected %s, got %s", tt.name, tt.kind, tt.got)
+			}
+		}
+		custom := NewCyclicT[int](0, 3, WithIncrementer(func(v int) int { return v }))
+		if k := custom.Config().Incrementer; k != IncrementerCustom {
+			t.Errorf("Expected a later WithIncrementer to report custom, got %s", k)
+		}
+	})
+
+	t.Run("Progress", func(t *testing.T) {
+		g := NewCyclicT[uint8](1, 12, WithLabel[uint8]("month"))
+		g.Next("Jan")
+		g.Next("Feb")
+		cfg := g.Config()
+		if cfg.Start != 1 || cfg.Modulus != 12 || cfg.Current != 3 || cfg.Len != 2 || cfg.Label != "month" || cfg.Mapped {
+			t.Errorf("Unexpected config: %+v", cfg)
+		}
+		rebuilt := NewCyclicT(cfg.Start, cfg.Modulus)
+		if rebuilt.Next("Jan").Get() != 1 {
+			t.Error("Expected config to be enough to rebuild the generator")
+		}
+		if p := NewPrefixed("dog", 5).Config(); p.Prefix != "dog" || p.Start != "dog5" {
+			t.Errorf("Unexpected prefixed config: %+v", p)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(NewMapped(map[string]int{"A": 1}).Config())
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"kind":"int","start":0,"incrementer":"none","current":0,"len":1,"mapped":true,"exhausted":false,` +
+			`"overflow":0,"bijective":false,"version":0}`
+		if string(data) != want {
+			t.Errorf("Expected %s, got %s", want, data)
+		}
+	})
+}
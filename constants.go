@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Gap describes a run of missing values in an integer constant set: every value
+// strictly between After and Before is unused.
+type Gap[T TypesValue] struct {
+	After  T
+	Before T
+}
+
+// RegisterConstants bridges existing Go constants (e.g., an iota block) to a Generator,
+// giving them names for lookup, Parse, and JSON without changing their values.
+// Unlike NewMapped, it rejects constants that share a value; the error names every
+// offending pair. For integer types, it also reports the gaps in the sequence, which
+// are informational and not an error.
+//
+// The returned Generator behaves like one created with NewMapped: Next is not supported.
+//
+// Example:
+//
+//	const (
+//		Pending = iota
+//		Active
+//		Closed = 5
+//	)
+//	g, gaps, err := RegisterConstants(map[string]int{"Pending": Pending, "Active": Active, "Closed": Closed})
+//	// gaps: [{After: 1, Before: 5}], err: nil
+func RegisterConstants[T TypesValue](pairs map[string]T) (*Generator[T], []Gap[T], error) {
+	names := make([]string, 0, len(pairs))
+	for name := range pairs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	owner := make(map[T]string, len(pairs))
+	for _, name := range names {
+		value := pairs[name]
+		if isNaN(value) {
+			errs = append(errs, fmt.Errorf("enum.RegisterConstants: constant %q is NaN", name))
+			continue
+		}
+		if first, ok := owner[value]; ok {
+			errs = append(errs, fmt.Errorf("enum.RegisterConstants: constants %q and %q both have value %v", first, name, value))
+			continue
+		}
+		owner[value] = name
+	}
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	g := NewMapped(pairs, WithSortedEntries[T]())
+	return g, constantGaps(g.values), nil
+}
+
+// MustRegisterConstants is like RegisterConstants but panics on error and discards
+// the gap report. It is intended for package-level variable initialization.
+//
+// Example:
+//
+//	var Status = MustRegisterConstants(map[string]int{"Pending": Pending, "Active": Active})
+func MustRegisterConstants[T TypesValue](pairs map[string]T) *Generator[T] {
+	g, _, err := RegisterConstants(pairs)
+	if err != nil {
+		panic(err.Error())
+	}
+	return g
+}
+
+// constantGaps returns the gaps between consecutive values of sorted integer entries.
+// It returns nil for non-integer types, where gaps are not meaningful.
+func constantGaps[T TypesValue](sorted []Value[T]) []Gap[T] {
+	if !isInteger[T]() {
+		return nil
+	}
+	var gaps []Gap[T]
+	for i := 1; i < len(sorted); i++ {
+		prev, curr := sorted[i-1].value, sorted[i].value
+		if defaultIncrementer(prev) < curr {
+			gaps = append(gaps, Gap[T]{After: prev, Before: curr})
+		}
+	}
+	return gaps
+}
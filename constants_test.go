@@ -0,0 +1,72 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	constPending = iota
+	constActive
+	_
+	constClosed
+	constArchived = 10
+)
+
+func TestRegisterConstants(t *testing.T) {
+	t.Run("Valid with gaps", func(t *testing.T) {
+		g, gaps, err := RegisterConstants(map[string]int{
+			"Pending":  constPending,
+			"Active":   constActive,
+			"Closed":   constClosed,
+			"Archived": constArchived,
+		})
+		if err != nil {
+			t.Fatalf("RegisterConstants failed: %v", err)
+		}
+		if v, err := g.Parse("Closed"); err != nil || v.Get() != constClosed {
+			t.Errorf("Expected Closed to parse to %d, got %d, err: %v", constClosed, v.Get(), err)
+		}
+		if len(gaps) != 2 || gaps[0] != (Gap[int]{After: 1, Before: 3}) || gaps[1] != (Gap[int]{After: 3, Before: 10}) {
+			t.Errorf("Unexpected gaps %v", gaps)
+		}
+	})
+
+	t.Run("Contiguous", func(t *testing.T) {
+		_, gaps, err := RegisterConstants(map[string]uint8{"A": 0, "B": 1, "C": 2})
+		if err != nil || gaps != nil {
+			t.Errorf("Expected no gaps and no error, got %v, %v", gaps, err)
+		}
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		_, _, err := RegisterConstants(map[string]int{"A": 1, "B": 1, "C": 2, "D": 2})
+		if err == nil {
+			t.Fatal("Expected duplicate error")
+		}
+		for _, want := range []string{`"A" and "B"`, `"C" and "D"`} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to name %s, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("Strings have no gaps", func(t *testing.T) {
+		_, gaps, err := RegisterConstants(map[string]string{"Red": "r", "Blue": "z"})
+		if err != nil || gaps != nil {
+			t.Errorf("Expected no gaps, got %v, %v", gaps, err)
+		}
+	})
+
+	t.Run("Must", func(t *testing.T) {
+		if g := MustRegisterConstants(map[string]int{"A": 0}); !g.Contains(0) {
+			t.Error("Expected A to be registered")
+		}
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic on duplicate values")
+			}
+		}()
+		MustRegisterConstants(map[string]int{"A": 0, "B": 0})
+	})
+}
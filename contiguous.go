@@ -0,0 +1,124 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NewContiguous creates a Generator assigning 0..len(names)-1 to names, in slice order,
+// for enums used as indexes into fixed-size slices or arrays. Like NewFromSlice, it keeps
+// its incrementer, so Next continues the sequence without gaps; CheckContiguous verifies
+// the invariant later, and IncludeNameArray exports the names as a dense array.
+//
+// Returns an error listing every name that is empty, has leading or trailing whitespace,
+// is repeated, or is a number other than its own value (see WithLiteralNameCheck).
+//
+// Example:
+//
+//	type Weekday int
+//	days, err := NewContiguous[Weekday]([]string{"Mon", "Tue", "Wed"})
+//	labels := [3]string{}
+//	for _, v := range days.Values() {
+//	    labels[v.Get()] = v.String()
+//	}
+func NewContiguous[T ~int](names []string) (*Generator[T], error) {
+	g := NewGenerator[T](
+		WithStart(T(0)),
+		withBuiltinIncrementer(func(x T) T { return x + 1 }, IncrementerNumeric),
+		WithLiteralNameCheck[T](),
+	)
+	var errs []error
+	for i, name := range names {
+		if _, err := NewValueChecked(0, name); err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+			continue
+		}
+		if _, err := g.TryNext(name); err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return g, nil
+}
+
+// ContiguityError is returned by CheckContiguous when the values of a Generator are not
+// exactly 0..n-1, where n is the number of distinct values. Each list is sorted.
+type ContiguityError[T TypesValue] struct {
+	Missing []T // Values in 0..n-1 that no entry has.
+	Extra   []T // Values outside 0..n-1, including negative ones.
+	Shared  []T // Values with more than one name.
+}
+
+// Error implements the error interface.
+func (e *ContiguityError[T]) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("missing %v", e.Missing))
+	}
+	if len(e.Extra) > 0 {
+		parts = append(parts, fmt.Sprintf("out of range %v", e.Extra))
+	}
+	if len(e.Shared) > 0 {
+		parts = append(parts, fmt.Sprintf("shared by several names %v", e.Shared))
+	}
+	return "enum: values are not contiguous from 0: " + strings.Join(parts, "; ")
+}
+
+// CheckContiguous reports whether the values form 0..n-1 with no gaps and no value
+// shared by several names, as indexing a slice by value requires. It returns nil if
+// they do, a *ContiguityError listing the missing, out-of-range, and shared values if
+// they do not, or a plain error if T is not an integer type. An empty Generator is
+// contiguous. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"A": 0, "B": 2, "C": -1})
+//	err := g.CheckContiguous() // missing [1]; out of range [-1 2]
+func (g *Generator[T]) CheckContiguous() error {
+	g.rlock()
+	defer g.runlock()
+	return g.checkContiguousLocked()
+}
+
+// checkContiguousLocked implements CheckContiguous. The caller must hold the lock.
+func (g *Generator[T]) checkContiguousLocked() error {
+	if !isInteger[T]() {
+		var zero T
+		return fmt.Errorf("enum: contiguity is only defined for integer types, not %T", zero)
+	}
+	values := make([]T, 0, len(g.valueMap))
+	for value := range g.valueMap {
+		values = append(values, value)
+	}
+	var shared []T
+	for value, names := range g.extraNames {
+		if len(names) > 0 {
+			shared = append(shared, value)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	sort.Slice(shared, func(i, j int) bool { return shared[i] < shared[j] })
+
+	var zero T
+	e := &ContiguityError[T]{Shared: shared}
+	i := 0
+	for ; i < len(values) && values[i] < zero; i++ {
+		e.Extra = append(e.Extra, values[i])
+	}
+	for n := 0; n < len(values); n++ {
+		if next := fromMask[T](uint64(n)); i < len(values) && values[i] == next {
+			i++
+		} else {
+			e.Missing = append(e.Missing, next)
+		}
+	}
+	e.Extra = append(e.Extra, values[i:]...)
+	if len(e.Missing) == 0 && len(e.Extra) == 0 && len(e.Shared) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,79 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewContiguous(t *testing.T) {
+	type Weekday int
+
+	t.Run("Assigns indexes", func(t *testing.T) {
+		g, err := NewContiguous[Weekday]([]string{"Mon", "Tue", "Wed"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Pair[Weekday]{{0, "Mon"}, {1, "Tue"}, {2, "Wed"}}
+		if got := g.Pairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		g.Next("Thu")
+		if err := g.CheckContiguous(); err != nil {
+			t.Errorf("Expected Next to keep the sequence contiguous, got %v", err)
+		}
+	})
+
+	t.Run("Invalid names", func(t *testing.T) {
+		_, err := NewContiguous[Weekday]([]string{"Mon", "", "Mon", "7"})
+		if !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("Expected ErrInvalidName, got %v", err)
+		}
+		for _, want := range []string{"element 1", "element 2", "element 3"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to mention %q, got %v", want, err)
+			}
+		}
+	})
+}
+
+func TestGenerator_CheckContiguous(t *testing.T) {
+	check := func(t *testing.T, g *Generator[int], missing, extra, shared []int) {
+		t.Helper()
+		var ce *ContiguityError[int]
+		if err := g.CheckContiguous(); !errors.As(err, &ce) {
+			t.Fatalf("Expected *ContiguityError, got %v", err)
+		}
+		if !reflect.DeepEqual(ce.Missing, missing) || !reflect.DeepEqual(ce.Extra, extra) || !reflect.DeepEqual(ce.Shared, shared) {
+			t.Errorf("Expected missing %v, extra %v, shared %v; got %+v", missing, extra, shared, ce)
+		}
+	}
+
+	t.Run("Contiguous", func(t *testing.T) {
+		if err := NewMapped(map[string]int{"B": 1, "A": 0, "C": 2}).CheckContiguous(); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+		if err := NewGenerator[int]().CheckContiguous(); err != nil {
+			t.Errorf("Expected empty Generator to be contiguous, got %v", err)
+		}
+	})
+
+	t.Run("Gap", func(t *testing.T) {
+		check(t, NewMapped(map[string]int{"A": 0, "B": 1, "D": 3}), []int{2}, []int{3}, nil)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		check(t, NewMapped(map[string]int{"Neg": -1, "A": 0}), []int{1}, []int{-1}, nil)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		check(t, NewMapped(map[string]int{"A": 0, "B": 1, "Also": 1}), nil, nil, []int{1})
+	})
+
+	t.Run("Non-integer", func(t *testing.T) {
+		if err := NewMapped(map[string]string{"A": "a"}).CheckContiguous(); err == nil {
+			t.Error("Expected an error for string values")
+		}
+	})
+}
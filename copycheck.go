@@ -0,0 +1,49 @@
+package enum
+
+// noCopy may be embedded in structs that must not be copied after first use, so that
+// go vet's copylocks check reports copies. See https://golang.org/issues/8005.
+type noCopy struct{}
+
+// Lock is a no-op used by go vet's copylocks check.
+func (*noCopy) Lock() {}
+
+// Unlock is a no-op used by go vet's copylocks check.
+func (*noCopy) Unlock() {}
+
+// copyCheck panics if g is a copy of another Generator, like strings.Builder does:
+// a copy shares the original's maps but not its lock, so using it would corrupt both.
+// Constructors record the Generator's address; a zero Generator claims it on first use.
+func (g *Generator[T]) copyCheck() {
+	if self := g.self.Load(); self == g || (self == nil && g.self.CompareAndSwap(nil, g)) {
+		return
+	}
+	if g.self.Load() != g {
+		panic("enum: Generator copied after first use; pass *Generator instead")
+	}
+}
+
+// lock acquires the write lock after checking that g is not a copy.
+func (g *Generator[T]) lock() {
+	g.copyCheck()
+	g.mu.Lock()
+}
+
+// unlock releases the write lock, then writes the journal lines queued under it.
+func (g *Generator[T]) unlock() {
+	j := g.journal
+	g.mu.Unlock()
+	if j != nil {
+		g.flush(j)
+	}
+}
+
+// rlock acquires the read lock after checking that g is not a copy.
+func (g *Generator[T]) rlock() {
+	g.copyCheck()
+	g.mu.RLock()
+}
+
+// runlock releases the read lock.
+func (g *Generator[T]) runlock() {
+	g.mu.RUnlock()
+}
@@ -0,0 +1,116 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxListedInvalid caps the invalid elements a ValuesError lists in its message.
+const maxListedInvalid = 10
+
+// InvalidValue describes an element of a JSON array that DecodeValues rejected.
+type InvalidValue struct {
+	Index int    // Position of the element in the array.
+	Input string // The element as it appeared in the JSON input.
+	Err   error  // Why it was rejected; wraps ErrUnknownValue if it is not registered.
+}
+
+// ValuesError reports every invalid element of a JSON array decoded by DecodeValues.
+// It unwraps to the errors of the elements, so errors.Is(err, ErrUnknownValue) holds if
+// any element is not registered.
+type ValuesError struct {
+	Total   int            // Number of elements in the array.
+	Invalid []InvalidValue // Invalid elements, in array order.
+}
+
+// Error implements the error interface, listing the first invalid elements.
+func (e *ValuesError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "enum: %d of %d values are invalid:", len(e.Invalid), e.Total)
+	for i, inv := range e.Invalid {
+		if i == maxListedInvalid {
+			fmt.Fprintf(&b, " and %d more", len(e.Invalid)-i)
+			break
+		}
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		fmt.Fprintf(&b, " [%d] %s: %v", inv.Index, inv.Input, inv.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the invalid elements.
+func (e *ValuesError) Unwrap() []error {
+	errs := make([]error, len(e.Invalid))
+	for i, inv := range e.Invalid {
+		errs[i] = inv.Err
+	}
+	return errs
+}
+
+// DecodeValues reads the next JSON value from dec, which must be an array of enum
+// values (or null, yielding nil), and returns them with their canonical names. Elements
+// take the forms Value.UnmarshalJSON accepts, such as 2 or "2" for integers, and are
+// checked against the registry under a single lock, so decoding a large array is much
+// faster than unmarshaling into []Value[T] and validating each element.
+// It is thread-safe, using a read lock for access.
+//
+// Every element is checked: if any is invalid, DecodeValues returns nil and a
+// *ValuesError listing all of them. Other errors come from reading dec.
+//
+// Example:
+//
+//	var req struct {
+//		Statuses json.RawMessage `json:"statuses"`
+//	}
+//	json.NewDecoder(r.Body).Decode(&req)
+//	statuses, err := g.DecodeValues(json.NewDecoder(bytes.NewReader(req.Statuses)))
+//	// err: enum: 2 of 4 values are invalid: [1] 9: invalid enum value; [3] "x": ...
+func (g *Generator[T]) DecodeValues(dec *json.Decoder) ([]Value[T], error) {
+	var elems []json.RawMessage
+	if err := dec.Decode(&elems); err != nil {
+		return nil, fmt.Errorf("enum: cannot decode values: %w", err)
+	}
+	if elems == nil {
+		return nil, nil
+	}
+
+	values := make([]Value[T], len(elems))
+	var invalid []InvalidValue
+	g.rlock()
+	defer g.runlock()
+	for i, elem := range elems {
+		val, err := decodeElement[T](elem)
+		if err == nil {
+			if name, ok := g.valueMap[val]; ok {
+				values[i] = g.entry(val, name)
+				continue
+			}
+			err = ErrUnknownValue
+		}
+		invalid = append(invalid, InvalidValue{Index: i, Input: string(elem), Err: err})
+	}
+	if invalid != nil {
+		return nil, &ValuesError{Total: len(elems), Invalid: invalid}
+	}
+	return values, nil
+}
+
+// decodeElement decodes one array element for DecodeValues like unmarshalValue, but
+// parses bare numbers and quoted strings without escapes directly.
+func decodeElement[T comparable](elem json.RawMessage) (T, error) {
+	switch {
+	case isJSONNumber(elem):
+		return parseStringToValue[T](string(elem))
+	case len(elem) >= 2 && elem[0] == '"' && bytes.IndexByte(elem[1:len(elem)-1], '\\') < 0:
+		return parseStringToValue[T](string(elem[1 : len(elem)-1]))
+	case string(elem) == "null":
+		var zero T
+		return zero, errors.New("null is not a value")
+	}
+	return unmarshalValue[T](elem)
+}
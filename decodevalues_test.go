@@ -0,0 +1,134 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_DecodeValues(t *testing.T) {
+	g := NewGenerator[int]()
+	g.Next("Pending") // 0
+	g.Next("Active")  // 1
+	g.Next("Done")    // 2
+
+	t.Run("Valid", func(t *testing.T) {
+		dec := json.NewDecoder(strings.NewReader(`[0, 2, "1", 1] [2]`))
+		got, err := g.DecodeValues(dec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Value[int]{NewValue(0, "Pending"), NewValue(2, "Done"), NewValue(1, "Active"), NewValue(1, "Active")}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if got, err := g.DecodeValues(dec); err != nil || len(got) != 1 {
+			t.Errorf("Expected the decoder to continue with the next array, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		got, err := g.DecodeValues(json.NewDecoder(strings.NewReader(`null`)))
+		if err != nil || got != nil {
+			t.Errorf("Expected nil, nil, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("AllInvalidReported", func(t *testing.T) {
+		got, err := g.DecodeValues(json.NewDecoder(strings.NewReader(`[0, 9, 1, "x", null, 2.5]`)))
+		if got != nil {
+			t.Errorf("Expected no values on error, got %v", got)
+		}
+		var verr *ValuesError
+		if !errors.As(err, &verr) {
+			t.Fatalf("Expected *ValuesError, got %v", err)
+		}
+		var indices []int
+		for _, inv := range verr.Invalid {
+			indices = append(indices, inv.Index)
+		}
+		if verr.Total != 6 || !reflect.DeepEqual(indices, []int{1, 3, 4, 5}) {
+			t.Errorf("Expected 4 of 6 invalid at [1 3 4 5], got %d of %d at %v", len(indices), verr.Total, indices)
+		}
+		if verr.Invalid[1].Input != `"x"` {
+			t.Errorf("Expected the raw input, got %s", verr.Invalid[1].Input)
+		}
+		if !errors.Is(err, ErrUnknownValue) {
+			t.Error("Expected the error to wrap ErrUnknownValue")
+		}
+		if msg := err.Error(); !strings.HasPrefix(msg, "enum: 4 of 6 values are invalid: [1] 9: invalid enum value; [3]") {
+			t.Errorf("Unexpected message %q", msg)
+		}
+	})
+
+	t.Run("LongListTruncated", func(t *testing.T) {
+		_, err := g.DecodeValues(json.NewDecoder(strings.NewReader(`[` + strings.Repeat(`7,`, 14) + `7]`)))
+		if msg := err.Error(); strings.Count(msg, "[") != maxListedInvalid || !strings.HasSuffix(msg, " and 5 more") {
+			t.Errorf("Expected %d listed elements and a count of the rest, got %q", maxListedInvalid, msg)
+		}
+	})
+
+	t.Run("NotArray", func(t *testing.T) {
+		for _, in := range []string{`{"a":1}`, `3`, `[1,`, ``} {
+			if _, err := g.DecodeValues(json.NewDecoder(strings.NewReader(in))); err == nil {
+				t.Errorf("Expected an error for %q", in)
+			}
+		}
+	})
+
+	t.Run("StringValues", func(t *testing.T) {
+		s := NewMapped(map[string]string{"Red": "r", "Escaped": "a\"b"})
+		got, err := s.DecodeValues(json.NewDecoder(strings.NewReader(`["r", "a\"b"]`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []Value[string]{NewValue("r", "Red"), NewValue("a\"b", "Escaped")}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+}
+
+func BenchmarkGenerator_DecodeValues(b *testing.B) {
+	g := NewGenerator[int]()
+	for _, name := range []string{"Pending", "Active", "Shipped", "Done", "Cancelled"} {
+		g.Next(name)
+	}
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i := 0; i < 10000; i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.Itoa(i % 5))
+	}
+	sb.WriteByte(']')
+	data := sb.String()
+
+	b.Run("DecodeValues", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := g.DecodeValues(json.NewDecoder(strings.NewReader(data))); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("PerElement", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var values []Value[int]
+			if err := json.Unmarshal([]byte(data), &values); err != nil {
+				b.Fatal(err)
+			}
+			for j, v := range values {
+				name, ok := g.Name(v.Get())
+				if !ok {
+					b.Fatal(ErrUnknownValue)
+				}
+				values[j] = NewValue(v.Get(), name)
+			}
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// DiffKind classifies a change between two generations of an enum.
+type DiffKind int
+
+const (
+	// DiffAdded marks a value present only in the newer generation.
+	DiffAdded DiffKind = iota + 1
+	// DiffRemoved marks a value present only in the older generation.
+	DiffRemoved
+	// DiffRenamed marks a value whose canonical name changed.
+	DiffRenamed
+)
+
+// String returns the lowercase name of the kind, as used in the JSON format.
+func (k DiffKind) String() string {
+	switch k {
+	case DiffAdded:
+		return "added"
+	case DiffRemoved:
+		return "removed"
+	case DiffRenamed:
+		return "renamed"
+	}
+	return fmt.Sprintf("DiffKind(%d)", int(k))
+}
+
+// DiffFormat selects the output of EnumDiff.Format.
+type DiffFormat int
+
+const (
+	// DiffText renders one line per change, e.g. "+ Added Archived=7",
+	// "- Removed Draft=1", or "~ Renamed 3: Closed -> Completed".
+	DiffText DiffFormat = iota
+	// DiffJSON renders a JSON array of {"kind","value","name","old_name"} objects.
+	DiffJSON
+)
+
+// DiffChange is one change reported by Diff. For DiffRenamed, OldName holds the
+// previous name and Name the new one; for DiffRemoved, Name is the removed name.
+type DiffChange[T TypesValue] struct {
+	Kind    DiffKind
+	Value   T
+	Name    string
+	OldName string
+}
+
+// EnumDiff lists the changes between two generations of an enum, ordered by value.
+type EnumDiff[T TypesValue] struct {
+	Changes []DiffChange[T]
+}
+
+// Diff compares g (the older generation) with other (the newer one), matching entries
+// by value and comparing canonical names. A value whose name moved to another value is
+// reported as removed and added. It is thread-safe, using read locks for access.
+//
+// Example:
+//
+//	old, _ := LoadJSON[int](oldFile)
+//	cur, _ := LoadJSON[int](newFile)
+//	err := old.Diff(cur).Format(os.Stdout, DiffText)
+//	// - Removed Draft=1
+//	// ~ Renamed 3: Closed -> Completed
+//	// + Added Archived=7
+func (g *Generator[T]) Diff(other *Generator[T]) EnumDiff[T] {
+	before, after := g.ValueMap(), other.ValueMap()
+	var changes []DiffChange[T]
+	for value, name := range before {
+		newName, ok := after[value]
+		switch {
+		case !ok:
+			changes = append(changes, DiffChange[T]{Kind: DiffRemoved, Value: value, Name: name})
+		case newName != name:
+			changes = append(changes, DiffChange[T]{Kind: DiffRenamed, Value: value, Name: newName, OldName: name})
+		}
+	}
+	for value, name := range after {
+		if _, ok := before[value]; !ok {
+			changes = append(changes, DiffChange[T]{Kind: DiffAdded, Value: value, Name: name})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Value < changes[j].Value
+	})
+	return EnumDiff[T]{Changes: changes}
+}
+
+// Empty reports whether the diff has no changes.
+func (d EnumDiff[T]) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// Format writes the diff to w in the given format, with changes ordered by value.
+// An empty diff writes nothing in DiffText and "[]" in DiffJSON.
+//
+// Returns an error if the format is unknown or writing to w fails.
+func (d EnumDiff[T]) Format(w io.Writer, format DiffFormat) error {
+	switch format {
+	case DiffText:
+		for _, c := range d.Changes {
+			var err error
+			switch c.Kind {
+			case DiffAdded:
+				_, err = fmt.Fprintf(w, "+ Added %s=%s\n", c.Name, formatKey(c.Value))
+			case DiffRemoved:
+				_, err = fmt.Fprintf(w, "- Removed %s=%s\n", c.Name, formatKey(c.Value))
+			case DiffRenamed:
+				_, err = fmt.Fprintf(w, "~ Renamed %s: %s -> %s\n", formatKey(c.Value), c.OldName, c.Name)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case DiffJSON:
+		type change struct {
+			Kind    string `json:"kind"`
+			Value   T      `json:"value"`
+			Name    string `json:"name"`
+			OldName string `json:"old_name,omitempty"`
+		}
+		out := make([]change, 0, len(d.Changes))
+		for _, c := range d.Changes {
+			out = append(out, change{Kind: c.Kind.String(), Value: c.Value, Name: c.Name, OldName: c.OldName})
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	return fmt.Errorf("enum: unknown diff format %d", int(format))
+}
@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// checkGolden compares got with testdata/name, rewriting the file under -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Output differs from %s:\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+func TestGenerator_Diff(t *testing.T) {
+	older, err := LoadJSON[int](strings.NewReader(`{"1":"Draft","2":"Open","3":"Closed","5":"Held"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newer, err := LoadJSON[int](strings.NewReader(`{"2":"Open","3":"Completed","5":"Held","7":"Archived","8":"Draft"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := older.Diff(newer)
+
+	t.Run("Changes", func(t *testing.T) {
+		want := []DiffChange[int]{
+			{Kind: DiffRemoved, Value: 1, Name: "Draft"},
+			{Kind: DiffRenamed, Value: 3, Name: "Completed", OldName: "Closed"},
+			{Kind: DiffAdded, Value: 7, Name: "Archived"},
+			{Kind: DiffAdded, Value: 8, Name: "Draft"},
+		}
+		if len(d.Changes) != len(want) {
+			t.Fatalf("Expected %d changes, got %+v", len(want), d.Changes)
+		}
+		for i := range want {
+			if d.Changes[i] != want[i] {
+				t.Errorf("Change %d: expected %+v, got %+v", i, want[i], d.Changes[i])
+			}
+		}
+		if !older.Diff(older.Clone()).Empty() {
+			t.Error("Expected no changes against a clone")
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := d.Format(&buf, DiffText); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "diff.golden.txt", buf.Bytes())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := d.Format(&buf, DiffJSON); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "diff.golden.json", buf.Bytes())
+	})
+
+	t.Run("Unknown format", func(t *testing.T) {
+		if err := d.Format(&bytes.Buffer{}, DiffFormat(9)); err == nil {
+			t.Error("Expected error for unknown format")
+		}
+	})
+}
+
+func TestLoadJSON(t *testing.T) {
+	g, err := LoadJSON[float64](strings.NewReader(`{"0.5":"Half","1":"One"}`))
+	if err != nil || g.Len() != 2 {
+		t.Fatalf("Expected 2 entries, got %v, err: %v", g, err)
+	}
+	if _, err := g.TryNext("More"); err == nil {
+		t.Error("Expected loaded Generator not to support Next")
+	}
+	if _, err := LoadJSON[int](strings.NewReader(`{"x":"Bad"}`)); err == nil {
+		t.Error("Expected error for invalid key")
+	}
+}
@@ -0,0 +1,155 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// SetDisplayName sets the localized display name of value for locale (e.g., "de-DE").
+// Display names are presentation data: they do not affect Name, Get, or the default
+// JSON form. It is thread-safe, using a write lock to protect state modifications.
+//
+// Panics if the value does not exist in the enum set. Use TrySetDisplayName to receive
+// this condition as an error.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Pending": 1})
+//	g.SetDisplayName(1, "de-DE", "Ausstehend")
+//	fmt.Println(g.DisplayName(1, "de-DE")) // Output: Ausstehend true
+//	fmt.Println(g.DisplayName(1, "fr-FR")) // Output: Pending true
+func (g *Generator[T]) SetDisplayName(value T, locale, display string) {
+	if err := g.TrySetDisplayName(value, locale, display); err != nil {
+		g.raise(err)
+	}
+}
+
+// TrySetDisplayName is like SetDisplayName but returns an error instead of panicking.
+func (g *Generator[T]) TrySetDisplayName(value T, locale, display string) error {
+	g.lock()
+	defer g.unlock()
+	if _, ok := g.valueMap[value]; !ok {
+		return fmt.Errorf("enum: cannot set display name for unknown value %s", g.formatValue(value))
+	}
+	g.setDisplayName(value, locale, display)
+	return nil
+}
+
+// DisplayName returns the display name of value for locale, falling back to the
+// canonical name when no translation exists. Returns false if the value does not exist.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) DisplayName(value T, locale string) (string, bool) {
+	g.rlock()
+	defer g.runlock()
+	name, ok := g.valueMap[value]
+	if !ok {
+		return "", false
+	}
+	if display, ok := g.display[locale][value]; ok {
+		return display, true
+	}
+	if g.logger != nil {
+		g.log(slog.LevelDebug, "enum display name fallback", slog.Any(LogKeyValue, value), slog.String(LogKeyLocale, locale))
+	}
+	return name, true
+}
+
+// LoadDisplayNames sets display names for locale in bulk from a map keyed by
+// canonical name. The load is atomic: if any name is unknown, nothing is changed
+// and the returned error lists every unknown name.
+// It is thread-safe, using a write lock to protect state modifications.
+func (g *Generator[T]) LoadDisplayNames(locale string, m map[string]string) error {
+	g.lock()
+	defer g.unlock()
+
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if _, ok := g.nameMap[name]; !ok {
+			errs = append(errs, fmt.Errorf("%w: name %q", ErrUnknownValue, name))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	for _, name := range names {
+		g.setDisplayName(g.nameMap[name], locale, m[name])
+	}
+	return nil
+}
+
+// ParseLocale is like Parse but also accepts display names registered for locale.
+// The returned Value carries the canonical name. If several values share the display
+// name, the first in entry order wins. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ParseLocale(s, locale string) (Value[T], error) {
+	if v, err := g.Parse(s); err == nil {
+		return v, nil
+	}
+	g.rlock()
+	defer g.runlock()
+	names := g.display[locale]
+	for _, entry := range g.values { // Entry order, so a display name shared by several values resolves to the first.
+		if display, ok := names[entry.value]; ok && display == s {
+			return g.entry(entry.value, g.valueMap[entry.value]), nil
+		}
+	}
+	return Value[T]{}, fmt.Errorf("no matching enum value for %q in locale %q", s, locale)
+}
+
+// verboseEntry is the element type of the verbose JSON form.
+type verboseEntry struct {
+	Value   json.RawMessage   `json:"value"`
+	Name    string            `json:"name"`
+	Display map[string]string `json:"display,omitempty"`
+}
+
+// MarshalVerboseJSON serializes the Generator as an array of objects in entry order,
+// each holding the value, the canonical name, and any display names by locale:
+//
+//	[{"value":1,"name":"Pending","display":{"de-DE":"Ausstehend"}}]
+//
+// Unlike MarshalJSON, this form includes presentation data. It is thread-safe,
+// using a read lock for access.
+func (g *Generator[T]) MarshalVerboseJSON() ([]byte, error) {
+	g.rlock()
+	defer g.runlock()
+	out := make([]verboseEntry, len(g.values))
+	for i, entry := range g.values {
+		value, err := marshalValue(entry.value, g.marshal)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = verboseEntry{Value: value, Name: entry.name}
+		for locale, names := range g.display {
+			if display, ok := names[entry.value]; ok {
+				if out[i].Display == nil {
+					out[i].Display = make(map[string]string)
+				}
+				out[i].Display[locale] = display
+			}
+		}
+	}
+	return json.Marshal(out)
+}
+
+// setDisplayName stores a display name of an existing value. The caller must hold the
+// write lock.
+func (g *Generator[T]) setDisplayName(value T, locale, display string) {
+	if g.display == nil {
+		g.display = make(map[string]map[T]string)
+	}
+	if g.display[locale] == nil {
+		g.display[locale] = make(map[T]string)
+	}
+	g.display[locale][value] = display
+	g.journalLocked(journalLine{Op: "display", Name: g.valueMap[value], Locale: locale, Text: display}, value)
+	g.bump()
+}
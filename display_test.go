@@ -0,0 +1,144 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_DisplayName(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+	g.SetDisplayName(1, "de-DE", "Ausstehend")
+
+	t.Run("Lookup and fallback", func(t *testing.T) {
+		if d, ok := g.DisplayName(1, "de-DE"); !ok || d != "Ausstehend" {
+			t.Errorf("Expected Ausstehend, got %q", d)
+		}
+		if d, ok := g.DisplayName(2, "de-DE"); !ok || d != "Active" {
+			t.Errorf("Expected fallback to Active, got %q", d)
+		}
+		if d, ok := g.DisplayName(1, "fr-FR"); !ok || d != "Pending" {
+			t.Errorf("Expected fallback to Pending, got %q", d)
+		}
+		if _, ok := g.DisplayName(9, "de-DE"); ok {
+			t.Error("Expected unknown value to fail")
+		}
+	})
+
+	t.Run("SetDisplayName unknown value", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for unknown value")
+			}
+		}()
+		g.SetDisplayName(9, "de-DE", "Neun")
+	})
+
+	t.Run("LoadDisplayNames", func(t *testing.T) {
+		if err := g.LoadDisplayNames("fr-FR", map[string]string{"Pending": "En attente", "Active": "Actif"}); err != nil {
+			t.Fatalf("LoadDisplayNames failed: %v", err)
+		}
+		if d, _ := g.DisplayName(2, "fr-FR"); d != "Actif" {
+			t.Errorf("Expected Actif, got %q", d)
+		}
+		err := g.LoadDisplayNames("es-ES", map[string]string{"Active": "Activo", "Closed": "Cerrado"})
+		if !errors.Is(err, ErrUnknownValue) || !strings.Contains(err.Error(), "Closed") {
+			t.Errorf("Expected error naming Closed, got %v", err)
+		}
+		if d, _ := g.DisplayName(2, "es-ES"); d != "Active" {
+			t.Errorf("Expected failed load to change nothing, got %q", d)
+		}
+	})
+
+	t.Run("ParseLocale", func(t *testing.T) {
+		v, err := g.ParseLocale("Ausstehend", "de-DE")
+		if err != nil || v.Get() != 1 || v.String() != "Pending" {
+			t.Errorf("Expected 1/Pending, got %d/%q, err: %v", v.Get(), v.String(), err)
+		}
+		if v, err := g.ParseLocale("Active", "de-DE"); err != nil || v.Get() != 2 {
+			t.Errorf("Expected canonical name to parse, got %v", err)
+		}
+		if _, err := g.ParseLocale("Ausstehend", "fr-FR"); err == nil {
+			t.Error("Expected display name from another locale to fail")
+		}
+		if _, err := g.Parse("Ausstehend"); err == nil {
+			t.Error("Expected plain Parse to ignore display names")
+		}
+	})
+
+	t.Run("ParseLocale shared display name", func(t *testing.T) {
+		c := NewGenerator[int]()
+		for _, name := range []string{"A", "B", "C", "D"} {
+			c.SetDisplayName(c.Next(name).Get(), "en-GB", "Same")
+		}
+		for i := 0; i < 20; i++ {
+			if v, err := c.ParseLocale("Same", "en-GB"); err != nil || v.String() != "A" {
+				t.Fatalf("Expected the first entry to win, got %q, err: %v", v.String(), err)
+			}
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		c := g.Clone()
+		c.SetDisplayName(2, "de-DE", "Aktiv")
+		if d, _ := c.DisplayName(1, "de-DE"); d != "Ausstehend" {
+			t.Errorf("Expected clone to keep display names, got %q", d)
+		}
+		if d, _ := g.DisplayName(2, "de-DE"); d != "Active" {
+			t.Errorf("Expected original to be unaffected by clone, got %q", d)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		b, err := json.Marshal(g)
+		if err != nil || strings.Contains(string(b), "Ausstehend") {
+			t.Errorf("Expected default JSON without display names, got %s, err: %v", b, err)
+		}
+		b, err = g.MarshalVerboseJSON()
+		if err != nil {
+			t.Fatalf("MarshalVerboseJSON failed: %v", err)
+		}
+		var out []struct {
+			Value   int               `json:"value"`
+			Name    string            `json:"name"`
+			Display map[string]string `json:"display"`
+		}
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatalf("Invalid verbose JSON %s: %v", b, err)
+		}
+		if len(out) != 2 || out[0].Name != "Pending" || out[0].Display["de-DE"] != "Ausstehend" || out[1].Display["fr-FR"] != "Actif" {
+			t.Errorf("Unexpected verbose JSON %s", b)
+		}
+	})
+
+	t.Run("Remove drops display names", func(t *testing.T) {
+		c := g.Clone()
+		if err := c.Remove("Pending"); err != nil {
+			t.Fatal(err)
+		}
+		if len(c.display["de-DE"]) != 0 {
+			t.Errorf("Expected display names of removed value to be dropped, got %v", c.display["de-DE"])
+		}
+	})
+}
+
+func TestGenerator_Clone(t *testing.T) {
+	g := NewGenerator[int](WithHistory[int](4))
+	g.Next("A")
+	c := g.Clone()
+	c.Next("B")
+	g.Next("C")
+	if v, _ := c.Get("B"); v != 1 {
+		t.Errorf("Expected clone to continue the sequence, got %d", v)
+	}
+	if v, _ := g.Get("C"); v != 1 {
+		t.Errorf("Expected original sequence to be independent, got %d", v)
+	}
+	if len(c.History()) != 2 || len(g.History()) != 2 {
+		t.Errorf("Expected independent histories, got %d and %d", len(c.History()), len(g.History()))
+	}
+	if err := c.CheckConsistency(); err != nil {
+		t.Errorf("CheckConsistency failed: %v", err)
+	}
+}
@@ -25,3 +25,44 @@ type Entry[T comparable] interface {
 	// String returns the human-readable name of the enum entry.
 	String() string
 }
+
+// BasicEntry is the non-generic counterpart of Entry[int], satisfied by Basic. It lets
+// code that only handles Basic enums avoid type parameters.
+type BasicEntry interface {
+	Get() int
+	String() string
+}
+
+// Compile-time checks that the package's entry types satisfy the entry interfaces.
+var (
+	_ Entry[int]    = Value[int]{}
+	_ Entry[string] = Value[string]{}
+	_ Entry[int]    = Basic{}
+	_ BasicEntry    = Basic{}
+)
+
+// ToEntries converts a slice of Value[T] (e.g., from Generator.Values) into a slice of
+// Entry[T], so entries from different sources can be handled by the same code.
+//
+// Example:
+//
+//	g := NewGenerator[int]()
+//	g.Next("Pending")
+//	entries := ToEntries(g.Values()) // []Entry[int]{Value{0, "Pending"}}
+func ToEntries[T comparable](values []Value[T]) []Entry[T] {
+	out := make([]Entry[T], len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+// BasicEntries returns the values of a Basic registry as a slice of Entry[int].
+func BasicEntries(b *BasicRegistry) []Entry[int] {
+	values := b.Values()
+	out := make([]Entry[int], len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
@@ -0,0 +1,15 @@
+package enum
+
+import "testing"
+
+func TestEntryAdapters(t *testing.T) {
+	if got := ToEntries[int](nil); len(got) != 0 {
+		t.Errorf("Expected empty slice, got %v", got)
+	}
+	b := NewBasicRegistry()
+	b.Add("A")
+	entries := BasicEntries(b)
+	if _, ok := entries[0].(BasicEntry); !ok {
+		t.Error("Expected Basic entries to satisfy BasicEntry")
+	}
+}
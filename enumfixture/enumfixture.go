@@ -0,0 +1,43 @@
+// Package enumfixture checks enum Generators against checked-in fixtures in tests, so
+// CI fails when an enum shared with other services drifts.
+//
+// Fixtures are verified by default. Run the tests with -enumfixture.update to rewrite
+// them after an intended change, then review and commit the new files:
+//
+//	func TestStatusContract(t *testing.T) {
+//	    enumfixture.Check(t, orders.Status, "testdata/status.enum.json")
+//	}
+//
+//	go test ./orders -enumfixture.update
+package enumfixture
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+// Update makes Check rewrite fixtures instead of verifying them. It is set by the
+// -enumfixture.update test flag, and may also be set directly.
+var Update bool
+
+func init() {
+	flag.BoolVar(&Update, "enumfixture.update", false, "rewrite enum fixtures instead of verifying them")
+}
+
+// Check verifies g against the fixture at path with Generator.VerifyFixture, failing t
+// with the diff on mismatch. If Update is set, it rewrites the fixture with
+// Generator.WriteFixture instead.
+func Check[T enum.TypesValue](t testing.TB, g *enum.Generator[T], path string) {
+	t.Helper()
+	if Update {
+		if err := g.WriteFixture(path); err != nil {
+			t.Fatalf("enumfixture: %v", err)
+		}
+		return
+	}
+	if err := g.VerifyFixture(path); err != nil {
+		t.Errorf("%v\n(run with -enumfixture.update to accept the change)", err)
+	}
+}
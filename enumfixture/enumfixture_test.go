@@ -0,0 +1,64 @@
+package enumfixture
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+// recorder is a testing.TB capturing failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+}
+
+func TestCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.enum.json")
+	status := enum.NewMapped(map[string]int{"Pending": 0, "Active": 1})
+
+	t.Run("Missing fixture", func(t *testing.T) {
+		r := &recorder{TB: t}
+		Check(r, status, path)
+		if len(r.failures) != 1 {
+			t.Errorf("Expected a failure for a missing fixture, got %v", r.failures)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		Update = true
+		defer func() { Update = false }()
+		r := &recorder{TB: t}
+		Check(r, status, path)
+		if len(r.failures) != 0 {
+			t.Errorf("Expected the fixture to be written, got %v", r.failures)
+		}
+	})
+
+	t.Run("Verify", func(t *testing.T) {
+		r := &recorder{TB: t}
+		Check(r, status, path)
+		if len(r.failures) != 0 {
+			t.Errorf("Expected a match, got %v", r.failures)
+		}
+
+		drifted := status.Clone()
+		drifted.Rename("Active", "Live")
+		Check(r, drifted, path)
+		if len(r.failures) != 1 || !strings.Contains(r.failures[0], "~ Renamed 1: Active -> Live") || !strings.Contains(r.failures[0], "-enumfixture.update") {
+			t.Errorf("Expected a diff with an update hint, got %v", r.failures)
+		}
+	})
+}
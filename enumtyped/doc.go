@@ -0,0 +1,19 @@
+// Package enumtyped is a worked example of enums generated with enum.GenerateGo and
+// enum.IncludeMethods. OrderStatus and PaymentStatus both have underlying type int and
+// overlapping values, yet are distinct Go types: one cannot be assigned or passed where
+// the other is expected without an explicit conversion, as with hand-written iota
+// constants. Each type's String, ParseX, JSON, text, and SQL methods are generated and
+// backed by an enum.Typed registry.
+//
+// The enums are defined in generate_test.go; regenerate the files after changing them:
+//
+//	go generate ./enumtyped
+package enumtyped
+
+//go:generate go test -run TestGenerated -update
+
+// CanShip reports whether an order in status s can be shipped. Passing a PaymentStatus,
+// such as PaymentStatusCaptured, does not compile.
+func CanShip(s OrderStatus) bool {
+	return s == OrderStatusPaid
+}
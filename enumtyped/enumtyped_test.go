@@ -0,0 +1,105 @@
+package enumtyped
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Compile-time checks that the generated methods satisfy the standard interfaces.
+var (
+	_ fmt.Stringer     = OrderStatus(0)
+	_ json.Marshaler   = OrderStatus(0)
+	_ json.Unmarshaler = (*OrderStatus)(nil)
+	_ sql.Scanner      = (*PaymentStatus)(nil)
+	_ driver.Valuer    = PaymentStatus(0)
+)
+
+func TestDistinctTypes(t *testing.T) {
+	order, payment := reflect.TypeOf(OrderStatusPending), reflect.TypeOf(PaymentStatusPending)
+	if order.AssignableTo(payment) || payment.AssignableTo(order) {
+		t.Error("OrderStatus and PaymentStatus are assignable to each other")
+	}
+	if !order.ConvertibleTo(payment) {
+		t.Error("OrderStatus is not convertible to PaymentStatus")
+	}
+	if int(OrderStatusPaid) != int(PaymentStatusAuthorized) {
+		t.Error("Expected the enums to share underlying values")
+	}
+	if OrderStatusPaid.String() != "Paid" || PaymentStatusAuthorized.String() != "Authorized" {
+		t.Errorf("Same value, different names: got %s and %s", OrderStatusPaid, PaymentStatusAuthorized)
+	}
+	if !CanShip(OrderStatus(PaymentStatusAuthorized)) {
+		t.Error("An explicit conversion keeps the value")
+	}
+}
+
+func TestGeneratedMethods(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		if got := OrderStatus(42).String(); got != "OrderStatus(42)" {
+			t.Errorf("String() = %q, want OrderStatus(42)", got)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		if s, err := ParseOrderStatus("Shipped"); err != nil || s != OrderStatusShipped {
+			t.Errorf("ParseOrderStatus(Shipped) = %v, %v", s, err)
+		}
+		if _, err := ParseOrderStatus("Captured"); err == nil {
+			t.Error("ParseOrderStatus accepted a PaymentStatus name")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type record struct {
+			Order   OrderStatus   `json:"order"`
+			Payment PaymentStatus `json:"payment"`
+		}
+		data, err := json.Marshal(record{OrderStatusPaid, PaymentStatusCaptured})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `{"order":"Paid","payment":"Captured"}`; string(data) != want {
+			t.Errorf("Marshal = %s, want %s", data, want)
+		}
+		var got record
+		if err := json.Unmarshal(data, &got); err != nil || got.Order != OrderStatusPaid || got.Payment != PaymentStatusCaptured {
+			t.Errorf("Unmarshal = %+v, %v", got, err)
+		}
+		if err := json.Unmarshal([]byte(`{"order":"Captured"}`), &got); err == nil {
+			t.Error("Unmarshal accepted a PaymentStatus name for an OrderStatus")
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		v, err := PaymentStatusRefunded.Value()
+		if err != nil || v != int64(3) {
+			t.Fatalf("Value() = %v, %v", v, err)
+		}
+		var s PaymentStatus
+		if err := s.Scan(v); err != nil || s != PaymentStatusRefunded {
+			t.Errorf("Scan(%v) = %v, %v", v, s, err)
+		}
+		if err := s.Scan([]byte("Authorized")); err != nil || s != PaymentStatusAuthorized {
+			t.Errorf("Scan(Authorized) = %v, %v", s, err)
+		}
+		if _, err := PaymentStatus(9).Value(); err == nil {
+			t.Error("Value() accepted an unregistered status")
+		}
+	})
+}
+
+func Example() {
+	data, _ := json.Marshal(map[string]any{"order": OrderStatusShipped, "payment": PaymentStatusCaptured})
+	fmt.Println(string(data))
+
+	var s OrderStatus
+	_ = json.Unmarshal([]byte(`"Cancelled"`), &s)
+	fmt.Println(s, CanShip(s))
+	// Output:
+	// {"order":"Shipped","payment":"Captured"}
+	// Cancelled false
+}
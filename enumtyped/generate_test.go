@@ -0,0 +1,50 @@
+package enumtyped
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+var update = flag.Bool("update", false, "rewrite the generated files")
+
+// definitions are the enums of this package, generated into the named files.
+var definitions = []struct {
+	file, typeName string
+	names          []string
+}{
+	{"order_status.go", "OrderStatus", []string{"Pending", "Paid", "Shipped", "Cancelled"}},
+	{"payment_status.go", "PaymentStatus", []string{"Pending", "Authorized", "Captured", "Refunded"}},
+}
+
+// TestGenerated checks that the generated files match the definitions, rewriting them
+// under -update.
+func TestGenerated(t *testing.T) {
+	for _, d := range definitions {
+		t.Run(d.typeName, func(t *testing.T) {
+			g := enum.NewGenerator[int]()
+			for _, name := range d.names {
+				g.Next(name)
+			}
+			var buf bytes.Buffer
+			if err := g.GenerateGo(&buf, "enumtyped", d.typeName, enum.IncludeMethods(true)); err != nil {
+				t.Fatal(err)
+			}
+			if *update {
+				if err := os.WriteFile(d.file, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(d.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("%s is out of date; run go generate", d.file)
+			}
+		})
+	}
+}
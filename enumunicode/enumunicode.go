@@ -0,0 +1,38 @@
+// Package enumunicode provides Unicode-aware enum.NameMatcher implementations for
+// enum.WithNameMatcher. It lives in its own module so that the enum package does not
+// depend on golang.org/x/text.
+//
+// Example:
+//
+//	g := enum.NewGenerator[int](enum.WithNameMatcher[int](enumunicode.NFCFold))
+//	g.Next("Résolu")
+//	v, _ := g.Parse("RÉSOLU") // {0 Résolu}; "Re\u0301solu" (decomposed) matches too
+package enumunicode
+
+import (
+	"github.com/olekukonko/enum"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// NFCFold matches names that are equal after Unicode case folding and NFC
+	// normalization, so precomposed and decomposed accents (e.g., "\u00e9" and
+	// "e\u0301") and any letter case match.
+	NFCFold enum.NameMatcher = matcher{form: norm.NFC}
+
+	// NFKCFold is like NFCFold but uses NFKC compatibility normalization, so width
+	// variants (e.g., full-width "Ｌｅｖｅｌ１" and "Level1") and ligatures match as well.
+	NFKCFold enum.NameMatcher = matcher{form: norm.NFKC}
+)
+
+// matcher case-folds and then normalizes names to form.
+type matcher struct {
+	form norm.Form
+}
+
+// Normalize implements enum.NameMatcher. A cases.Caser is stateful, so one is created
+// per call to keep the matcher safe for concurrent use.
+func (m matcher) Normalize(name string) string {
+	return m.form.String(cases.Fold().String(m.form.String(name)))
+}
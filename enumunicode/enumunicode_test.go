@@ -0,0 +1,42 @@
+package enumunicode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+func TestNFCFold(t *testing.T) {
+	g := enum.NewGenerator[int](enum.WithNameMatcher[int](NFCFold))
+	g.Next("Résolu")
+	g.Next("Straße")
+
+	for _, input := range []string{"Re\u0301solu", "RÉSOLU", "résolu", "STRASSE", "strasse"} {
+		if _, err := g.Parse(input); err != nil {
+			t.Errorf("Parse(%q): %v", input, err)
+		}
+	}
+	if _, err := g.Parse("Resolu"); err == nil {
+		t.Error("Expected accents to be significant")
+	}
+	if _, err := g.Parse("Ｒésolu"); err == nil {
+		t.Error("Expected width variants not to match under NFC")
+	}
+	if _, err := g.TryNext("RéSOLU"); !errors.Is(err, enum.ErrInvalidName) {
+		t.Errorf("Expected a normalization collision to be rejected, got %v", err)
+	}
+}
+
+func TestNFKCFold(t *testing.T) {
+	g := enum.NewGenerator[int](enum.WithNameMatcher[int](NFKCFold))
+	g.Next("Level1")
+	for _, input := range []string{"Ｌｅｖｅｌ１", "LEVEL1", "level１"} {
+		if v, err := g.Parse(input); err != nil || v.String() != "Level1" {
+			t.Errorf("Parse(%q): expected Level1, got %v, %v", input, v, err)
+		}
+	}
+	if _, err := g.TryNext("ＬＥＶＥＬ１"); !errors.Is(err, enum.ErrInvalidName) {
+		t.Errorf("Expected a normalization collision to be rejected, got %v", err)
+	}
+}
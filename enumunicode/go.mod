@@ -0,0 +1,12 @@
+module github.com/olekukonko/enum/enumunicode
+
+go 1.21
+
+require (
+	github.com/olekukonko/enum v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.22.0
+)
+
+// The enum module has no published version with the APIs enumunicode uses yet; build
+// against the checkout this module lives in until one is tagged.
+replace github.com/olekukonko/enum => ../
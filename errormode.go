@@ -0,0 +1,114 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// LogKeyError is the attribute key of the error in records logged under WithErrorMode
+// and when a Journal write fails.
+const LogKeyError = "error"
+
+// WithErrorMode makes the Generator report failures of panicking operations instead of
+// panicking, for code bases that forbid panics outside initialization. Each of these
+// operations has an error-returning twin that it delegates to:
+//
+//	Generator.Next             TryNext
+//	Generator.AddAlias         TryAddAlias
+//	Generator.SetDescription   TrySetDescription
+//	Generator.Deprecate        TryDeprecate
+//	Generator.SetDisplayName   TrySetDisplayName
+//	BasicRegistry.Add          TryAdd
+//	BasicRegistry.FromValue    TryFromValue
+//	Basic.With                 TryWith
+//	Basic.Or                   BasicRegistry.Mask
+//	Interner.Intern            TryIntern
+//
+// In error mode, a failing operation returns the zero result, leaves the Generator
+// unchanged, logs the error at Error if a logger is attached, and records it for Err
+// (Generator.Err or BasicRegistry.Err).
+// NewMapped and WithUnknown skip the entries they would have panicked on, and
+// Generator.Clamp returns its value unchanged.
+//
+// Operations without a registry to hold the mode keep panicking and have twins of their
+// own: Make (TryMake), MakeManual (TryMakeManual), MakeManualWithRegistry
+// (TryMakeManualWithRegistry), Namespaced.In (TryIn), NewFromSlice (NewFromSliceChecked),
+// NewFromKeys (NewFromKeysChecked), and the Must functions (their non-Must forms).
+// Invalid option arguments (e.g., WithEviction(0)) panic in every mode.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithErrorMode[int]())
+//	g.Next("Pending")
+//	v := g.Next("Pending")  // no panic; v is the zero Value
+//	fmt.Println(g.Err())    // Output: enum: name "Pending" already exists with value 0
+func WithErrorMode[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.errs = &errorLog{}
+	}
+}
+
+// Err returns the errors recorded under WithErrorMode, joined with errors.Join, or nil
+// if there were none or the Generator is not in error mode.
+func (g *Generator[T]) Err() error {
+	if g.errs == nil {
+		return nil
+	}
+	return g.errs.err()
+}
+
+// raise panics with err, or records it if the Generator is in error mode. Panics name
+// the Generator (see panicError); recorded errors do not, as Err belongs to it.
+func (g *Generator[T]) raise(err error) {
+	if g.errs == nil {
+		panic(g.panicError(err))
+	}
+	if g.logger != nil {
+		g.log(slog.LevelError, "enum error", slog.String(LogKeyError, err.Error()))
+	}
+	g.errs.add(err)
+}
+
+// panicError returns err followed by the identity of the Generator, so that a panic
+// during initialization tells which of several enum sets failed, e.g.
+// `enum: name "Active" already exists with value 1 (in enum "Status")`. Unlabeled
+// Generators are identified by the type of their values and a short fingerprint of
+// their entries, as in "(in int enum 3b9f0c2a)". The result wraps err.
+func (g *Generator[T]) panicError(err error) error {
+	if g.label != "" {
+		return fmt.Errorf("%w (in enum %q)", err, g.label)
+	}
+	var zero T
+	// The fingerprint is skipped if the lock is taken: raise may run under it.
+	if g.mu.TryRLock() {
+		defer g.mu.RUnlock()
+		return fmt.Errorf("%w (in %T enum %s)", err, zero, fingerprint(canonicalBytes(g.values))[:8])
+	}
+	return fmt.Errorf("%w (in %T enum)", err, zero)
+}
+
+// errorLog accumulates the errors recorded under WithErrorMode. It has its own lock,
+// so errors can be recorded while the Generator's lock is held.
+type errorLog struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (l *errorLog) add(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errs = append(l.errs, err)
+}
+
+func (l *errorLog) err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return errors.Join(l.errs...)
+}
+
+// Err is like Generator.Err, for a registry created with WithErrorMode.
+func (r *BasicRegistry) Err() error {
+	return r.meta.Err()
+}
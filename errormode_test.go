@@ -0,0 +1,219 @@
+package enum
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestWithErrorMode(t *testing.T) {
+	noPanic := func(t *testing.T, fn func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("Expected no panic in error mode, got %v", r)
+			}
+		}()
+		fn()
+	}
+
+	t.Run("Generator operations", func(t *testing.T) {
+		var logs bytes.Buffer
+		g := NewGenerator[int](WithErrorMode[int](), WithLogger[int](slog.New(slog.NewTextHandler(&logs, nil))))
+		g.Next("Pending")
+		noPanic(t, func() {
+			if v := g.Next("Pending"); v != (Value[int]{}) {
+				t.Errorf("Expected the zero Value, got %v", v)
+			}
+			g.AddAlias("Missing", "M")
+			g.SetDescription(42, "unknown")
+			g.Deprecate(42)
+			g.SetDisplayName(42, "de-DE", "Unbekannt")
+		})
+		err := g.Err()
+		for _, want := range []string{`name "Pending" already exists`, `unknown name "Missing"`, "description", "deprecate", "display name"} {
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected Err to mention %q, got %v", want, err)
+			}
+		}
+		if g.Len() != 1 {
+			t.Errorf("Expected the Generator unchanged, got %v", g.Names())
+		}
+		if !strings.Contains(logs.String(), "level=ERROR") {
+			t.Errorf("Expected errors to be logged, got %q", logs.String())
+		}
+	})
+
+	t.Run("NewMapped skips bad entries", func(t *testing.T) {
+		var g *Generator[float64]
+		noPanic(t, func() {
+			g = NewMapped(map[string]float64{"Bad": math.NaN(), "One": 1, "Uno": 1}, WithBijective[float64](), WithErrorMode[float64]())
+		})
+		if g.Len() != 1 {
+			t.Errorf("Expected one entry to remain, got %v", g.Names())
+		}
+		var bij *BijectionError
+		if err := g.Err(); !errors.As(err, &bij) || !strings.Contains(err.Error(), "NaN") {
+			t.Errorf("Expected NaN and bijection errors, got %v", err)
+		}
+	})
+
+	t.Run("Basic operations", func(t *testing.T) {
+		b := NewBasicFlags(WithErrorMode[int]())
+		read := b.Add("Read")
+		other := NewBasicFlags().Add("Other")
+		noPanic(t, func() {
+			b.Add("Read")
+			b.FromValue(NewValue(1, "Uno"))
+			b.Add("Write").With(1)
+			if m := read.Or(other); m.Get() != 0 {
+				t.Errorf("Expected an empty mask, got %v", m)
+			}
+		})
+		if err := b.Err(); err == nil || len(strings.Split(err.Error(), "\n")) != 4 {
+			t.Errorf("Expected four recorded errors, got %v", err)
+		}
+		if names := b.Names(); len(names) != 2 {
+			t.Errorf("Expected [Read Write], got %v", names)
+		}
+	})
+
+	t.Run("Interner", func(t *testing.T) {
+		in := NewInterner(WithStart(math.MaxInt), WithOverflowPolicy[int](OverflowError), WithErrorMode[int]())
+		in.Intern("a")
+		noPanic(t, func() { in.Intern("b") })
+		if _, err := in.TryIntern("c"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted, got %v", err)
+		}
+	})
+
+	t.Run("Default mode panics", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		want := `enum: name "A" already exists with value 0 (in int enum ` + fingerprint(g.CanonicalBytes())[:8] + `)`
+		defer func() {
+			if err, ok := recover().(error); !ok || err.Error() != want {
+				t.Errorf("Expected a panic with the TryNext error, got %v", err)
+			}
+		}()
+		g.Next("A")
+	})
+}
+
+func TestPanicContext(t *testing.T) {
+	panicMessage := func(t *testing.T, fn func()) string {
+		t.Helper()
+		var msg string
+		func() {
+			defer func() {
+				switch r := recover().(type) {
+				case error:
+					msg = r.Error()
+				case string:
+					msg = r
+				}
+			}()
+			fn()
+		}()
+		if msg == "" {
+			t.Fatal("Expected a panic")
+		}
+		return msg
+	}
+
+	sizes := NewBasicRegistry(WithLabel[int]("Sizes"))
+	small := sizes.Add("Small")
+	sizes.Add("Large")
+	statuses := NewGenerator[int](WithLabel[int]("Status"))
+	statuses.Next("Pending")
+
+	tests := []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"Add", func() { sizes.Add("Large") }, `enum: name "Large" already exists with value 1 (in enum "Sizes")`},
+		{"With", func() { small.With(1) }, `(in enum "Sizes")`},
+		{"Next", func() { statuses.Next("Pending") }, `enum: name "Pending" already exists with value 0 (in enum "Status")`},
+		{"MustParse", func() { statuses.MustParse("Done") }, `"Done": invalid syntax (in enum "Status")`},
+		{"Alias", func() { statuses.AddAlias("Pending", "Pending") }, `enum: alias "Pending" already exists as a name with value 0 (in enum "Status")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if msg := panicMessage(t, tt.fn); !strings.Contains(msg, tt.want) {
+				t.Errorf("Expected the panic to contain %q, got %q", tt.want, msg)
+			}
+		})
+	}
+
+	t.Run("Unwraps", func(t *testing.T) {
+		defer func() {
+			if err, ok := recover().(error); !ok || !errors.Is(err, ErrExhausted) {
+				t.Errorf("Expected a panic wrapping ErrExhausted, got %v", err)
+			}
+		}()
+		g := NewGenerator[int8](WithStart[int8](math.MaxInt8), WithOverflowPolicy[int8](OverflowError))
+		g.Next("Max")
+		g.Next("Over")
+	})
+}
+
+func TestTryTwins(t *testing.T) {
+	t.Run("Generator", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 1})
+		if err := g.TryAddAlias("A", "Alpha"); err != nil {
+			t.Errorf("Expected TryAddAlias to succeed, got %v", err)
+		}
+		if err := g.TrySetDescription(2, "x"); err == nil {
+			t.Error("Expected TrySetDescription to fail for an unknown value")
+		}
+		if err := g.TryDeprecate(1); err != nil || !g.IsDeprecated(1) {
+			t.Errorf("Expected TryDeprecate to succeed, got %v", err)
+		}
+		if err := g.TrySetDisplayName(2, "fr", "x"); err == nil {
+			t.Error("Expected TrySetDisplayName to fail for an unknown value")
+		}
+		if g.Err() != nil {
+			t.Errorf("Expected no recorded errors outside error mode, got %v", g.Err())
+		}
+	})
+
+	t.Run("Basic", func(t *testing.T) {
+		b := NewBasicRegistry()
+		ok, err := b.TryAdd("OK")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := b.TryAdd("OK"); err == nil {
+			t.Error("Expected TryAdd to reject a duplicate name")
+		}
+		if ok, err = ok.TryWith(200); err != nil || ok.Get() != 200 {
+			t.Errorf("Expected OK=200, got %v (%v)", ok.Get(), err)
+		}
+		if _, err := b.TryFromValue(NewValue(200, "Success")); err == nil {
+			t.Error("Expected TryFromValue to reject a used value")
+		}
+		if v, err := b.TryFromValue(NewValue(1, "Created")); err != nil || v.Get() != 1 {
+			t.Errorf("Expected Created=1, got %v (%v)", v.Get(), err)
+		}
+		if _, err := (Basic{}).TryWith(1); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+		if v, err := b.Parse("OK"); err != nil || v.Get() != 200 {
+			t.Errorf("Expected OK to resolve to 200, got %v (%v)", v.Get(), err)
+		}
+	})
+
+	t.Run("Namespaced", func(t *testing.T) {
+		ns := NewNamespaced[int]("")
+		if _, err := ns.TryIn("a.b"); err == nil {
+			t.Error("Expected TryIn to reject a namespace containing the separator")
+		}
+		if g, err := ns.TryIn("orders"); err != nil || g != ns.In("orders") {
+			t.Errorf("Expected TryIn to return the namespace, got %v", err)
+		}
+	})
+}
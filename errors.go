@@ -0,0 +1,53 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNilRegistry is returned when a Basic value is used without its registry
+	// (meta) assigned, which indicates a wiring bug rather than bad input.
+	ErrNilRegistry = errors.New("enum: nil registry (meta)")
+
+	// ErrUnknownValue is returned when a value or name is not registered in the enum set.
+	ErrUnknownValue = errors.New("invalid enum value")
+
+	// ErrExhausted is returned by TryNext (and carried by Next's panic) when a Generator
+	// using OverflowError cannot produce another unused value.
+	ErrExhausted = errors.New("enum: generator exhausted")
+
+	// ErrNotBijective is wrapped by BijectionError, returned when an operation would
+	// map two names to one value on a Generator created with WithBijective, or two
+	// values to one name on any Generator.
+	ErrNotBijective = errors.New("enum: bijection violated")
+
+	// ErrInvalidName is returned by NewValueChecked and the constructors built on it
+	// when an entry's name (or, for string enums, its value) is malformed.
+	ErrInvalidName = errors.New("enum: invalid entry")
+
+	// ErrUnset is returned by ValidateEntry for a Value that was never set (the zero
+	// Value[T]{} literal, or one reset by JSON null or SQL NULL).
+	ErrUnset = errors.New("enum: value is unset")
+
+	// ErrForeignEntry is returned by ValidateEntry and Member.Set for an entry handed out
+	// by another Generator created with WithProvenance.
+	ErrForeignEntry = errors.New("enum: entry belongs to another enum set")
+
+	// ErrNotFound is returned by Bind and Handle methods when the bound name is not
+	// (or no longer) registered. It wraps ErrUnknownValue.
+	ErrNotFound = fmt.Errorf("%w: name not found", ErrUnknownValue)
+
+	// ErrAmbiguous is returned by ParsePrefix when a prefix matches several entries, and
+	// by a Chain with ChainRejectAmbiguous when several registries match an input.
+	// It wraps ErrUnknownValue.
+	ErrAmbiguous = fmt.Errorf("%w: ambiguous match", ErrUnknownValue)
+
+	// ErrMergeRejected is returned by UnmarshalJSONMerge when the incoming entries do
+	// not satisfy its MergePolicy.
+	ErrMergeRejected = errors.New("enum: merge rejected")
+
+	// ErrFixtureMismatch is returned by VerifyFixture when the Generator has drifted
+	// from its fixture.
+	ErrFixtureMismatch = errors.New("enum: fixture mismatch")
+)
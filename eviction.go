@@ -0,0 +1,164 @@
+package enum
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// WithEviction bounds the number of entries added by GetOrAdd (and Interner.Intern) to
+// max, evicting the least recently used of them once the bound is exceeded. GetOrAdd and
+// Get count as uses. Entries added any other way, such as with Next, are pinned: they are
+// never evicted and do not count towards max. Evicted entries are removed like with
+// Remove, and onEvict, if not nil, is called with each of them after the Generator's lock
+// is released, so it may use the Generator (e.g., to invalidate dependent caches).
+//
+// Panics if max is not positive.
+//
+// Example:
+//
+//	labels := NewInterner(WithEviction[int](10_000, func(v Value[int]) {
+//		cache.Delete(v.Get())
+//	}))
+func WithEviction[T TypesValue](max int, onEvict func(Value[T])) Option[T] {
+	if max <= 0 {
+		panic("enum.WithEviction: max must be positive")
+	}
+	return func(g *Generator[T]) {
+		g.evict = &evictor[T]{max: max, onEvict: onEvict, order: list.New(), elems: make(map[string]*list.Element)}
+	}
+}
+
+// evictor tracks the recency of evictable entries by name. Its own mutex guards the
+// recency list, so Get can record uses while holding only the Generator's read lock.
+// Lock order: Generator.mu, then evictor.mu.
+type evictor[T TypesValue] struct {
+	max     int
+	onEvict func(Value[T])
+	mu      sync.Mutex
+	order   *list.List               // Names, most recently used first.
+	elems   map[string]*list.Element // Elements of order by name.
+}
+
+// touch marks name as used if it is tracked.
+func (e *evictor[T]) touch(name string) {
+	e.mu.Lock()
+	if el, ok := e.elems[name]; ok {
+		e.order.MoveToFront(el)
+	}
+	e.mu.Unlock()
+}
+
+// add starts tracking name as the most recently used entry.
+func (e *evictor[T]) add(name string) {
+	e.mu.Lock()
+	e.elems[name] = e.order.PushFront(name)
+	e.mu.Unlock()
+}
+
+// drop stops tracking name.
+func (e *evictor[T]) drop(name string) {
+	e.mu.Lock()
+	if el, ok := e.elems[name]; ok {
+		e.order.Remove(el)
+		delete(e.elems, name)
+	}
+	e.mu.Unlock()
+}
+
+// rename tracks a renamed entry under its new name, keeping its recency.
+func (e *evictor[T]) rename(oldName, newName string) {
+	e.mu.Lock()
+	if el, ok := e.elems[oldName]; ok {
+		el.Value = newName
+		delete(e.elems, oldName)
+		e.elems[newName] = el
+	}
+	e.mu.Unlock()
+}
+
+// excess returns the least recently used names beyond max and stops tracking them.
+func (e *evictor[T]) excess() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var names []string
+	for e.order.Len() > e.max {
+		el := e.order.Back()
+		name := e.order.Remove(el).(string)
+		delete(e.elems, name)
+		names = append(names, name)
+	}
+	return names
+}
+
+// clone returns an independent copy of e, or nil if e is nil.
+func (e *evictor[T]) clone() *evictor[T] {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	c := &evictor[T]{max: e.max, onEvict: e.onEvict, order: list.New(), elems: make(map[string]*list.Element, len(e.elems))}
+	for el := e.order.Front(); el != nil; el = el.Next() {
+		name := el.Value.(string)
+		c.elems[name] = c.order.PushBack(name)
+	}
+	return c
+}
+
+// GetOrAdd returns the entry named name, adding it with the next value in the sequence
+// if it does not exist. Lookups of existing names take only a read lock. Under
+// WithEviction, entries added here are evictable and adding one may evict others.
+// It is thread-safe.
+//
+// Returns an error if the entry must be added and Next would fail (see TryNext).
+func (g *Generator[T]) GetOrAdd(name string) (Value[T], error) {
+	g.rlock()
+	val, ok := g.nameMap[name]
+	if ok && g.evict != nil {
+		g.evict.touch(name)
+	}
+	g.runlock()
+	if ok {
+		return g.entry(val, name), nil
+	}
+	if err := g.checkName(name); err != nil {
+		return Value[T]{}, err
+	}
+
+	err := g.lockValidated(context.Background(), func() []Pair[T] {
+		return []Pair[T]{{Name: name, Value: g.current}}
+	})
+	if err != nil {
+		return Value[T]{}, err
+	}
+	// Another goroutine may have added name between the two locks.
+	if val, ok := g.nameMap[name]; ok {
+		if g.evict != nil {
+			g.evict.touch(name)
+		}
+		g.unlock()
+		return g.entry(val, name), nil
+	}
+	entry, err := g.nextLocked(name, "")
+	if err != nil || g.evict == nil {
+		g.unlock()
+		return entry, err
+	}
+	g.evict.add(name)
+	var evicted []Value[T]
+	for _, old := range g.evict.excess() {
+		if val, ok := g.nameMap[old]; ok && g.removeLocked(old, "evict") == nil {
+			evicted = append(evicted, g.entry(val, old))
+		}
+	}
+	onEvict := g.evict.onEvict
+	g.unlock()
+
+	if onEvict != nil {
+		for _, v := range evicted {
+			onEvict(v)
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,136 @@
+package enum
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGenerator_Eviction(t *testing.T) {
+	t.Run("Least recently used", func(t *testing.T) {
+		var evicted []string
+		g := NewGenerator[int](WithEviction[int](2, func(v Value[int]) {
+			evicted = append(evicted, v.String())
+		}))
+		g.Next("Pinned")
+		g.GetOrAdd("a")
+		g.GetOrAdd("b")
+		g.Get("a") // b is now the least recently used.
+		g.GetOrAdd("c")
+		if len(evicted) != 1 || evicted[0] != "b" {
+			t.Fatalf("Expected b evicted, got %v", evicted)
+		}
+		g.GetOrAdd("a")
+		g.GetOrAdd("d")
+		if len(evicted) != 2 || evicted[1] != "c" {
+			t.Fatalf("Expected c evicted next, got %v", evicted)
+		}
+		if !g.ContainsName("Pinned") || !g.ContainsName("a") || !g.ContainsName("d") || g.Len() != 3 {
+			t.Errorf("Unexpected entries %v", g.Names())
+		}
+		if err := g.CheckConsistency(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Callback may use the Generator", func(t *testing.T) {
+		var g *Generator[int]
+		g = NewGenerator[int](WithEviction[int](1, func(v Value[int]) {
+			if g.ContainsName(v.String()) {
+				t.Errorf("Expected %s removed before the callback", v)
+			}
+		}))
+		g.GetOrAdd("x")
+		g.GetOrAdd("y")
+	})
+
+	t.Run("Rename and remove", func(t *testing.T) {
+		var evicted []string
+		g := NewGenerator[int](WithEviction[int](2, func(v Value[int]) {
+			evicted = append(evicted, v.String())
+		}))
+		g.GetOrAdd("a")
+		g.GetOrAdd("b")
+		if err := g.Rename("a", "A"); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Remove("b"); err != nil {
+			t.Fatal(err)
+		}
+		g.GetOrAdd("c")
+		g.GetOrAdd("d")
+		if len(evicted) != 1 || evicted[0] != "A" {
+			t.Errorf("Expected the renamed entry evicted, got %v", evicted)
+		}
+	})
+
+	t.Run("Interner", func(t *testing.T) {
+		in := NewInterner(WithEviction[int](3, nil))
+		for i := 0; i < 10; i++ {
+			in.Intern(fmt.Sprint(i))
+		}
+		if in.Len() != 3 {
+			t.Errorf("Expected 3 interned strings, got %d", in.Len())
+		}
+		if _, ok := in.Lookup(9); !ok {
+			t.Error("Expected the newest string kept")
+		}
+	})
+
+	t.Run("Invalid max", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for max 0")
+			}
+		}()
+		WithEviction[int](0, nil)
+	})
+}
+
+func TestGenerator_EvictionConcurrent(t *testing.T) {
+	const max, workers, perWorker = 50, 8, 500
+	var evictions atomic.Int64
+	g := NewGenerator[int](WithEviction[int](max, func(Value[int]) { evictions.Add(1) }))
+	for i := 0; i < 10; i++ {
+		g.Next(fmt.Sprintf("pinned%d", i))
+	}
+
+	var added atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				name := fmt.Sprintf("label%d", (w*perWorker+i)%300)
+				if _, err := g.GetOrAdd(name); err != nil {
+					t.Error(err)
+					return
+				}
+				g.Get(name)
+				if i%7 == 0 {
+					g.Next(fmt.Sprintf("pinned-%d-%d", w, i))
+					added.Add(1)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if err := g.CheckConsistency(); err != nil {
+		t.Fatal(err)
+	}
+	pinned := 10 + int(added.Load())
+	if got := g.Len() - pinned; got != max {
+		t.Errorf("Expected %d evictable entries, got %d", max, got)
+	}
+	for i := 0; i < 10; i++ {
+		if !g.ContainsName(fmt.Sprintf("pinned%d", i)) {
+			t.Errorf("Pinned entry %d was evicted", i)
+		}
+	}
+	if evictions.Load() == 0 {
+		t.Error("Expected evictions")
+	}
+}
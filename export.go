@@ -0,0 +1,493 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ExportOption configures the exporters GenerateGo, ExportSQL, ExportTypeScript,
+// ExportProto, and ExportJSONSchema.
+type ExportOption func(*exportConfig)
+
+// exportConfig holds the settings applied by ExportOption values.
+type exportConfig struct {
+	comments bool
+	methods  bool
+	names    bool
+	dense    error // Result of CheckContiguous when names is set.
+}
+
+// IncludeComments makes exporters emit each entry's description (see SetDescription)
+// as a comment adjacent to the entry, escaped for the target language. Entries without
+// a description get no comment.
+func IncludeComments(include bool) ExportOption {
+	return func(c *exportConfig) {
+		c.comments = include
+	}
+}
+
+// IncludeMethods makes GenerateGo also emit a Typed registry of the constants, named
+// typeName followed by "Enum", and methods on the type delegating to it: String,
+// MarshalText, UnmarshalText, MarshalJSON, UnmarshalJSON, Scan, and Value, plus a
+// Parse function named "Parse" followed by typeName. The generated type then works with
+// fmt, encoding/json, and database/sql while staying distinct from other enums of the
+// same kind. Other exporters ignore it.
+//
+// GenerateGo returns an error under IncludeMethods if T is not an integer type.
+func IncludeMethods(include bool) ExportOption {
+	return func(c *exportConfig) {
+		c.methods = include
+	}
+}
+
+// IncludeNameArray makes GenerateGo and ExportTypeScript also emit a dense array of
+// the entry names indexed by value, named typeName followed by "Names", so generated
+// code can look names up without a map. Other exporters ignore it.
+//
+// The array needs values 0..n-1 with no gaps or shared values (see CheckContiguous);
+// otherwise the exporters return the *ContiguityError, writing nothing.
+func IncludeNameArray(include bool) ExportOption {
+	return func(c *exportConfig) {
+		c.names = include
+	}
+}
+
+// exportEntry is an entry as seen by the exporters.
+type exportEntry[T TypesValue] struct {
+	Pair[T]
+	desc string
+}
+
+// exportEntries returns the canonical entries in entry order with their descriptions,
+// and the applied options. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) exportEntries(opts []ExportOption) ([]exportEntry[T], exportConfig) {
+	var cfg exportConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	g.rlock()
+	defer g.runlock()
+	if cfg.names {
+		cfg.dense = g.checkContiguousLocked()
+	}
+	pairs := g.pairsLocked(true)
+	entries := make([]exportEntry[T], len(pairs))
+	for i, p := range pairs {
+		entries[i] = exportEntry[T]{Pair: p}
+		if cfg.comments {
+			entries[i].desc = g.descriptions[p.Value]
+		}
+	}
+	return entries, cfg
+}
+
+// byValue returns the indexes of entries ordered by value. With contiguous values,
+// the i-th index is that of the entry whose value is i.
+func byValue[T TypesValue](entries []exportEntry[T]) []int {
+	order := make([]int, len(entries))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return entries[order[i]].Value < entries[order[j]].Value })
+	return order
+}
+
+// commentLines splits a description into trimmed lines, dropping blank ones.
+func commentLines(desc string) []string {
+	var lines []string
+	for _, line := range strings.Split(desc, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// GenerateGo writes Go source declaring typeName (with T's underlying kind) and one
+// constant per entry, named typeName followed by the entry name in CamelCase, in
+// package pkg. The output is gofmt-formatted. With IncludeMethods, the type also gets
+// a Typed registry and the methods that make it usable on its own (see Typed); with
+// IncludeNameArray, a [...]string of the names indexed by the constants.
+// It is thread-safe, using a read lock for access.
+//
+// Returns an error, writing nothing, if pkg or typeName is not a valid identifier, or
+// if the constant names would not compile: an entry name without letters or digits,
+// or two names that map to the same constant (e.g., "in-progress" and "in_progress").
+//
+// Example:
+//
+//	g.SetDescription(0, "Awaiting payment")
+//	err := g.GenerateGo(os.Stdout, "orders", "Status", IncludeComments(true))
+//	// type Status int
+//	//
+//	// const (
+//	//	// Awaiting payment
+//	//	StatusPending Status = 0
+//	//	StatusActive  Status = 1
+//	// )
+func (g *Generator[T]) GenerateGo(w io.Writer, pkg, typeName string, opts ...ExportOption) error {
+	entries, cfg := g.exportEntries(opts)
+	if cfg.methods && !g.Kind().IsInteger() {
+		var zero T
+		return fmt.Errorf("enum: cannot generate methods for %T values", zero)
+	}
+	if cfg.names && cfg.dense != nil {
+		return cfg.dense
+	}
+	idents, err := goConstNames(pkg, typeName, entries, cfg)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by enum.GenerateGo. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	if cfg.methods {
+		buf.WriteString("import (\n\t\"database/sql/driver\"\n\n\t\"github.com/olekukonko/enum\"\n)\n\n")
+	}
+	fmt.Fprintf(&buf, "type %s %s\n\nconst (\n", typeName, g.Kind())
+	for i, e := range entries {
+		for _, line := range commentLines(e.desc) {
+			fmt.Fprintf(&buf, "// %s\n", line)
+		}
+		fmt.Fprintf(&buf, "%s %s = %s\n", idents[i], typeName, goLiteral(e.Value, g.Kind()))
+	}
+	buf.WriteString(")\n")
+	if cfg.names {
+		fmt.Fprintf(&buf, "\n// %sNames holds the name of each %s, indexed by value.\n", typeName, typeName)
+		fmt.Fprintf(&buf, "var %sNames = [...]string{\n", typeName)
+		for _, i := range byValue(entries) {
+			fmt.Fprintf(&buf, "%s: %s,\n", idents[i], strconv.Quote(entries[i].Name))
+		}
+		buf.WriteString("}\n")
+	}
+	if cfg.methods {
+		writeGoMethods(&buf, typeName, entries, idents)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("enum: generated invalid Go source: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// goMethodsTemplate is the code GenerateGo emits under IncludeMethods after the
+// registry; %[1]s is the type name and %[2]s the registry variable.
+const goMethodsTemplate = `
+// String returns the name of v, implementing fmt.Stringer.
+func (v %[1]s) String() string { return %[2]s.String(v) }
+
+// Parse%[1]s returns the %[1]s named s.
+func Parse%[1]s(s string) (%[1]s, error) { return %[2]s.Parse(s) }
+
+// MarshalText implements encoding.TextMarshaler.
+func (v %[1]s) MarshalText() ([]byte, error) { return %[2]s.MarshalText(v) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *%[1]s) UnmarshalText(text []byte) error { return %[2]s.UnmarshalText(text, v) }
+
+// MarshalJSON implements json.Marshaler, writing the name of v.
+func (v %[1]s) MarshalJSON() ([]byte, error) { return %[2]s.EncodeJSON(v) }
+
+// UnmarshalJSON implements json.Unmarshaler, reading a name or value.
+func (v *%[1]s) UnmarshalJSON(data []byte) error { return %[2]s.DecodeJSON(data, v) }
+
+// Scan implements sql.Scanner, reading a name or value.
+func (v *%[1]s) Scan(src any) error { return %[2]s.Scan(src, v) }
+
+// Value implements driver.Valuer, writing the value of v.
+func (v %[1]s) Value() (driver.Value, error) { return %[2]s.Value(v) }
+`
+
+// writeGoMethods writes the Typed registry and methods GenerateGo emits under
+// IncludeMethods.
+func writeGoMethods[T TypesValue](buf *bytes.Buffer, typeName string, entries []exportEntry[T], idents []string) {
+	registry := typeName + "Enum"
+	fmt.Fprintf(buf, "\n// %s is the registry of %s values, backing its methods.\n", registry, typeName)
+	fmt.Fprintf(buf, "var %s = enum.TypedOf(enum.MustRegisterConstants(map[string]%s{\n", registry, typeName)
+	for i, e := range entries {
+		fmt.Fprintf(buf, "%s: %s,\n", strconv.Quote(e.Name), idents[i])
+	}
+	buf.WriteString("}))\n")
+	fmt.Fprintf(buf, goMethodsTemplate, typeName, registry)
+}
+
+// ExportSQL writes a PostgreSQL CREATE TYPE statement declaring typeName as an enum
+// whose labels are the entry names, in entry order. Descriptions become "--" comments.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	err := g.ExportSQL(os.Stdout, "order_status", IncludeComments(true))
+//	// CREATE TYPE order_status AS ENUM (
+//	//     -- Awaiting payment
+//	//     'Pending',
+//	//     'Active'
+//	// );
+func (g *Generator[T]) ExportSQL(w io.Writer, typeName string, opts ...ExportOption) error {
+	entries, _ := g.exportEntries(opts)
+	labels := make([]string, len(entries))
+	descs := make([]string, len(entries))
+	for i, e := range entries {
+		labels[i], descs[i] = e.Name, e.desc
+	}
+	return writeSQLEnum(w, typeName, labels, descs)
+}
+
+// writeSQLEnum writes the CREATE TYPE statement of ExportSQL for the given labels and
+// their descriptions.
+func writeSQLEnum(w io.Writer, typeName string, labels, descs []string) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE TYPE %s AS ENUM (\n", typeName)
+	for i, label := range labels {
+		for _, line := range commentLines(descs[i]) {
+			fmt.Fprintf(&buf, "    -- %s\n", line)
+		}
+		sep := ","
+		if i == len(labels)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(&buf, "    '%s'%s\n", strings.ReplaceAll(label, "'", "''"), sep)
+	}
+	buf.WriteString(");\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// ExportTypeScript writes a TypeScript enum named typeName with one member per entry.
+// Names that are not identifiers are quoted. Descriptions become JSDoc comments.
+// Numeric values are written as FormatValueMap writes them (see WithValueFormatter).
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	err := g.ExportTypeScript(os.Stdout, "Status", IncludeComments(true))
+//	// export enum Status {
+//	//   /** Awaiting payment */
+//	//   Pending = 0,
+//	//   Active = 1,
+//	// }
+func (g *Generator[T]) ExportTypeScript(w io.Writer, typeName string, opts ...ExportOption) error {
+	entries, cfg := g.exportEntries(opts)
+	if cfg.names && cfg.dense != nil {
+		return cfg.dense
+	}
+	kind := g.Kind()
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "export enum %s {\n", typeName)
+	for _, e := range entries {
+		switch lines := commentLines(e.desc); len(lines) {
+		case 0:
+		case 1:
+			fmt.Fprintf(&buf, "  /** %s */\n", jsDocEscape(lines[0]))
+		default:
+			buf.WriteString("  /**\n")
+			for _, line := range lines {
+				fmt.Fprintf(&buf, "   * %s\n", jsDocEscape(line))
+			}
+			buf.WriteString("   */\n")
+		}
+		name := e.Name
+		if IdentifierRule(name) != nil {
+			quoted, _ := json.Marshal(name)
+			name = string(quoted)
+		}
+		value := g.formatText(e.Value)
+		if kind.IsString() {
+			quoted, _ := json.Marshal(e.Value)
+			value = string(quoted)
+		}
+		fmt.Fprintf(&buf, "  %s = %s,\n", name, value)
+	}
+	buf.WriteString("}\n")
+	if cfg.names {
+		names := make([]string, 0, len(entries))
+		for _, i := range byValue(entries) {
+			quoted, _ := json.Marshal(entries[i].Name)
+			names = append(names, string(quoted))
+		}
+		fmt.Fprintf(&buf, "\nexport const %sNames = [%s] as const;\n", typeName, strings.Join(names, ", "))
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// ExportProto writes a proto3 enum named typeName. Constants are prefixed with the
+// type name in UPPER_SNAKE_CASE, as the protobuf style guide recommends, and ordered by
+// value. Descriptions become "//" comments.
+// It is thread-safe, using a read lock for access.
+//
+// Returns an error if T is not an integer type, a value does not fit in int32, or no
+// entry has the value 0, which proto3 requires (see WithUnknown).
+func (g *Generator[T]) ExportProto(w io.Writer, typeName string, opts ...ExportOption) error {
+	entries, _ := g.exportEntries(opts)
+	if !g.Kind().IsInteger() {
+		var zero T
+		return fmt.Errorf("enum: cannot export %T values to protobuf", zero)
+	}
+	sorted := make([]exportEntry[T], 0, len(entries))
+	hasZero := false
+	for _, e := range entries {
+		var n int64
+		if g.Kind().Class == ClassUint {
+			if uintOf(e.Value) > math.MaxInt32 {
+				return fmt.Errorf("enum: value %s of %q does not fit in a protobuf enum", g.formatValue(e.Value), e.Name)
+			}
+			n = int64(uintOf(e.Value))
+		} else {
+			n = intOf(e.Value)
+		}
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return fmt.Errorf("enum: value %s of %q does not fit in a protobuf enum", g.formatValue(e.Value), e.Name)
+		}
+		hasZero = hasZero || n == 0
+		sorted = append(sorted, e)
+	}
+	if !hasZero {
+		return fmt.Errorf("enum: protobuf enum %s needs an entry with value 0", typeName)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value < sorted[j].Value })
+
+	prefix := UpperSnake(typeName) + "_"
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "enum %s {\n", typeName)
+	for _, e := range sorted {
+		for _, line := range commentLines(e.desc) {
+			fmt.Fprintf(&buf, "  // %s\n", line)
+		}
+		fmt.Fprintf(&buf, "  %s%s = %s;\n", prefix, UpperSnake(e.Name), formatKey(e.Value))
+	}
+	buf.WriteString("}\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// jsonSchemaOption is a member of the oneOf list written by ExportJSONSchema.
+type jsonSchemaOption struct {
+	Const       json.RawMessage `json:"const"`
+	Title       string          `json:"title"`
+	Description string          `json:"description,omitempty"`
+}
+
+// ExportJSONSchema writes a JSON Schema (draft 2020-12) titled typeName that accepts
+// exactly the entry values. The schema type follows Kind ("string", "integer", or
+// "number"), and each value is listed under oneOf with its name as the title and, with
+// IncludeComments, its description.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	err := g.ExportJSONSchema(os.Stdout, "Status")
+//	// {
+//	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+//	//   "title": "Status",
+//	//   "type": "integer",
+//	//   "oneOf": [
+//	//     {"const": 0, "title": "Pending"}, ...
+func (g *Generator[T]) ExportJSONSchema(w io.Writer, typeName string, opts ...ExportOption) error {
+	entries, _ := g.exportEntries(opts)
+	schemaType := "number"
+	switch kind := g.Kind(); {
+	case kind.IsString():
+		schemaType = "string"
+	case kind.IsInteger():
+		schemaType = "integer"
+	}
+	options := make([]jsonSchemaOption, 0, len(entries))
+	for _, e := range entries {
+		value, err := json.Marshal(e.Value)
+		if err != nil {
+			return err
+		}
+		options = append(options, jsonSchemaOption{
+			Const:       value,
+			Title:       e.Name,
+			Description: strings.Join(commentLines(e.desc), "\n"),
+		})
+	}
+	schema := struct {
+		Schema string             `json:"$schema"`
+		Title  string             `json:"title"`
+		Type   string             `json:"type"`
+		OneOf  []jsonSchemaOption `json:"oneOf"`
+	}{"https://json-schema.org/draft/2020-12/schema", typeName, schemaType, options}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// goLiteral formats a value of the given kind as a Go constant literal.
+func goLiteral[T TypesValue](v T, kind ValueKind) string {
+	if kind.IsString() {
+		return strconv.Quote(stringOf(v))
+	}
+	return formatKey(v)
+}
+
+// goConstNames returns the constant name GenerateGo declares for each entry, typeName
+// followed by the entry name in CamelCase, checking that the identifiers compile: pkg
+// and typeName must be identifiers, and the constants must be distinct from each
+// other and from the other declarations of the file.
+func goConstNames[T TypesValue](pkg, typeName string, entries []exportEntry[T], cfg exportConfig) ([]string, error) {
+	for _, ident := range []string{pkg, typeName} {
+		if !token.IsIdentifier(ident) {
+			return nil, fmt.Errorf("enum: %q is not a valid Go identifier", ident)
+		}
+	}
+	declared := map[string]string{typeName: "the type"}
+	if cfg.methods {
+		declared[typeName+"Enum"] = "the registry"
+	}
+	if cfg.names {
+		declared[typeName+"Names"] = "the name array"
+	}
+	idents := make([]string, len(entries))
+	for i, e := range entries {
+		suffix := camelIdent(e.Name)
+		if suffix == "" {
+			return nil, fmt.Errorf("enum: name %q has no letters or digits to form a Go constant name", e.Name)
+		}
+		ident := typeName + suffix
+		if other, ok := declared[ident]; ok {
+			return nil, fmt.Errorf("enum: name %q maps to Go constant %s, which collides with %s", e.Name, ident, other)
+		}
+		declared[ident] = strconv.Quote(e.Name)
+		idents[i] = ident
+	}
+	return idents, nil
+}
+
+// camelIdent converts a name to an exported CamelCase identifier fragment, dropping
+// characters that cannot appear in identifiers (e.g., "in progress" -> "InProgress").
+func camelIdent(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// jsDocEscape prevents a comment line from closing a JSDoc block early.
+func jsDocEscape(s string) string {
+	return strings.ReplaceAll(s, "*/", `*\/`)
+}
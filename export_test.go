@@ -0,0 +1,188 @@
+package enum
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// exportFixture returns an enum with plain, multi-line, and hostile descriptions.
+func exportFixture() *Generator[int] {
+	g := NewGenerator[int]()
+	g.Next("Pending")
+	g.Next("Active")
+	g.Next("in progress")
+	g.Next("Done")
+	g.SetDescription(0, "Awaiting payment")
+	g.SetDescription(2, "Being processed.\nMay take a while.")
+	g.SetDescription(3, "Closed */ for good")
+	return g
+}
+
+func TestExport_Golden(t *testing.T) {
+	exporters := []struct {
+		name   string
+		export func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error
+	}{
+		{"go", func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error {
+			return g.GenerateGo(buf, "orders", "Status", opts...)
+		}},
+		{"sql", func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error {
+			return g.ExportSQL(buf, "order_status", opts...)
+		}},
+		{"ts", func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error {
+			return g.ExportTypeScript(buf, "Status", opts...)
+		}},
+		{"proto", func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error {
+			return g.ExportProto(buf, "Status", opts...)
+		}},
+		{"schema.json", func(g *Generator[int], buf *bytes.Buffer, opts ...ExportOption) error {
+			return g.ExportJSONSchema(buf, "Status", opts...)
+		}},
+	}
+	for _, e := range exporters {
+		t.Run(e.name, func(t *testing.T) {
+			var plain, commented bytes.Buffer
+			if err := e.export(exportFixture(), &plain); err != nil {
+				t.Fatal(err)
+			}
+			if err := e.export(exportFixture(), &commented, IncludeComments(true)); err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, "export."+e.name+".golden", plain.Bytes())
+			checkGolden(t, "export_comments."+e.name+".golden", commented.Bytes())
+			if strings.Contains(plain.String(), "Awaiting") {
+				t.Error("Descriptions emitted without IncludeComments")
+			}
+		})
+	}
+}
+
+func TestGenerateGo_Methods(t *testing.T) {
+	t.Run("Golden", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := exportFixture().GenerateGo(&buf, "orders", "Status", IncludeMethods(true)); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "export_methods.go.golden", buf.Bytes())
+	})
+	t.Run("NonInteger", func(t *testing.T) {
+		g := NewMapped(map[string]string{"Zero": "z"})
+		if err := g.GenerateGo(&bytes.Buffer{}, "orders", "Status", IncludeMethods(true)); err == nil {
+			t.Error("Expected an error for string values")
+		}
+	})
+}
+
+func TestExport_NameArray(t *testing.T) {
+	t.Run("Golden", func(t *testing.T) {
+		var goSrc, ts bytes.Buffer
+		if err := exportFixture().GenerateGo(&goSrc, "orders", "Status", IncludeNameArray(true)); err != nil {
+			t.Fatal(err)
+		}
+		if err := exportFixture().ExportTypeScript(&ts, "Status", IncludeNameArray(true)); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, "export_names.go.golden", goSrc.Bytes())
+		checkGolden(t, "export_names.ts.golden", ts.Bytes())
+	})
+	t.Run("ValueOrder", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Second": 1, "First": 0, "Third": 2})
+		var buf bytes.Buffer
+		if err := g.ExportTypeScript(&buf, "Rank", IncludeNameArray(true)); err != nil {
+			t.Fatal(err)
+		}
+		if want := `export const RankNames = ["First", "Second", "Third"] as const;`; !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected %s, got:\n%s", want, buf.String())
+		}
+	})
+	t.Run("NotContiguous", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 0, "B": 2})
+		for name, export := range map[string]func(*bytes.Buffer) error{
+			"go": func(buf *bytes.Buffer) error { return g.GenerateGo(buf, "orders", "Status", IncludeNameArray(true)) },
+			"ts": func(buf *bytes.Buffer) error { return g.ExportTypeScript(buf, "Status", IncludeNameArray(true)) },
+		} {
+			var buf bytes.Buffer
+			var cerr *ContiguityError[int]
+			if err := export(&buf); !errors.As(err, &cerr) || buf.Len() != 0 {
+				t.Errorf("%s: expected a *ContiguityError and no output, got %v", name, err)
+			}
+		}
+	})
+}
+
+func TestGenerateGo_InvalidIdentifiers(t *testing.T) {
+	tests := []struct {
+		name          string
+		names         []string
+		pkg, typeName string
+		methods       bool
+		want          string
+	}{
+		{"Collision", []string{"in-progress", "in_progress"}, "orders", "Status", false, "StatusInProgress"},
+		{"NoLetters", []string{"ok", "--"}, "orders", "Status", false, `"--"`},
+		{"Registry", []string{"Enum"}, "orders", "Status", true, "the registry"},
+		{"NameArray", []string{"Names"}, "orders", "Status", false, "the name array"},
+		{"TypeName", []string{"ok"}, "orders", "1Status", false, `"1Status"`},
+		{"Package", []string{"ok"}, "my-pkg", "Status", false, `"my-pkg"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGenerator[int]()
+			for _, name := range tt.names {
+				g.Next(name)
+			}
+			var buf bytes.Buffer
+			err := g.GenerateGo(&buf, tt.pkg, tt.typeName, IncludeMethods(tt.methods), IncludeNameArray(true))
+			if err == nil || !strings.Contains(err.Error(), tt.want) || buf.Len() != 0 {
+				t.Errorf("Expected an error mentioning %s and no output, got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("LeadingDigit", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("2fa")
+		var buf bytes.Buffer
+		if err := g.GenerateGo(&buf, "auth", "Method"); err != nil || !strings.Contains(buf.String(), "Method2fa Method = 0") {
+			t.Errorf("Expected the type name to prefix a leading digit, got %v:\n%s", err, buf.String())
+		}
+	})
+}
+
+func TestExportProto_Errors(t *testing.T) {
+	t.Run("NoZero", func(t *testing.T) {
+		g := NewGenerator[int](WithStart(1))
+		g.Next("Active")
+		if err := g.ExportProto(&bytes.Buffer{}, "Status"); err == nil {
+			t.Error("Expected an error without a zero value")
+		}
+	})
+	t.Run("OutOfRange", func(t *testing.T) {
+		g := NewMapped(map[string]int64{"Zero": 0, "Big": 1 << 40})
+		if err := g.ExportProto(&bytes.Buffer{}, "Status"); err == nil {
+			t.Error("Expected an error for a value beyond int32")
+		}
+	})
+	t.Run("NonInteger", func(t *testing.T) {
+		g := NewMapped(map[string]string{"Zero": "z"})
+		err := g.ExportProto(&bytes.Buffer{}, "Status")
+		if err == nil || errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected a type error, got %v", err)
+		}
+	})
+}
+
+func TestGenerateGo_StringValues(t *testing.T) {
+	g := NewMapped(map[string]string{"Red": "#f00", "Quote": `say "hi"`})
+	var buf bytes.Buffer
+	if err := g.GenerateGo(&buf, "colors", "Color"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"type Color string", `ColorRed   Color = "#f00"`, `ColorQuote Color = "say \"hi\""`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Missing %q in:\n%s", want, buf.String())
+		}
+	}
+}
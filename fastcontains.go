@@ -0,0 +1,146 @@
+package enum
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// WithFastContains adds a fast-reject filter in front of Contains, for hot paths where
+// most lookups are for values that do not exist (e.g., filtering a stream of events).
+// The filter is consulted without taking the lock; only values it cannot rule out go
+// on to the locked map lookup, so results are identical to those without the option.
+//
+// The filter is a bitset when the values are dense and small, and a Bloom filter
+// otherwise. It is rebuilt on the first Contains after a mutation, so it suits
+// Generators that are populated once and then read.
+//
+// Panics if T is not an integer type.
+//
+// Example:
+//
+//	g := NewMapped(codes, WithFastContains[int]())
+//	if !g.Contains(event.Code) { // Unknown codes rarely reach the lock.
+//	    return
+//	}
+func WithFastContains[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		if !isInteger[T]() {
+			panic(fmt.Sprintf("enum.WithFastContains: %T is not an integer type", *new(T)))
+		}
+		g.fastContains = true
+	}
+}
+
+// fastFilter is a set of value keys (see fastKey) with no false negatives, built for one
+// version of a Generator. If bloom is false, bits is a bitset of key-base; otherwise it
+// is a Bloom filter of len(bits)*64 bits, a power of two.
+type fastFilter struct {
+	version uint64
+	base    uint64
+	bits    []uint64
+	bloom   bool
+}
+
+// fastFilterHashes is the number of bits set per key in a Bloom filter, and
+// fastFilterBitsPerKey its size per key; together they give about 0.3% false positives.
+const (
+	fastFilterHashes     = 3
+	fastFilterBitsPerKey = 16
+)
+
+// fastFilter returns the filter for the current version, rebuilding it if the
+// Generator has changed since it was last built. It is thread-safe, using a read lock
+// only when rebuilding.
+func (g *Generator[T]) fastFilter() *fastFilter {
+	if f := g.fast.Load(); f != nil && f.version == g.version.Load() {
+		return f
+	}
+	g.rlock()
+	keys := make([]uint64, 0, len(g.valueMap))
+	for value := range g.valueMap {
+		keys = append(keys, fastKey(value))
+	}
+	f := newFastFilter(keys)
+	f.version = g.version.Load()
+	g.runlock()
+	g.fast.Store(f)
+	return f
+}
+
+// newFastFilter builds a bitset if keys span at most 64 bits per key (and at least
+// 4096 bits), or a Bloom filter otherwise.
+func newFastFilter(keys []uint64) *fastFilter {
+	if len(keys) == 0 {
+		return &fastFilter{}
+	}
+	lo, hi := keys[0], keys[0]
+	for _, k := range keys {
+		lo, hi = min(lo, k), max(hi, k)
+	}
+	if span := hi - lo; span < uint64(64*max(len(keys), 64)) {
+		f := &fastFilter{base: lo, bits: make([]uint64, span/64+1)}
+		for _, k := range keys {
+			k -= lo
+			f.bits[k/64] |= 1 << (k % 64)
+		}
+		return f
+	}
+	words := 1
+	for words*64 < len(keys)*fastFilterBitsPerKey {
+		words <<= 1
+	}
+	f := &fastFilter{bits: make([]uint64, words), bloom: true}
+	mask := uint64(words*64 - 1)
+	for _, k := range keys {
+		h1, h2 := bloomHashes(k)
+		for i := uint64(0); i < fastFilterHashes; i++ {
+			bit := (h1 + i*h2) & mask
+			f.bits[bit/64] |= 1 << (bit % 64)
+		}
+	}
+	return f
+}
+
+// mayContain reports whether key may be in the set; false means it is not.
+func (f *fastFilter) mayContain(key uint64) bool {
+	if !f.bloom {
+		key -= f.base
+		return key/64 < uint64(len(f.bits)) && f.bits[key/64]&(1<<(key%64)) != 0
+	}
+	mask := uint64(len(f.bits)*64 - 1)
+	h1, h2 := bloomHashes(key)
+	for i := uint64(0); i < fastFilterHashes; i++ {
+		bit := (h1 + i*h2) & mask
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHashes derives the two hashes of double hashing from key with the splitmix64
+// finalizer. The second is odd, so the probes of one key are distinct.
+func bloomHashes(key uint64) (uint64, uint64) {
+	key ^= key >> 30
+	key *= 0xbf58476d1ce4e5b9
+	key ^= key >> 27
+	key *= 0x94d049bb133111eb
+	key ^= key >> 31
+	return key, key>>32 | 1
+}
+
+// fastKey returns the bit pattern of an integer value, zero-extended to 64 bits,
+// without allocating. Distinct values of T have distinct keys.
+func fastKey[T TypesValue](v T) uint64 {
+	p := unsafe.Pointer(&v)
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return uint64(*(*uint8)(p))
+	case 2:
+		return uint64(*(*uint16)(p))
+	case 4:
+		return uint64(*(*uint32)(p))
+	default:
+		return *(*uint64)(p)
+	}
+}
@@ -0,0 +1,123 @@
+package enum
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWithFastContains(t *testing.T) {
+	t.Run("Dense", func(t *testing.T) {
+		g := NewGenerator[int](WithFastContains[int]())
+		for i := 0; i < 100; i++ {
+			g.Next(fmt.Sprintf("V%d", i))
+		}
+		if f := g.fastFilter(); f.bloom {
+			t.Error("Expected a bitset for dense values")
+		}
+		for i := -10; i < 200; i++ {
+			if got, want := g.Contains(i), i >= 0 && i < 100; got != want {
+				t.Errorf("Contains(%d) = %v, want %v", i, got, want)
+			}
+		}
+	})
+
+	t.Run("Sparse", func(t *testing.T) {
+		m := map[string]int64{}
+		for i := int64(0); i < 50; i++ {
+			m[fmt.Sprintf("V%d", i)] = i*1_000_003 - 7_000_000
+		}
+		g := NewMapped(m, WithFastContains[int64]())
+		if f := g.fastFilter(); !f.bloom {
+			t.Error("Expected a Bloom filter for sparse values")
+		}
+		for _, v := range m {
+			if !g.Contains(v) {
+				t.Errorf("Expected Contains(%d)", v)
+			}
+		}
+		rejected := 0
+		for i := int64(0); i < 10000; i++ {
+			if g.Contains(i*7 + 1) {
+				t.Errorf("Unexpected Contains(%d)", i*7+1)
+			}
+			if !g.fastFilter().mayContain(fastKey(i*7 + 1)) {
+				rejected++
+			}
+		}
+		if rejected < 9000 {
+			t.Errorf("Expected the filter to reject most misses, rejected %d of 10000", rejected)
+		}
+	})
+
+	t.Run("Narrow and negative", func(t *testing.T) {
+		g := NewMapped(map[string]int8{"Min": -128, "Neg": -1, "Max": 127}, WithFastContains[int8]())
+		for i := -128; i <= 127; i++ {
+			want := i == -128 || i == -1 || i == 127
+			if got := g.Contains(int8(i)); got != want {
+				t.Errorf("Contains(%d) = %v, want %v", i, got, want)
+			}
+		}
+	})
+
+	t.Run("Rebuilt on mutation", func(t *testing.T) {
+		g := NewGenerator[uint16](WithFastContains[uint16]())
+		if g.Contains(0) {
+			t.Error("Expected empty Generator to contain nothing")
+		}
+		g.Next("A")
+		if !g.Contains(0) {
+			t.Error("Expected added value after rebuild")
+		}
+		if err := g.Remove("A"); err != nil {
+			t.Fatal(err)
+		}
+		if g.Contains(0) {
+			t.Error("Expected removed value to be rejected")
+		}
+		if !g.Clone().fastContains {
+			t.Error("Expected Clone to keep the option")
+		}
+	})
+
+	t.Run("Non-integer panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a string Generator")
+			}
+		}()
+		NewGenerator[string](WithFastContains[string]())
+	})
+}
+
+// benchmarkContains looks up values of which 90% are not registered.
+func benchmarkContains(b *testing.B, spread int, opts ...Option[int]) {
+	m := make(map[string]int, 1000)
+	for i := 0; i < 1000; i++ {
+		m[fmt.Sprintf("V%d", i)] = i * spread
+	}
+	g := NewMapped(m, opts...)
+	lookups := make([]int, 1024)
+	for i := range lookups {
+		if i%10 == 0 {
+			lookups[i] = (i % 1000) * spread
+		} else {
+			lookups[i] = 1_000_000_000 + i*spread + 1
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			g.Contains(lookups[i&1023])
+			i++
+		}
+	})
+}
+
+func BenchmarkContains_90PercentMisses(b *testing.B) {
+	b.Run("Dense/Map", func(b *testing.B) { benchmarkContains(b, 1) })
+	b.Run("Dense/Fast", func(b *testing.B) { benchmarkContains(b, 1, WithFastContains[int]()) })
+	b.Run("Sparse/Map", func(b *testing.B) { benchmarkContains(b, 7919) })
+	b.Run("Sparse/Fast", func(b *testing.B) { benchmarkContains(b, 7919, WithFastContains[int]()) })
+}
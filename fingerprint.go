@@ -0,0 +1,103 @@
+package enum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+)
+
+// canonicalMagic identifies version 1 of the canonical byte layout.
+const canonicalMagic = "enum-canonical-v1"
+
+// Kind tags used in the canonical byte layout.
+const (
+	canonicalString   byte = 's'
+	canonicalSigned   byte = 'i'
+	canonicalUnsigned byte = 'u'
+	canonicalFloat    byte = 'f'
+)
+
+// CanonicalBytes returns a deterministic binary serialization of the Generator's
+// entries in insertion order. It is the input to Fingerprint and can be stored and
+// diffed by callers. Unlike Checksum, which hashes the entries ordered by name, it
+// changes when entries are reordered. It is thread-safe, using a read lock for access.
+//
+// Layout (version 1):
+//
+//	"enum-canonical-v1" 0x00        magic and version
+//	kind                            1 byte: 's' string, 'i' signed, 'u' unsigned, 'f' float
+//	count                           uvarint number of entries
+//	for each entry:
+//	    len(name) name              uvarint length followed by UTF-8 bytes
+//	    value                       's': uvarint length followed by bytes
+//	                                'i': 8 bytes big-endian int64
+//	                                'u': 8 bytes big-endian uint64
+//	                                'f': 8 bytes big-endian IEEE-754 float64 bits
+//
+// The layout does not depend on map iteration order, platform, or Go version.
+func (g *Generator[T]) CanonicalBytes() []byte {
+	return canonicalBytes(g.Values())
+}
+
+// Fingerprint returns the hex-encoded SHA-256 of CanonicalBytes. The same logical
+// enum always yields the same fingerprint across processes, which makes it suitable
+// for detecting drift between a binary and persisted data.
+func (g *Generator[T]) Fingerprint() string {
+	return fingerprint(g.CanonicalBytes())
+}
+
+// canonicalBytes serializes entries using the version 1 canonical layout.
+func canonicalBytes[T TypesValue](entries []Value[T]) []byte {
+	kind := canonicalKind(kindOf[T]())
+
+	buf := make([]byte, 0, len(canonicalMagic)+2+binary.MaxVarintLen64+len(entries)*16)
+	buf = append(buf, canonicalMagic...)
+	buf = append(buf, 0, kind)
+	buf = binary.AppendUvarint(buf, uint64(len(entries)))
+
+	for _, entry := range entries {
+		buf = binary.AppendUvarint(buf, uint64(len(entry.name)))
+		buf = append(buf, entry.name...)
+
+		buf = appendCanonicalValue(buf, kind, entry.value)
+	}
+	return buf
+}
+
+// appendCanonicalValue appends the canonical encoding of a value of the given kind.
+func appendCanonicalValue[T TypesValue](buf []byte, kind byte, v T) []byte {
+	switch kind {
+	case canonicalString:
+		s := stringOf(v)
+		buf = binary.AppendUvarint(buf, uint64(len(s)))
+		buf = append(buf, s...)
+	case canonicalSigned:
+		buf = binary.BigEndian.AppendUint64(buf, uint64(intOf(v)))
+	case canonicalUnsigned:
+		buf = binary.BigEndian.AppendUint64(buf, uintOf(v))
+	case canonicalFloat:
+		buf = binary.BigEndian.AppendUint64(buf, math.Float64bits(floatOf(v)))
+	}
+	return buf
+}
+
+// canonicalKind maps a ValueKind to its canonical layout tag.
+func canonicalKind(k ValueKind) byte {
+	switch k.Class {
+	case ClassString:
+		return canonicalString
+	case ClassInt:
+		return canonicalSigned
+	case ClassUint:
+		return canonicalUnsigned
+	default:
+		return canonicalFloat
+	}
+}
+
+// fingerprint returns the hex-encoded SHA-256 of data.
+func fingerprint(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
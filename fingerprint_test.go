@@ -0,0 +1,65 @@
+package enum
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenerator_Fingerprint(t *testing.T) {
+	t.Run("Stable across map order", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			a := NewMapped(map[string]int{"A": 1, "B": 2, "C": 3, "D": 4}, WithSortedEntries[int]())
+			b := NewMapped(map[string]int{"D": 4, "C": 3, "B": 2, "A": 1}, WithSortedEntries[int]())
+			if a.Fingerprint() != b.Fingerprint() {
+				t.Fatal("Expected equal fingerprints for equal mapped definitions")
+			}
+		}
+	})
+
+	t.Run("Entry order matters", func(t *testing.T) {
+		a := mustFromValues(t, NewValue(1, "A"), NewValue(2, "B"))
+		b := mustFromValues(t, NewValue(2, "B"), NewValue(1, "A"))
+		if a.Fingerprint() == b.Fingerprint() {
+			t.Error("Expected reordering to change the fingerprint")
+		}
+		if a.Checksum() != b.Checksum() {
+			t.Error("Expected reordering to leave the order-independent Checksum unchanged")
+		}
+	})
+
+	t.Run("Insertion order matters", func(t *testing.T) {
+		a := NewGenerator[int]()
+		a.Next("A")
+		a.Next("B")
+		b := NewGenerator[int](WithStart(1))
+		b.Next("B")
+		if bytes.Equal(a.CanonicalBytes(), b.CanonicalBytes()) {
+			t.Error("Expected different canonical bytes for different entries")
+		}
+	})
+
+	t.Run("Known layout", func(t *testing.T) {
+		g := NewGenerator[uint8](WithStart[uint8](7))
+		g.Next("X")
+		want := append([]byte("enum-canonical-v1"), 0, 'u', 1, 1, 'X', 0, 0, 0, 0, 0, 0, 0, 7)
+		if got := g.CanonicalBytes(); !bytes.Equal(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if fp := g.Fingerprint(); len(fp) != 64 {
+			t.Errorf("Expected 64 hex characters, got %q", fp)
+		}
+	})
+
+	t.Run("String and float kinds", func(t *testing.T) {
+		s := NewAlpha()
+		s.Next("First")
+		if !bytes.Contains(s.CanonicalBytes(), []byte{'s'}) {
+			t.Error("Expected string kind tag")
+		}
+		f := NewNumeric(0.5)
+		f.Next("Half")
+		if bytes.Equal(f.CanonicalBytes(), s.CanonicalBytes()) {
+			t.Error("Expected different canonical bytes for different kinds")
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// fixture is the file format of WriteFixture: the entries in order, with the kind of
+// T and the fingerprint so reviewers can see at a glance whether a fixture changed.
+type fixture[T TypesValue] struct {
+	Kind        ValueKind `json:"kind"`
+	Fingerprint string    `json:"fingerprint"`
+	Entries     []Pair[T] `json:"entries"`
+}
+
+// WriteFixture writes the Generator's entries to path as an indented JSON fixture, to be
+// checked in and compared with VerifyFixture in contract tests. Entries are in entry
+// order, one per name, along with the kind of T and the Fingerprint:
+//
+//	{
+//	  "kind": "int",
+//	  "fingerprint": "3b1f...",
+//	  "entries": [
+//	    {"value": 0, "name": "Pending"}, ...
+//
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) WriteFixture(path string) error {
+	f := fixture[T]{Kind: g.kind}
+	for current := false; !current; {
+		// Retry until the entries are read at the version the fingerprint was taken at.
+		version := g.version.Load()
+		f.Fingerprint = g.Fingerprint()
+		g.rlock()
+		if current = g.version.Load() == version; current {
+			f.Entries = g.pairsLocked(false)
+		}
+		g.runlock()
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// VerifyFixture compares the Generator with the fixture at path, written by WriteFixture.
+// It returns nil if the entries match, including their order, or an error wrapping
+// ErrFixtureMismatch that lists the changes from the fixture to the Generator in the
+// DiffText format of EnumDiff.Format. It also fails if the file cannot be read or
+// holds another kind of value. It is thread-safe, using a read lock for access.
+//
+// The usual pattern rewrites fixtures under a test flag and verifies them otherwise;
+// the enumfixture package implements it.
+//
+// Example:
+//
+//	if err := Status.VerifyFixture("testdata/status.enum.json"); err != nil {
+//	    t.Fatal(err)
+//	    // enum: fixture mismatch: testdata/status.enum.json (fixture -> current):
+//	    // ~ Renamed 3: Closed -> Completed
+//	}
+func (g *Generator[T]) VerifyFixture(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var f fixture[T]
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("enum: invalid fixture %s: %w", path, err)
+	}
+	if want := kindOf[T](); f.Kind != want {
+		return fmt.Errorf("enum: invalid fixture %s: kind %s does not match %s", path, f.Kind, want)
+	}
+	entries := make([]Value[T], len(f.Entries))
+	for i, p := range f.Entries {
+		entries[i] = NewValue(p.Value, p.Name)
+	}
+	want, err := NewFromValues(entries)
+	if err != nil {
+		return fmt.Errorf("enum: invalid fixture %s: %w", path, err)
+	}
+
+	g.rlock()
+	got := g.pairsLocked(false)
+	g.runlock()
+	if pairsEqual(f.Entries, got) {
+		return nil
+	}
+	var diff bytes.Buffer
+	if err := want.Diff(g).Format(&diff, DiffText); err != nil {
+		return err
+	}
+	if diff.Len() == 0 {
+		fmt.Fprintf(&diff, "~ Order or shared names changed: %s -> %s\n", formatFixturePairs(f.Entries), formatFixturePairs(got))
+	}
+	return fmt.Errorf("%w: %s (fixture -> current):\n%s", ErrFixtureMismatch, path, strings.TrimSuffix(diff.String(), "\n"))
+}
+
+// pairsEqual reports whether a and b hold the same pairs in the same order.
+func pairsEqual[T TypesValue](a, b []Pair[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// formatFixturePairs formats pairs as "[name=value ...]" in their order.
+func formatFixturePairs[T TypesValue](pairs []Pair[T]) string {
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p.Name + "=" + formatKey(p.Value)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,76 @@
+package enum
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Fixture(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "status.enum.json")
+	g := NewGenerator[int]()
+	g.Next("Pending")
+	g.Next("Active")
+	g.Next("Closed")
+	if err := g.WriteFixture(path); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Format", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{`"kind": "int"`, `"fingerprint": "` + g.Fingerprint() + `"`, `"name": "Closed"`} {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("Expected fixture to contain %s, got:\n%s", want, data)
+			}
+		}
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		if err := g.Clone().VerifyFixture(path); err != nil {
+			t.Errorf("Expected match, got %v", err)
+		}
+	})
+
+	t.Run("Drift", func(t *testing.T) {
+		c := g.Clone()
+		c.Rename("Closed", "Completed")
+		c.Remove("Pending")
+		c.Next("Archived")
+		err := c.VerifyFixture(path)
+		if !errors.Is(err, ErrFixtureMismatch) {
+			t.Fatalf("Expected ErrFixtureMismatch, got %v", err)
+		}
+		want := path + " (fixture -> current):\n- Removed Pending=0\n~ Renamed 2: Closed -> Completed\n+ Added Archived=3"
+		if !strings.HasSuffix(err.Error(), want) {
+			t.Errorf("Expected diff %q, got %q", want, err)
+		}
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		c := g.Clone()
+		c.SortByName()
+		err := c.VerifyFixture(path)
+		if !errors.Is(err, ErrFixtureMismatch) || !strings.Contains(err.Error(), "Order or shared names changed") {
+			t.Errorf("Expected an order mismatch, got %v", err)
+		}
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		other := NewMapped(map[string]string{"Pending": "p"})
+		if err := other.VerifyFixture(path); err == nil || !strings.Contains(err.Error(), "kind int") {
+			t.Errorf("Expected a kind error, got %v", err)
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		if err := g.VerifyFixture(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("Expected ErrNotExist, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// DeriveFlags returns a new Generator mapping each name to the bit mask 1<<value, in
+// the same order, for use as flags (e.g., access-control permissions derived from a
+// sequential enum). Values must be integers in the range [0, 63]; DeriveFlags returns
+// an error listing every value outside it, or if T is not an integer type.
+// It is thread-safe, using a read lock for access.
+//
+// The result is a snapshot: names added to or removed from g afterwards are not
+// reflected until Resync is called on the derived Generator.
+//
+// Example:
+//
+//	roles := NewGenerator[int](WithStart(0))
+//	roles.Next("Read")  // 0
+//	roles.Next("Write") // 1
+//	flags, _ := roles.DeriveFlags()
+//	v, _ := flags.Get("Write") // v == 2
+func (g *Generator[T]) DeriveFlags() (*Generator[uint64], error) {
+	g.rlock()
+	entries := make([]Value[uint64], 0, len(g.values))
+	var errs []error
+	for _, entry := range g.values {
+		bit, err := flagBit(entry.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q: %w", entry.name, err))
+			continue
+		}
+		entries = append(entries, NewValue(uint64(1)<<bit, entry.name))
+	}
+	g.runlock()
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("enum: cannot derive flags: %w", errors.Join(errs...))
+	}
+
+	flags, err := NewFromValues(entries)
+	if err != nil {
+		return nil, fmt.Errorf("enum: cannot derive flags: %w", err)
+	}
+	flags.derive = g.DeriveFlags
+	return flags, nil
+}
+
+// Resync re-derives a Generator returned by DeriveFlags from its source, picking up
+// names added, removed, or renamed since. Display names, descriptions, and other
+// metadata set on the derived Generator are kept.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Returns an error if the Generator was not created by DeriveFlags or if the source
+// now holds values that cannot be flags; the Generator is left unchanged in that case.
+func (g *Generator[T]) Resync() error {
+	g.rlock()
+	derive := g.derive
+	g.runlock()
+	if derive == nil {
+		return errors.New("enum: Resync requires a Generator created by DeriveFlags")
+	}
+	fresh, err := derive()
+	if err != nil {
+		return err
+	}
+
+	g.lock()
+	defer g.unlock()
+	g.values = g.adopt(fresh.values)
+	g.valueMap = fresh.valueMap
+	g.nameMap = fresh.nameMap
+	g.extraNames = fresh.extraNames
+	g.bump()
+	return nil
+}
+
+// flagBit returns the bit position of an integer enum value, checking it fits in a uint64 mask.
+func flagBit[T TypesValue](value T) (uint, error) {
+	switch kindOf[T]().Class {
+	case ClassInt:
+		n := intOf(value)
+		if n < 0 || n >= 64 {
+			return 0, fmt.Errorf("value %d is outside the flag range [0, 63]", n)
+		}
+		return uint(n), nil
+	case ClassUint:
+		n := uintOf(value)
+		if n >= 64 {
+			return 0, fmt.Errorf("value %d is outside the flag range [0, 63]", n)
+		}
+		return uint(n), nil
+	default:
+		return 0, fmt.Errorf("value %v of type %T is not an integer", value, value)
+	}
+}
+
+// CountFlags returns the number of registered flags set in mask. Only bits that are
+// registered single-bit values count; unknown bits are ignored (see ValidateMask).
+// For non-integer types it returns 0. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	perms := NewBitFlagGenerator[uint8](1)
+//	perms.Next("Read")       // 1
+//	perms.Next("Write")      // 2
+//	perms.CountFlags(0b1011) // 2 (bit 8 is not registered)
+func (g *Generator[T]) CountFlags(mask T) int {
+	m, ok := toMask(mask)
+	if !ok {
+		return 0
+	}
+	g.rlock()
+	defer g.runlock()
+	return bits.OnesCount64(m & g.flagBitsLocked())
+}
+
+// LowestFlag returns the registered flag of lowest bit set in mask, ignoring unknown
+// bits, and false if mask contains no registered flag.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) LowestFlag(mask T) (Value[T], bool) {
+	return g.flagAt(mask, func(m uint64) int { return bits.TrailingZeros64(m) })
+}
+
+// HighestFlag returns the registered flag of highest bit set in mask, ignoring unknown
+// bits, and false if mask contains no registered flag.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) HighestFlag(mask T) (Value[T], bool) {
+	return g.flagAt(mask, func(m uint64) int { return 63 - bits.LeadingZeros64(m) })
+}
+
+// ValidateMask checks that every bit set in mask is a registered flag. The error wraps
+// ErrUnknownValue and lists the unknown bits. A zero mask is valid.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	err := perms.ValidateMask(0b1011) // invalid enum value: unknown bits 0x8 in mask 0xb
+func (g *Generator[T]) ValidateMask(mask T) error {
+	m, ok := toMask(mask)
+	if !ok {
+		return fmt.Errorf("%w: %v is not an integer mask", ErrUnknownValue, mask)
+	}
+	g.rlock()
+	defer g.runlock()
+	if unknown := m &^ g.flagBitsLocked(); unknown != 0 {
+		return fmt.Errorf("%w: unknown bits %#x in mask %#x", ErrUnknownValue, unknown, m)
+	}
+	return nil
+}
+
+// flagAt implements LowestFlag and HighestFlag, using pick to select a bit of the
+// registered bits set in mask.
+func (g *Generator[T]) flagAt(mask T, pick func(uint64) int) (Value[T], bool) {
+	m, ok := toMask(mask)
+	if !ok {
+		return Value[T]{}, false
+	}
+	g.rlock()
+	defer g.runlock()
+	m &= g.flagBitsLocked()
+	if m == 0 {
+		return Value[T]{}, false
+	}
+	value := fromMask[T](uint64(1) << pick(m))
+	return g.entry(value, g.valueMap[value]), true
+}
+
+// flagBitsLocked returns the union of the registered values that are single bits.
+// Composite values (e.g., ReadWrite = Read|Write) are not flags of their own.
+// The caller must hold the read lock.
+func (g *Generator[T]) flagBitsLocked() uint64 {
+	var known uint64
+	for value := range g.valueMap {
+		if m, ok := toMask(value); ok && bits.OnesCount64(m) == 1 {
+			known |= m
+		}
+	}
+	return known
+}
+
+// toMask returns the bits of an integer value, limited to the width of T so that
+// negative signed values do not set bits beyond it. It reports false for other types.
+func toMask[T TypesValue](value T) (uint64, bool) {
+	switch kindOf[T]().Class {
+	case ClassInt:
+		m := uint64(intOf(value))
+		if w := bitsOf[T](); w < 64 {
+			m &= 1<<w - 1
+		}
+		return m, true
+	case ClassUint:
+		return uintOf(value), true
+	}
+	return 0, false
+}
+
+// fromMask converts bits produced by toMask back to T.
+func fromMask[T TypesValue](m uint64) T {
+	// Truncating the bits to the width of T keeps the top bit of narrow signed types,
+	// which maps them back to negative values.
+	v, _ := fromUint[T](m)
+	return v
+}
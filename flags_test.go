@@ -0,0 +1,114 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_DeriveFlags(t *testing.T) {
+	t.Run("Masks in order", func(t *testing.T) {
+		roles := NewGenerator[int](WithStart(0))
+		roles.Next("Read")
+		roles.Next("Write")
+		roles.Next("Admin")
+		flags, err := roles.DeriveFlags()
+		if err != nil {
+			t.Fatalf("DeriveFlags failed: %v", err)
+		}
+		if got, want := flags.Names(), []string{"Read", "Write", "Admin"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected names %v, got %v", want, got)
+		}
+		for name, want := range map[string]uint64{"Read": 1, "Write": 2, "Admin": 4} {
+			if v, ok := flags.Get(name); !ok || v != want {
+				t.Errorf("Expected %s = %d, got %d", name, want, v)
+			}
+		}
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Neg": -1, "Ok": 3, "Big": 64})
+		_, err := g.DeriveFlags()
+		if err == nil || !strings.Contains(err.Error(), `"Neg"`) || !strings.Contains(err.Error(), `"Big"`) {
+			t.Errorf("Expected error naming Neg and Big, got %v", err)
+		}
+		if _, err := NewMapped(map[string]uint8{"Last": 63}).DeriveFlags(); err != nil {
+			t.Errorf("Expected bit 63 to be accepted, got %v", err)
+		}
+		if _, err := NewMapped(map[string]string{"A": "a"}).DeriveFlags(); err == nil {
+			t.Error("Expected error for non-integer enum")
+		}
+	})
+
+	t.Run("Resync", func(t *testing.T) {
+		roles := NewGenerator[int](WithStart(0))
+		roles.Next("Read")
+		flags, _ := roles.DeriveFlags()
+		flags.SetDescription(1, "May read")
+		roles.Next("Write")
+		if flags.ContainsName("Write") {
+			t.Fatal("Expected derived flags to be a snapshot")
+		}
+		if err := flags.Resync(); err != nil {
+			t.Fatalf("Resync failed: %v", err)
+		}
+		if v, ok := flags.Get("Write"); !ok || v != 2 {
+			t.Errorf("Expected Write = 2 after Resync, got %d", v)
+		}
+		if desc, _ := flags.Description(1); desc != "May read" {
+			t.Errorf("Expected description to survive Resync, got %q", desc)
+		}
+		if err := roles.Resync(); err == nil {
+			t.Error("Expected Resync to fail on a non-derived Generator")
+		}
+	})
+}
+
+func testFlagHelpers[T uint8 | uint32 | uint64](t *testing.T, high T) {
+	g := NewMapped(map[string]T{"Read": 1, "Write": 2, "Execute": 4, "High": high})
+	mask := T(1|4|8) | high // Bit 8 is not registered.
+
+	if got := g.CountFlags(mask); got != 3 {
+		t.Errorf("CountFlags(%#x): expected 3, got %d", mask, got)
+	}
+	if v, ok := g.LowestFlag(mask); !ok || v.String() != "Read" {
+		t.Errorf("LowestFlag(%#x): expected Read, got %v", mask, v)
+	}
+	if v, ok := g.HighestFlag(mask); !ok || v.Get() != high || v.String() != "High" {
+		t.Errorf("HighestFlag(%#x): expected High, got %v", mask, v)
+	}
+	if _, ok := g.LowestFlag(8); ok {
+		t.Error("Expected no flag for a mask of unknown bits only")
+	}
+	err := g.ValidateMask(mask)
+	if !errors.Is(err, ErrUnknownValue) || !strings.Contains(err.Error(), "unknown bits 0x8") {
+		t.Errorf("Expected unknown bit 0x8 to be reported, got %v", err)
+	}
+	if err := g.ValidateMask(T(1|2) | high); err != nil {
+		t.Errorf("Expected mask of registered flags to be valid, got %v", err)
+	}
+}
+
+func TestGenerator_FlagHelpers(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) { testFlagHelpers[uint8](t, 1<<7) })
+	t.Run("uint32", func(t *testing.T) { testFlagHelpers[uint32](t, 1<<31) })
+	t.Run("uint64", func(t *testing.T) { testFlagHelpers[uint64](t, 1<<63) })
+
+	t.Run("Composite values", func(t *testing.T) {
+		g := NewMapped(map[string]int8{"Read": 1, "Write": 2, "ReadWrite": 3, "Sign": -128})
+		if got := g.CountFlags(3); got != 2 {
+			t.Errorf("Expected composite value not to count as a flag, got %d", got)
+		}
+		if v, ok := g.HighestFlag(-128 | 1); !ok || v.String() != "Sign" {
+			t.Errorf("Expected sign bit flag, got %v", v)
+		}
+	})
+
+	t.Run("Non-integer", func(t *testing.T) {
+		g := NewMapped(map[string]string{"A": "a"})
+		if g.CountFlags("a") != 0 || g.ValidateMask("a") == nil {
+			t.Error("Expected flag helpers to reject non-integer types")
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package enum
+
+import (
+	"math"
+)
+
+// ContainsWithEpsilon checks if a value within epsilon of the given value exists in
+// the enum set. It is an opt-in alternative to Contains for float enums, whose keys
+// are otherwise compared exactly. For non-float types it behaves like Contains.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]float64{"Third": 1.0 / 3})
+//	g.Contains(0.3333)                   // false
+//	g.ContainsWithEpsilon(0.3333, 1e-3) // true
+func (g *Generator[T]) ContainsWithEpsilon(value T, epsilon float64) bool {
+	_, ok := g.ContainsApprox(value, epsilon)
+	return ok
+}
+
+// ContainsApprox is like ContainsWithEpsilon but also returns the entry matched: the
+// one whose value is nearest to value, if it is within epsilon (inclusive). It is meant
+// for float enums looked up with values that went through a lossy round-trip (e.g., a
+// rate of 0.075 decoded as 0.07500000000000001). For non-float types it is an exact
+// lookup.
+//
+// It scans every entry, so it costs O(n) rather than the map lookup of Contains.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	rates := NewMapped(map[string]float64{"Reduced": 0.075, "Standard": 0.2})
+//	v, ok := rates.ContainsApprox(0.07500000000000001, 1e-9) // Reduced, true
+func (g *Generator[T]) ContainsApprox(value T, epsilon float64) (Value[T], bool) {
+	v, distance, ok := g.NearestValue(value)
+	if !ok || distance > epsilon {
+		return Value[T]{}, false
+	}
+	return v, true
+}
+
+// NearestValue returns the entry whose value is nearest to value, with the absolute
+// difference between them. Ties go to the entry defined first. It returns false if the
+// Generator is empty or value is NaN; for non-float types, it only finds an exact match.
+//
+// It scans every entry, so it costs O(n). It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	rates := NewMapped(map[string]float64{"Reduced": 0.075, "Standard": 0.2})
+//	v, d, _ := rates.NearestValue(0.19) // Standard, 0.01
+func (g *Generator[T]) NearestValue(value T) (Value[T], float64, bool) {
+	if !kindOf[T]().IsFloat() {
+		if name, ok := g.Name(value); ok {
+			return g.entry(value, name), 0, true
+		}
+		return Value[T]{}, 0, false
+	}
+	target := floatOf(value)
+	if math.IsNaN(target) {
+		return Value[T]{}, 0, false
+	}
+
+	if g.parent != nil {
+		// The parent's entries come first, so they win ties.
+		nearest, best, found := g.parent.NearestValue(value)
+		if own, d, ok := g.nearestOwn(target); ok && (!found || d < best) {
+			return own, d, true
+		}
+		return g.entry(nearest.value, nearest.name), best, found
+	}
+	return g.nearestOwn(target)
+}
+
+// nearestOwn implements NearestValue for a float target over the Generator's own
+// entries, leaving out the parent of an overlay.
+func (g *Generator[T]) nearestOwn(target float64) (Value[T], float64, bool) {
+	g.rlock()
+	defer g.runlock()
+	var nearest Value[T]
+	best, found := math.Inf(1), false
+	for _, entry := range g.values {
+		d := math.Abs(floatOf(entry.value) - target)
+		if !found || d < best {
+			nearest, best, found = entry, d, true
+		}
+	}
+	if !found {
+		return Value[T]{}, 0, false
+	}
+	return g.entry(nearest.value, g.valueMap[nearest.value]), best, true
+}
+
+// isNaN reports whether v is a floating-point NaN, the only comparable value
+// that does not equal itself. It is always false for non-float types.
+func isNaN[T comparable](v T) bool {
+	return v != v
+}
@@ -0,0 +1,122 @@
+package enum
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGenerator_NaN(t *testing.T) {
+	t.Run("NewMapped rejects NaN", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for NaN value in NewMapped")
+			}
+		}()
+		NewMapped(map[string]float64{"Bad": math.NaN()})
+	})
+
+	t.Run("Next rejects NaN", func(t *testing.T) {
+		g := NewGenerator[float32](WithIncrementer(func(float32) float32 {
+			return float32(math.NaN())
+		}))
+		g.Next("Zero")
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic for NaN value in Next")
+			}
+		}()
+		g.Next("NaN")
+	})
+
+	t.Run("Inf is allowed", func(t *testing.T) {
+		g := NewMapped(map[string]float64{"Max": math.Inf(1)})
+		if !g.Contains(math.Inf(1)) {
+			t.Error("Expected +Inf to be found")
+		}
+	})
+
+	t.Run("isNaN", func(t *testing.T) {
+		if !isNaN(math.NaN()) || isNaN(1.0) || isNaN("x") || isNaN(0) {
+			t.Error("isNaN returned unexpected results")
+		}
+	})
+}
+
+func TestGenerator_ContainsWithEpsilon(t *testing.T) {
+	g := NewMapped(map[string]float64{"Third": 1.0 / 3, "Half": 0.5})
+	if g.Contains(0.3333) {
+		t.Error("Expected exact Contains to fail")
+	}
+	if !g.ContainsWithEpsilon(0.3333, 1e-3) {
+		t.Error("Expected approximate Contains to succeed")
+	}
+	if g.ContainsWithEpsilon(0.4, 1e-3) {
+		t.Error("Expected approximate Contains to fail outside epsilon")
+	}
+	if g.ContainsWithEpsilon(math.NaN(), 1) {
+		t.Error("Expected NaN to never match")
+	}
+
+	ints := NewMapped(map[string]int{"One": 1})
+	if !ints.ContainsWithEpsilon(1, 0.5) || ints.ContainsWithEpsilon(2, 5) {
+		t.Error("Expected exact matching for non-float types")
+	}
+}
+
+func TestGenerator_NearestValue(t *testing.T) {
+	rates := NewMapped(map[string]float64{"Reduced": 0.075, "Standard": 0.2})
+
+	t.Run("Round-trip", func(t *testing.T) {
+		drifted := math.Nextafter(0.075, 1)
+		if rates.Contains(drifted) {
+			t.Fatal("Expected exact Contains to miss a drifted value")
+		}
+		v, ok := rates.ContainsApprox(drifted, 1e-12)
+		if !ok || v.String() != "Reduced" || v.Get() != 0.075 {
+			t.Errorf("Expected Reduced, got %v, %v", v, ok)
+		}
+	})
+
+	t.Run("Epsilon boundary", func(t *testing.T) {
+		g, _ := NewFromValues([]Value[float64]{NewValue(0.75, "High"), NewValue(0.5, "Low")})
+		if v, ok := g.ContainsApprox(0.625, 0.125); !ok || v.String() != "High" {
+			t.Errorf("Expected inclusive epsilon with tie to the first entry, got %v, %v", v, ok)
+		}
+		if _, ok := g.ContainsApprox(0.625, math.Nextafter(0.125, 0)); ok {
+			t.Error("Expected no match just inside the distance")
+		}
+		if v, ok := g.ContainsApprox(0.55, 0.1); !ok || v.String() != "Low" {
+			t.Errorf("Expected the nearest entry within epsilon, got %v, %v", v, ok)
+		}
+	})
+
+	t.Run("NearestValue", func(t *testing.T) {
+		v, d, ok := rates.NearestValue(0.19)
+		if !ok || v.String() != "Standard" || math.Abs(d-0.01) > 1e-15 {
+			t.Errorf("Expected Standard at 0.01, got %v, %v, %v", v, d, ok)
+		}
+		if _, _, ok := rates.NearestValue(math.NaN()); ok {
+			t.Error("Expected NaN to find nothing")
+		}
+		if _, _, ok := NewMapped(map[string]float64{}).NearestValue(1); ok {
+			t.Error("Expected an empty Generator to find nothing")
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		g := NewMapped(map[string]float32{"Reduced": 0.075})
+		if v, ok := g.ContainsApprox(float32(0.0750001), 1e-6); !ok || v.String() != "Reduced" {
+			t.Errorf("Expected Reduced, got %v, %v", v, ok)
+		}
+	})
+
+	t.Run("Non-float", func(t *testing.T) {
+		ints := NewMapped(map[string]int{"One": 1})
+		if v, d, ok := ints.NearestValue(1); !ok || d != 0 || v.String() != "One" {
+			t.Errorf("Expected exact match, got %v, %v, %v", v, d, ok)
+		}
+		if _, _, ok := ints.NearestValue(2); ok {
+			t.Error("Expected no match for a missing integer")
+		}
+	})
+}
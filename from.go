@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// NewFromSlice creates a Generator assigning sequential integers from start to names,
+// in slice order. Unlike NewMapped, the Generator keeps its incrementer, so Next
+// continues the sequence.
+//
+// Panics if a name is empty, has leading or trailing whitespace, is repeated, or is a
+// number other than its own value (see WithLiteralNameCheck, which the Generator uses).
+// Use NewFromSliceChecked to receive these conditions as an error.
+//
+// Example:
+//
+//	g := NewFromSlice([]string{"Low", "Medium", "High"}, 1)
+//	fmt.Println(g.ValueMap()) // Output: map[1:Low 2:Medium 3:High]
+func NewFromSlice(names []string, start int) *Generator[int] {
+	g, err := NewFromSliceChecked(names, start)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// NewFromSliceChecked is like NewFromSlice but returns an error listing every invalid
+// or duplicate name by index instead of panicking. Each name is validated with
+// NewValueChecked, applying rules.
+func NewFromSliceChecked(names []string, start int, rules ...NameRule) (*Generator[int], error) {
+	g := NewGenerator[int](WithStart(start), WithLiteralNameCheck[int]())
+	var errs []error
+	for i, name := range names {
+		if _, err := NewValueChecked(0, name, rules...); err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+			continue
+		}
+		if _, err := g.TryNext(name); err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return g, nil
+}
+
+// NewFromKeys creates a Generator assigning sequential integers from 0 to the keys of m
+// in sorted order, ignoring its values. It turns configuration maps into enums without
+// building an intermediate map[string]int for NewMapped.
+//
+// Panics if a key is empty, has leading or trailing whitespace, or is a number other
+// than its own value (see WithLiteralNameCheck). Use NewFromKeysChecked to receive
+// these conditions as an error.
+//
+// Example:
+//
+//	limits := map[string]time.Duration{"fast": time.Second, "slow": time.Minute}
+//	g := NewFromKeys(limits)
+//	fmt.Println(g.Names()) // Output: [fast slow]
+func NewFromKeys[V any](m map[string]V) *Generator[int] {
+	g, err := NewFromKeysChecked(m)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// NewFromKeysChecked is like NewFromKeys but returns an error listing every invalid key
+// instead of panicking. Each key is validated with NewValueChecked, applying rules.
+func NewFromKeysChecked[V any](m map[string]V, rules ...NameRule) (*Generator[int], error) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return NewFromSliceChecked(keys, 0, rules...)
+}
@@ -0,0 +1,60 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewFromSlice(t *testing.T) {
+	t.Run("Order and start", func(t *testing.T) {
+		g := NewFromSlice([]string{"Low", "Medium", "High"}, 1)
+		want := map[int]string{1: "Low", 2: "Medium", 3: "High"}
+		if got := g.ValueMap(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if names := g.Names(); !reflect.DeepEqual(names, []string{"Low", "Medium", "High"}) {
+			t.Errorf("Expected slice order, got %v", names)
+		}
+		if v := g.Next("Critical"); v.Get() != 4 {
+			t.Errorf("Expected Next to continue at 4, got %d", v.Get())
+		}
+	})
+
+	t.Run("Checked errors", func(t *testing.T) {
+		_, err := NewFromSliceChecked([]string{"A", "", "A", "b c"}, 0, IdentifierRule)
+		if !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		for _, want := range []string{"element 1", "element 2", "element 3"} {
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to mention %q, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("Panics on duplicate", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a duplicate name")
+			}
+		}()
+		NewFromSlice([]string{"A", "A"}, 0)
+	})
+}
+
+func TestNewFromKeys(t *testing.T) {
+	g := NewFromKeys(map[string]struct{ Limit int }{"slow": {1}, "fast": {10}, "medium": {5}})
+	want := map[int]string{0: "fast", 1: "medium", 2: "slow"}
+	if got := g.ValueMap(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected sorted keys %v, got %v", want, got)
+	}
+
+	if _, err := NewFromKeysChecked(map[string]int{" padded": 1}); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("Expected ErrInvalidName, got %v", err)
+	}
+	if g := NewFromKeys(map[string]bool{}); g.Len() != 0 {
+		t.Errorf("Expected an empty enum, got %d entries", g.Len())
+	}
+}
@@ -25,11 +25,18 @@
 package enum
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Generator provides thread-safe generation and management of enum values for a given type T.
@@ -37,13 +44,88 @@ import (
 // The Generator maintains mappings of values to names and names to values, and provides
 // methods for lookup, parsing, and validation. Use NewGenerator or specialized constructors
 // (e.g., NewNumeric, NewAlpha) to create a Generator.
+//
+// Floating-point values are compared exactly, as Go map keys. NaN is rejected because it
+// never compares equal to itself; use ContainsWithEpsilon for approximate membership checks.
+//
+// A Generator must not be copied: pass *Generator. go vet's copylocks check reports
+// copies, and methods called on a copy panic with "enum: Generator copied after first
+// use" rather than silently diverging from the original. Use Clone for an independent copy.
 type Generator[T TypesValue] struct {
-	mu          sync.RWMutex // Protects concurrent access to generator state.
-	current     T            // Current value for the next enum entry.
-	incrementer func(T) T    // Function to compute the next value in the sequence.
-	values      []Value[T]   // Slice of all generated enum entries.
-	valueMap    map[T]string // Maps values to their string names.
-	nameMap     map[string]T // Maps names to their values.
+	noCopy       noCopy                  // Makes go vet report copies of a Generator.
+	mu           sync.RWMutex            // Protects concurrent access to generator state.
+	current      T                       // Current value for the next enum entry.
+	incrementer  func(T) T               // Function to compute the next value in the sequence.
+	incKind      IncrementerKind         // Which built-in incrementer is in use, reported by Config.
+	start        T                       // First value of the sequence, set by WithStart.
+	modulus      T                       // Cycle length of NewCyclicT generators.
+	prefix       string                  // Prefix of NewPrefixed generators.
+	values       []Value[T]              // Slice of all generated enum entries.
+	valueMap     map[T]string            // Maps values to their canonical (first-registered) names.
+	extraNames   map[T][]string          // Further names of shared values, in registration order.
+	nameMap      map[string]T            // Maps names to their values.
+	history      *history[T]             // Optional change log, nil unless WithHistory is used.
+	journal      *journal                // Destination of journal lines, nil unless Journal is called.
+	sortMapped   bool                    // Set by WithSortedEntries to order NewMapped entries.
+	parent       *Generator[T]           // Generator an Overlay falls through to, nil otherwise.
+	overlayStart T                       // First value of Next in overlays, valid if hasOverlay.
+	hasOverlay   bool                    // Set by WithOverlayStart.
+	overflow     OverflowPolicy          // How Next behaves when the incrementer overflows.
+	exhausted    bool                    // Set under OverflowError once the sequence cannot advance.
+	display      map[string]map[T]string // Localized display names by locale, then value.
+	aliases      map[string]T            // Maps alternate names accepted by Parse to values.
+	label        string                  // Human-readable name of the enum set, used in log records.
+	logger       *slog.Logger            // Optional structured logger, nil unless WithLogger is used.
+	stats        *stats[T]               // Optional usage counters, nil unless WithStats is used.
+	bijective    bool                    // Set by WithBijective to forbid names sharing a value.
+	sqlNames     bool                    // Set by WithSQLNames to store Basic values by name in SQL.
+	emptyName    string                  // Format of Basic.String for unset values, set by WithEmptyNameFormat.
+	unknownName  string                  // Name requested by WithUnknown for the sentinel entry.
+	unknown      T                       // Value of the unknown sentinel, valid if hasUnknown.
+	hasUnknown   bool                    // Whether an unknown sentinel is registered.
+	omitUnknown  bool                    // Set by OmitUnknown to hide the sentinel from Names and ValidValues.
+	descriptions map[T]string            // Human-readable descriptions by value, shown by Catalog.
+	deprecated   map[T]bool              // Values marked with Deprecate.
+	runes        bool                    // Set by WithRuneFormatting to format values as characters.
+	formatter    func(T) string          // Set by WithValueFormatter to render values as text.
+	origin       uint32                  // Provenance ID set by WithProvenance, 0 if entries are untagged.
+	marshal      marshalFlags            // JSON encoding options, set by WithUnsetAsZero and WithInt64AsString.
+	arena        *nameArena              // Optional storage for names, nil unless WithNameArena is used.
+	errs         *errorLog               // Errors recorded in place of panics, nil unless WithErrorMode is used.
+	literalCheck bool                    // Set by WithLiteralNameCheck to reject names shadowing value literals.
+	kind         ValueKind               // Kind of T, computed at construction and reported by Kind.
+	fastContains bool                    // Set by WithFastContains to consult fast before the value map.
+	validators   []validatorFunc[T]      // Checks run before committing a new name, added by WithValidator.
+	nameLimits   *nameLimits             // Constraints on names, nil unless WithMaxNameLength or WithNameCharset is used.
+	matcher      NameMatcher             // Set by WithNameMatcher to resolve normalized names in Parse.
+	normalized   map[string]string       // Names and aliases by normalized form, maintained if matcher is set.
+	keys         *keyIndex[T]            // Secondary keys added with AddKey, nil until then.
+	version      atomic.Uint64           // Incremented by every mutation, see Version.
+
+	// derive rebuilds a Generator returned by DeriveFlags from its source; used by Resync.
+	derive func() (*Generator[T], error)
+
+	// API version ranges by value, set with SetVersionRange and compared with versionCmp.
+	ranges     map[T]VersionRange
+	versionCmp func(a, b string) int
+
+	// evict tracks entries added by GetOrAdd for eviction, nil unless WithEviction is used.
+	evict *evictor[T]
+
+	// prefixes caches the folded name index of ParsePrefix and Complete, rebuilt when
+	// the version changes.
+	prefixes atomic.Pointer[prefixIndex[T]]
+
+	// fast is the fast-reject filter of Contains under WithFastContains, rebuilt when the
+	// version changes.
+	fast atomic.Pointer[fastFilter]
+
+	// snapshot caches the result of Snapshot, rebuilt when the version changes.
+	snapshot atomic.Pointer[Snapshot[T]]
+
+	// self is the address of the Generator, recorded by the constructors and compared
+	// by copyCheck to detect copies.
+	self atomic.Pointer[Generator[T]]
 }
 
 // NewGenerator creates a new Generator for type T with optional configuration options.
@@ -62,12 +144,19 @@ func NewGenerator[T TypesValue](opts ...Option[T]) *Generator[T] {
 	g := &Generator[T]{
 		current:     *new(T),
 		incrementer: defaultIncrementer[T],
+		incKind:     IncrementerNumeric,
 		valueMap:    make(map[T]string),
 		nameMap:     make(map[string]T),
+		kind:        kindOf[T](),
+	}
+	g.self.Store(g)
+	if isString[T]() {
+		g.incKind = IncrementerAlpha
 	}
 	for _, opt := range opts {
 		opt(g)
 	}
+	g.registerUnknown()
 	return g
 }
 
@@ -78,6 +167,7 @@ type Option[T TypesValue] func(*Generator[T])
 func WithStart[T TypesValue](start T) Option[T] {
 	return func(g *Generator[T]) {
 		g.current = start
+		g.start = start
 	}
 }
 
@@ -86,6 +176,29 @@ func WithStart[T TypesValue](start T) Option[T] {
 func WithIncrementer[T TypesValue](inc func(T) T) Option[T] {
 	return func(g *Generator[T]) {
 		g.incrementer = inc
+		g.incKind = IncrementerCustom
+	}
+}
+
+// WithCapacityHint pre-sizes the Generator's internal maps and entry slice for n entries,
+// avoiding incremental growth when many values are added up front. It is only a hint:
+// the Generator still grows beyond n as needed.
+func WithCapacityHint[T TypesValue](n int) Option[T] {
+	return func(g *Generator[T]) {
+		if n <= 0 {
+			return
+		}
+		if len(g.valueMap) == 0 {
+			g.valueMap = make(map[T]string, n)
+		}
+		if len(g.nameMap) == 0 {
+			g.nameMap = make(map[string]T, n)
+		}
+		if cap(g.values) < n {
+			values := make([]Value[T], len(g.values), n)
+			copy(values, g.values)
+			g.values = values
+		}
 	}
 }
 
@@ -127,7 +240,7 @@ func NewNumeric[T TypesValue](start T) *Generator[T] {
 func NewBitFlagGenerator[T TypesValue](start T) *Generator[T] {
 	return NewGenerator[T](
 		WithStart(start),
-		WithIncrementer(func(x T) T {
+		withBuiltinIncrementer(func(x T) T {
 			switch v := any(x).(type) {
 			case int:
 				return any(v << 1).(T)
@@ -152,7 +265,7 @@ func NewBitFlagGenerator[T TypesValue](start T) *Generator[T] {
 			default:
 				return x
 			}
-		}),
+		}, IncrementerBitFlag),
 	)
 }
 
@@ -177,13 +290,14 @@ func NewPrefixed(prefix string, start int) *Generator[string] {
 	}
 	return NewGenerator[string](
 		WithStart(initialValue),
-		WithIncrementer(incrementer),
+		withBuiltinIncrementer(incrementer, IncrementerPrefixed),
+		func(g *Generator[string]) { g.prefix = prefix },
 	)
 }
 
 // NewCyclic creates a Generator for integers that cycle from 0 to modulus-1.
 // If modulus <= 0, it defaults to 1 to avoid division by zero.
-// The generator is thread-safe.
+// It is equivalent to NewCyclicT(0, modulus). The generator is thread-safe.
 //
 // Example:
 //
@@ -193,16 +307,67 @@ func NewPrefixed(prefix string, start int) *Generator[string] {
 //	v3 := g.Next("Two")   // Value[int]{value: 2, name: "Two"}
 //	v4 := g.Next("Zero2") // Value[int]{value: 0, name: "Zero2"}
 func NewCyclic(modulus int) *Generator[int] {
-	if modulus <= 0 {
-		modulus = 1 // Avoid division by zero
+	return NewCyclicT(0, modulus)
+}
+
+// NewCyclicT creates a Generator for integer type T that cycles through the modulus
+// values start, start+1, ..., start+modulus-1, computing ((x - start + 1) mod modulus) + start.
+// If modulus <= 0, it defaults to 1. Combined with WithOverflowPolicy(OverflowError), the
+// generator yields exactly modulus entries before Next fails with ErrExhausted.
+// The generator is thread-safe.
+//
+// Panics if T is not an integer type or if start+modulus-1 does not fit in T.
+// Options are applied after the cyclic configuration.
+//
+// Example:
+//
+//	months := NewCyclicT[uint8](1, 12)
+//	months.Next("Jan")  // 1
+//	// ...
+//	months.Next("Dec")  // 12
+//	months.Next("Jan2") // 1
+func NewCyclicT[T TypesValue](start, modulus T, opts ...Option[T]) *Generator[T] {
+	if !isInteger[T]() {
+		panic(fmt.Sprintf("enum.NewCyclicT: %T is not an integer type", start))
 	}
-	incrementer := func(i int) int {
-		return (i + 1) % modulus
+	var incrementer func(T) T
+	if kindOf[T]().Class == ClassInt {
+		base, m := intOf(start), intOf(modulus)
+		if m <= 0 {
+			m = 1 // Avoid division by zero
+			modulus, _ = fromInt[T](m)
+		}
+		last := base + (m - 1)
+		if _, ok := fromInt[T](last); !ok || last < base {
+			panic(fmt.Sprintf("enum.NewCyclicT: %v+%v-1 overflows %T", start, modulus, start))
+		}
+		incrementer = func(x T) T {
+			d := (intOf(x) - base + 1) % m
+			if d < 0 {
+				d += m // x was below start, e.g. after WithStart.
+			}
+			next, _ := fromInt[T](base + d)
+			return next
+		}
+	} else {
+		base, m := uintOf(start), uintOf(modulus)
+		if m == 0 {
+			m = 1 // Avoid division by zero
+			modulus, _ = fromUint[T](m)
+		}
+		last := base + (m - 1)
+		if _, ok := fromUint[T](last); !ok || last < base {
+			panic(fmt.Sprintf("enum.NewCyclicT: %v+%v-1 overflows %T", start, modulus, start))
+		}
+		incrementer = func(x T) T {
+			d := (uintOf(x) - base + 1) % m
+			next, _ := fromUint[T](base + d)
+			return next
+		}
 	}
-	return NewGenerator[int](
-		WithStart(0),
-		WithIncrementer(incrementer),
-	)
+	setModulus := func(g *Generator[T]) { g.modulus = modulus }
+	builtin := []Option[T]{WithStart(start), withBuiltinIncrementer(incrementer, IncrementerCyclic), setModulus}
+	return NewGenerator[T](append(builtin, opts...)...)
 }
 
 // NewMapped creates a Generator pre-populated with a static map of names to values.
@@ -210,104 +375,423 @@ func NewCyclic(modulus int) *Generator[int] {
 // The Generator supports lookups (Name, Get, Parse) but panics if Next is called,
 // as it does not support sequential generation. The generator is thread-safe.
 //
+// Optional options (e.g., WithLabel, WithBijective) configure the Generator; options
+// affecting sequential generation have no effect.
+//
+// Panics if any value is NaN, or, under WithBijective, if two names share a value;
+// under WithErrorMode, such entries are skipped and recorded instead (see Err).
+//
+// Entries are stored in map iteration order, which varies between runs; use
+// WithSortedEntries for a deterministic order.
+//
 // Example:
 //
 //	m := map[string]int{"Small": 1, "Large": 100}
 //	g := NewMapped(m)
 //	v, err := g.Parse("Small") // Value[int]{value: 1, name: "Small"}
-func NewMapped[T TypesValue](nameToValueMap map[string]T) *Generator[T] {
+func NewMapped[T TypesValue](nameToValueMap map[string]T, opts ...Option[T]) *Generator[T] {
 	g := &Generator[T]{
-		incrementer: nil, // Prevent Next() usage
-		valueMap:    make(map[T]string, len(nameToValueMap)),
-		nameMap:     make(map[string]T, len(nameToValueMap)),
-		values:      make([]Value[T], 0, len(nameToValueMap)),
+		valueMap: make(map[T]string, len(nameToValueMap)),
+		nameMap:  make(map[string]T, len(nameToValueMap)),
+		values:   make([]Value[T], 0, len(nameToValueMap)),
+		kind:     kindOf[T](),
 	}
+	g.self.Store(g)
+	for _, opt := range opts {
+		opt(g)
+	}
+	g.incrementer = nil // Prevent Next() usage
 	for name, value := range nameToValueMap {
-		entry := NewValue(value, name)
-		g.values = append(g.values, entry)
-		g.nameMap[name] = value
-		g.valueMap[value] = name
+		if isNaN(value) {
+			g.raise(fmt.Errorf("enum.NewMapped: NaN value for %q cannot be used as an enum key", name))
+			continue
+		}
+		g.values = append(g.values, g.entry(value, name))
+	}
+	if g.sortMapped {
+		sortByValue(g.values)
+	}
+	kept := g.values[:0]
+	for _, entry := range g.values {
+		if existing, ok := g.valueMap[entry.value]; ok && g.bijective {
+			g.raise(sharedValueError(existing, entry.name, entry.value))
+			continue
+		}
+		if err := g.checkName(entry.name); err != nil {
+			g.raise(err)
+			continue
+		}
+		if err := g.checkLiteralLocked(entry.name, entry.value); err != nil {
+			g.raise(err)
+			continue
+		}
+		if err := g.checkMatchLocked(entry.name, "", nil); err != nil {
+			g.raise(err)
+			continue
+		}
+		if err := g.validate(context.Background(), entry.name, entry.value); err != nil {
+			g.raise(err)
+			continue
+		}
+		g.nameMap[entry.name] = entry.value
+		g.addName(entry.value, entry.name)
+		kept = append(kept, entry)
 	}
+	g.values = kept
+	g.registerUnknown()
 	return g
 }
 
+// NewFromValues creates a Generator pre-populated with the given entries, kept in the
+// order provided. Like NewMapped, the Generator does not support Next. Each entry is
+// validated with NewValueChecked (applying rules), and names must be unique.
+//
+// Returns an error listing every invalid or duplicate entry by index.
+//
+// Example:
+//
+//	g, err := NewFromValues([]Value[int]{NewValue(1, "Low"), NewValue(10, "High")}, IdentifierRule)
+//	fmt.Println(g.Names(), err) // Output: [Low High] <nil>
+func NewFromValues[T TypesValue](values []Value[T], rules ...NameRule) (*Generator[T], error) {
+	g := NewMapped(map[string]T{})
+	var errs []error
+	for i, v := range values {
+		entry, err := NewValueChecked(v.value, v.name, rules...)
+		if err == nil && isNaN(entry.value) {
+			err = fmt.Errorf("NaN value for %q cannot be used as an enum key", entry.name)
+		}
+		if err == nil {
+			if existing, exists := g.nameMap[entry.name]; exists {
+				err = fmt.Errorf("name %q already exists with value %s", entry.name, g.formatValue(existing))
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+			continue
+		}
+		g.values = append(g.values, entry)
+		g.nameMap[entry.name] = entry.value
+		g.addName(entry.value, entry.name)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return g, nil
+}
+
 // Next generates the next enum value in the sequence with the given name.
 // It updates the internal state (valueMap, nameMap, values) and advances the current value
-// using the configured incrementer. It panics if called on a Generator created with NewMapped.
+// using the configured incrementer. It panics if called on a Generator created with NewMapped,
+// if the name already exists, if the next value is NaN, or if the sequence is exhausted
+// under OverflowError. Use TryNext to receive these conditions as errors; under
+// WithErrorMode, Next records them (see Err) and returns the zero Value.
 // The method is thread-safe, using a write lock to protect state modifications.
 //
 // Returns a Value[T] containing the generated value and name.
 func (g *Generator[T]) Next(name string) Value[T] {
+	return g.next(name, "")
+}
+
+// TryNext is like Next but returns an error instead of panicking. It fails if the
+// Generator was created with NewMapped, the name already exists, the next value is NaN,
+// or, under OverflowError, the sequence is exhausted (ErrExhausted).
+func (g *Generator[T]) TryNext(name string) (Value[T], error) {
+	return g.tryNext(context.Background(), name, "")
+}
+
+// next implements Next, attributing the change to actor in the history.
+func (g *Generator[T]) next(name, actor string) Value[T] {
+	entry, err := g.tryNext(context.Background(), name, actor)
+	if err != nil {
+		g.raise(err)
+	}
+	return entry
+}
+
+// tryNext implements TryNext and NextCtx, attributing the change to actor in the history.
+func (g *Generator[T]) tryNext(ctx context.Context, name, actor string) (Value[T], error) {
 	if g.incrementer == nil {
-		panic("enum: cannot call Next() on a Generator created with NewMapped")
+		return Value[T]{}, errors.New("enum: cannot call Next() on a Generator created with NewMapped")
 	}
-	g.mu.Lock()
-	defer g.mu.Unlock()
+	if err := g.checkName(name); err != nil {
+		return Value[T]{}, err
+	}
+	if len(g.validators) > 0 {
+		return g.nextValidated(ctx, name, actor)
+	}
+	g.lock()
+	defer g.unlock()
+	return g.nextLocked(name, actor)
+}
 
+// nextLocked adds the next entry in the sequence. The caller must hold the write lock
+// and have checked that the Generator supports Next.
+func (g *Generator[T]) nextLocked(name, actor string) (Value[T], error) {
 	// FIX: Check for duplicate names before adding.
-	if _, exists := g.nameMap[name]; exists {
-		panic(fmt.Sprintf("enum: name %q already exists", name))
+	if existing, exists := g.nameMap[name]; exists {
+		if g.logger != nil {
+			g.log(slog.LevelWarn, "enum duplicate name", slog.String(LogKeyName, name))
+		}
+		return Value[T]{}, fmt.Errorf("enum: name %q already exists with value %s", name, g.formatValue(existing))
 	}
 
 	val := g.current
-	g.current = g.incrementer(g.current)
-	entry := NewValue(val, name)
-	g.values = append(g.values, entry)
-	g.valueMap[val] = name
+	if isNaN(val) {
+		return Value[T]{}, fmt.Errorf("enum: NaN value for %q cannot be used as an enum key", name)
+	}
+	if err := g.checkLiteralLocked(name, val); err != nil {
+		return Value[T]{}, err
+	}
+	if err := g.checkMatchLocked(name, "", nil); err != nil {
+		return Value[T]{}, err
+	}
+	if g.overflow == OverflowError {
+		if g.exhausted {
+			return Value[T]{}, fmt.Errorf("%w: no value left for %q", ErrExhausted, name)
+		}
+		if _, used := g.valueMap[val]; used {
+			return Value[T]{}, fmt.Errorf("%w: value %s for %q is already used", ErrExhausted, g.formatValue(val), name)
+		}
+	}
+	if existing, used := g.valueMap[val]; used && g.bijective {
+		return Value[T]{}, sharedValueError(existing, name, val)
+	}
+	if err := g.checkParent(name, val); err != nil {
+		return Value[T]{}, err
+	}
+	g.advance()
+
+	if g.arena != nil {
+		name = g.arena.intern(name)
+	}
+	entry := g.entry(val, name)
+	g.appendValue(entry)
+	g.addName(val, name)
 	g.nameMap[name] = val
-	return entry
+	g.record(ChangeAdd, name, "", val, actor)
+	g.bump()
+	if g.logger != nil {
+		g.log(slog.LevelDebug, "enum value added", slog.String(LogKeyName, name), slog.Any(LogKeyValue, val))
+	}
+	return entry, nil
+}
+
+// Remove deletes the entry with the given name from the enum set.
+// If other names share the removed entry's value, the value remains resolvable
+// to one of them. The method is thread-safe, using a write lock to protect state modifications.
+//
+// Returns an error if the name does not exist.
+func (g *Generator[T]) Remove(name string) error {
+	return g.remove(name, "")
+}
+
+// remove implements Remove, attributing the change to actor in the history.
+func (g *Generator[T]) remove(name, actor string) error {
+	g.lock()
+	defer g.unlock()
+	return g.removeLocked(name, actor)
+}
+
+// removeLocked implements remove. The caller must hold the write lock.
+func (g *Generator[T]) removeLocked(name, actor string) error {
+	val, ok := g.nameMap[name]
+	if !ok {
+		return fmt.Errorf("enum: name %q does not exist", name)
+	}
+	delete(g.nameMap, name)
+	if g.evict != nil {
+		g.evict.drop(name)
+	}
+
+	kept := g.values[:0]
+	for _, entry := range g.values {
+		if entry.name != name {
+			kept = append(kept, entry)
+		}
+	}
+	// Clear the now-unused tail so removed entries can be collected.
+	for i := len(kept); i < len(g.values); i++ {
+		g.values[i] = Value[T]{}
+	}
+	g.values = kept
+
+	g.dropName(val, name)
+	if _, ok := g.valueMap[val]; !ok {
+		if g.isUnknown(val) {
+			g.hasUnknown = false
+		}
+		delete(g.descriptions, val)
+		delete(g.deprecated, val)
+		for _, names := range g.display {
+			delete(names, val)
+		}
+		g.keys.drop(val)
+		for alias, v := range g.aliases {
+			if v == val {
+				delete(g.aliases, alias)
+				g.unindexName(alias)
+			}
+		}
+	}
+	g.record(ChangeRemove, name, "", val, actor)
+	g.bump()
+	if g.logger != nil {
+		g.log(slog.LevelDebug, "enum value removed", slog.String(LogKeyName, name), slog.Any(LogKeyValue, val))
+	}
+	return nil
+}
+
+// Rename changes the name of an existing entry, keeping its value and position.
+// The method is thread-safe, using a write lock to protect state modifications.
+//
+// Returns an error if oldName does not exist or newName is already used.
+func (g *Generator[T]) Rename(oldName, newName string) error {
+	return g.rename(oldName, newName, "")
+}
+
+// rename implements Rename, attributing the change to actor in the history.
+func (g *Generator[T]) rename(oldName, newName, actor string) error {
+	err := g.lockValidated(context.Background(), func() []Pair[T] {
+		if val, ok := g.nameMap[oldName]; ok {
+			return []Pair[T]{{Name: newName, Value: val}}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	defer g.unlock()
+	return g.renameLocked(oldName, newName, actor)
+}
+
+// renameLocked implements rename. The caller must hold the write lock.
+func (g *Generator[T]) renameLocked(oldName, newName, actor string) error {
+	val, ok := g.nameMap[oldName]
+	if !ok {
+		return fmt.Errorf("enum: name %q does not exist", oldName)
+	}
+	if existing, exists := g.nameMap[newName]; exists {
+		return fmt.Errorf("enum: name %q already exists with value %s", newName, g.formatValue(existing))
+	}
+	if err := g.checkName(newName); err != nil {
+		return err
+	}
+	if err := g.checkParentName(newName); err != nil {
+		return err
+	}
+	if err := g.checkLiteralLocked(newName, val); err != nil {
+		return err
+	}
+	if err := g.checkMatchLocked(newName, oldName, nil); err != nil {
+		return err
+	}
+	delete(g.nameMap, oldName)
+	g.nameMap[newName] = val
+	if g.evict != nil {
+		g.evict.rename(oldName, newName)
+	}
+	for i := range g.values {
+		if g.values[i].name == oldName {
+			g.values[i].name = newName
+		}
+	}
+	g.renameName(val, oldName, newName)
+	g.record(ChangeRename, newName, oldName, val, actor)
+	g.bump()
+	return nil
 }
 
-// Name returns the name associated with a given value, if it exists.
+// Name returns the name associated with a given value, if it exists. When several names
+// share the value, it returns the canonical (first-registered) one; see NamesOfValue.
 // It is thread-safe, using a read lock for access.
 //
 // Returns the name and true if the value exists, or an empty string and false otherwise.
 func (g *Generator[T]) Name(value T) (string, bool) {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	g.rlock()
 	name, ok := g.valueMap[value]
+	g.runlock()
+	if !ok && g.parent != nil {
+		return g.parent.Name(value)
+	}
 	return name, ok
 }
 
 // Get returns the value associated with a given name, if it exists.
-// It is thread-safe, using a read lock for access.
+// It is thread-safe, using a read lock for access. Under WithEviction, it marks entries
+// added by GetOrAdd as recently used.
 //
 // Returns the value and true if the name exists, or the zero value of T and false otherwise.
 func (g *Generator[T]) Get(name string) (T, bool) {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	v, ok := g.GetValue(name)
+	return v.value, ok
+}
+
+// GetValue is like Get but returns the entry registered under name, so callers needing
+// the Value do not rebuild it from the value. Unlike Parse, it matches names only, not
+// aliases or value literals, and it does not allocate.
+// It is thread-safe, using a read lock for access. Under WithEviction, it marks entries
+// added by GetOrAdd as recently used.
+func (g *Generator[T]) GetValue(name string) (Value[T], bool) {
+	g.rlock()
 	val, ok := g.nameMap[name]
-	return val, ok
+	if ok && g.evict != nil {
+		g.evict.touch(name)
+	}
+	g.runlock()
+	if ok {
+		return g.entry(val, name), true
+	}
+	if g.parent != nil {
+		return g.parentName(name, true)
+	}
+	return Value[T]{}, false
 }
 
 // Values returns a copy of all generated enum entries as a slice of Value[T].
 // It is thread-safe, using a read lock and returning a copy to prevent external modification.
 func (g *Generator[T]) Values() []Value[T] {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	if g.parent != nil {
+		return g.overlayValues()
+	}
+	g.rlock()
+	defer g.runlock()
 	valsCopy := make([]Value[T], len(g.values))
 	copy(valsCopy, g.values)
 	return valsCopy
 }
 
-// ValueMap returns a copy of the map of values to names.
+// ValueMap returns a copy of the map of values to names. On an overlay, it holds the
+// parent's values too, as Name resolves them.
 // It is thread-safe, using a read lock and returning a copy to prevent external modification.
 func (g *Generator[T]) ValueMap() map[T]string {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	mapCopy := make(map[T]string, len(g.valueMap))
+	var mapCopy map[T]string
+	if g.parent != nil {
+		mapCopy = g.parent.ValueMap()
+	}
+	g.rlock()
+	defer g.runlock()
+	if mapCopy == nil {
+		mapCopy = make(map[T]string, len(g.valueMap))
+	}
 	for k, v := range g.valueMap {
 		mapCopy[k] = v
 	}
 	return mapCopy
 }
 
-// NameMap returns a copy of the map of names to values.
+// NameMap returns a copy of the map of names to values. On an overlay, it holds the
+// parent's names too, except those the overlay shadows, as Get resolves them.
 // It is thread-safe, using a read lock and returning a copy to prevent external modification.
 func (g *Generator[T]) NameMap() map[string]T {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	mapCopy := make(map[string]T, len(g.nameMap))
+	var mapCopy map[string]T
+	if g.parent != nil {
+		mapCopy = g.parent.NameMap()
+	}
+	g.rlock()
+	defer g.runlock()
+	if mapCopy == nil {
+		mapCopy = make(map[string]T, len(g.nameMap))
+	}
 	for k, v := range g.nameMap {
 		mapCopy[k] = v
 	}
@@ -315,77 +799,633 @@ func (g *Generator[T]) NameMap() map[string]T {
 }
 
 // Contains checks if a value exists in the generated enum set.
-// It is thread-safe, using a read lock for access.
+// It is thread-safe, using a read lock for access. Under WithFastContains, most values
+// that do not exist are rejected without taking the lock.
 func (g *Generator[T]) Contains(value T) bool {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	if g.fastContains && !g.fastFilter().mayContain(fastKey(value)) {
+		return false
+	}
+	g.rlock()
 	_, ok := g.valueMap[value]
+	g.runlock()
+	if !ok && g.parent != nil {
+		return g.parent.Contains(value)
+	}
 	return ok
 }
 
-// Names returns a slice of all enum names.
+// ContainsName checks if a name exists in the generated enum set.
 // It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ContainsName(name string) bool {
+	g.rlock()
+	_, ok := g.nameMap[name]
+	g.runlock()
+	if !ok && g.parent != nil {
+		return g.parent.ContainsName(name)
+	}
+	return ok
+}
+
+// ContainsAll checks if every given value exists in the enum set.
+// It returns true for an empty argument list. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ContainsAll(values ...T) bool {
+	if g.parent != nil {
+		for _, v := range values {
+			if !g.Contains(v) {
+				return false
+			}
+		}
+		return true
+	}
+	g.rlock()
+	defer g.runlock()
+	for _, v := range values {
+		if _, ok := g.valueMap[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAnyName checks if at least one of the given names exists in the enum set.
+// It returns false for an empty argument list. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ContainsAnyName(names ...string) bool {
+	if g.parent != nil {
+		for _, name := range names {
+			if g.ContainsName(name) {
+				return true
+			}
+		}
+		return false
+	}
+	g.rlock()
+	defer g.runlock()
+	for _, name := range names {
+		if _, ok := g.nameMap[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns a slice of all enum names. The WithUnknown sentinel is left out
+// if the Generator was created with OmitUnknown. It is thread-safe, using a read lock for access.
 func (g *Generator[T]) Names() []string {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	names := make([]string, len(g.values))
-	for i, val := range g.values {
-		names[i] = val.String()
+	if g.parent != nil {
+		values := g.overlayValues()
+		names := make([]string, 0, len(values))
+		for _, val := range values {
+			if !g.parent.omitUnknown || !g.parent.isUnknown(val.value) {
+				names = append(names, val.name)
+			}
+		}
+		return names
+	}
+	g.rlock()
+	defer g.runlock()
+	names := make([]string, 0, len(g.values))
+	for _, val := range g.values {
+		if g.omitUnknown && g.isUnknown(val.value) {
+			continue
+		}
+		names = append(names, val.name)
 	}
 	return names
 }
 
-// MarshalJSON implements json.Marshaler, serializing the Generator's value-to-name map.
+// Len returns the number of enum entries.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Len() int {
+	if g.parent != nil {
+		return len(g.overlayValues())
+	}
+	g.rlock()
+	defer g.runlock()
+	return len(g.values)
+}
+
+// CheckConsistency verifies the Generator's internal invariants: every entry has a
+// unique name, and the value-to-name and name-to-value maps agree with the entries.
+// Several names may share a value, in which case the value resolves to its canonical name.
+// It is intended for tests and fuzzing, and is thread-safe, using a read lock for access.
+//
+// Returns nil if the Generator is consistent, or an error describing the first violation.
+func (g *Generator[T]) CheckConsistency() error {
+	g.rlock()
+	defer g.runlock()
+	if err := checkConsistency(g.values, g.valueMap, g.nameMap, false); err != nil {
+		return err
+	}
+	return g.checkNames()
+}
+
+// checkConsistency verifies that entries, valueMap, and nameMap describe the same
+// enum set. Names must be unique; if uniqueValues is set, values must be too.
+// Otherwise valueMap may resolve a shared value to any one of its names.
+func checkConsistency[T comparable](entries []Value[T], valueMap map[T]string, nameMap map[string]T, uniqueValues bool) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.name] {
+			return fmt.Errorf("enum: duplicate entry for name %q", entry.name)
+		}
+		seen[entry.name] = true
+
+		if v, ok := nameMap[entry.name]; !ok || v != entry.value {
+			return fmt.Errorf("enum: nameMap has %v for %q, entry has %v", v, entry.name, entry.value)
+		}
+		name, ok := valueMap[entry.value]
+		if !ok {
+			return fmt.Errorf("enum: valueMap is missing value %v of %q", entry.value, entry.name)
+		}
+		if uniqueValues && name != entry.name {
+			return fmt.Errorf("enum: value %v is shared by %q and %q", entry.value, name, entry.name)
+		}
+	}
+	if len(nameMap) != len(entries) {
+		return fmt.Errorf("enum: nameMap has %d names, expected %d", len(nameMap), len(entries))
+	}
+	for value, name := range valueMap {
+		if v, ok := nameMap[name]; !ok || v != value {
+			return fmt.Errorf("enum: valueMap maps %v to %q, which does not map back", value, name)
+		}
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the Generator, including its sequence position,
+// options, display names, aliases, and history. The copy evolves independently of the original.
+// Under WithProvenance, the copy gets its own ID, so entries of the original are foreign to it.
+// The clone of an overlay is an overlay of the same parent. The copy starts at Version 0,
+// like a new Generator: versions only order the states of one Generator.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Clone() *Generator[T] {
+	g.rlock()
+	defer g.runlock()
+
+	c := &Generator[T]{
+		current:      g.current,
+		incrementer:  g.incrementer,
+		incKind:      g.incKind,
+		start:        g.start,
+		modulus:      g.modulus,
+		prefix:       g.prefix,
+		values:       make([]Value[T], len(g.values)),
+		valueMap:     make(map[T]string, len(g.valueMap)),
+		nameMap:      make(map[string]T, len(g.nameMap)),
+		overflow:     g.overflow,
+		exhausted:    g.exhausted,
+		bijective:    g.bijective,
+		sqlNames:     g.sqlNames,
+		emptyName:    g.emptyName,
+		literalCheck: g.literalCheck,
+		fastContains: g.fastContains,
+		validators:   g.validators,
+		nameLimits:   g.nameLimits,
+		matcher:      g.matcher,
+		unknownName:  g.unknownName,
+		unknown:      g.unknown,
+		hasUnknown:   g.hasUnknown,
+		omitUnknown:  g.omitUnknown,
+		label:        g.label,
+		logger:       g.logger,
+		runes:        g.runes,
+		formatter:    g.formatter,
+		marshal:      g.marshal,
+		parent:       g.parent,
+		overlayStart: g.overlayStart,
+		hasOverlay:   g.hasOverlay,
+		derive:       g.derive,
+		versionCmp:   g.versionCmp,
+		evict:        g.evict.clone(),
+		keys:         g.keys.clone(),
+		kind:         g.kind,
+	}
+	c.self.Store(c)
+	if g.arena != nil {
+		c.arena = &nameArena{} // Existing names stay valid; new ones go to the clone's own buffers.
+	}
+	if g.stats != nil {
+		c.stats = &stats[T]{} // Counters start fresh; the clone is a new enum set.
+	}
+	if g.errs != nil {
+		c.errs = &errorLog{}
+	}
+	copy(c.values, g.values)
+	if g.origin != 0 {
+		c.origin = nextProvenanceID() // The clone is a new enum set, like its stats.
+		c.adopt(c.values)
+	}
+	for k, v := range g.valueMap {
+		c.valueMap[k] = v
+	}
+	for k, v := range g.nameMap {
+		c.nameMap[k] = v
+	}
+	if g.extraNames != nil {
+		c.extraNames = make(map[T][]string, len(g.extraNames))
+		for k, v := range g.extraNames {
+			c.extraNames[k] = append([]string(nil), v...)
+		}
+	}
+	if g.display != nil {
+		c.display = make(map[string]map[T]string, len(g.display))
+		for locale, names := range g.display {
+			c.display[locale] = make(map[T]string, len(names))
+			for k, v := range names {
+				c.display[locale][k] = v
+			}
+		}
+	}
+	if g.aliases != nil {
+		c.aliases = make(map[string]T, len(g.aliases))
+		for k, v := range g.aliases {
+			c.aliases[k] = v
+		}
+	}
+	if g.normalized != nil {
+		c.normalized = make(map[string]string, len(g.normalized))
+		for k, v := range g.normalized {
+			c.normalized[k] = v
+		}
+	}
+	if g.descriptions != nil {
+		c.descriptions = make(map[T]string, len(g.descriptions))
+		for k, v := range g.descriptions {
+			c.descriptions[k] = v
+		}
+	}
+	if g.deprecated != nil {
+		c.deprecated = make(map[T]bool, len(g.deprecated))
+		for k, v := range g.deprecated {
+			c.deprecated[k] = v
+		}
+	}
+	if g.ranges != nil {
+		c.ranges = make(map[T]VersionRange, len(g.ranges))
+		for k, v := range g.ranges {
+			c.ranges[k] = v
+		}
+	}
+	if g.history != nil {
+		c.history = &history[T]{
+			records: make([]ChangeRecord[T], len(g.history.records)),
+			next:    g.history.next,
+			full:    g.history.full,
+		}
+		copy(c.history.records, g.history.records)
+	}
+	return c
+}
+
+// MarshalJSON implements json.Marshaler, serializing the Generator's value-to-name map
+// as a JSON object in entry order, e.g. {"1":"Pending","2":"Active"}. Keys are formatted
+// by the package rather than by encoding/json map handling, so float values work too
+// (e.g., {"0.5":"Half"}, using the shortest representation that round-trips). Under
+// WithRuneFormatting, keys are the characters themselves (e.g., {"+":"Plus"}). A value
+// with several names is written once, under its canonical name.
+//
+// For string types, an object of strings cannot tell values from names, so MarshalJSON
+// writes the ordered array form of MarshalOrderedJSON instead, e.g.
+// [{"value":"tok_a","name":"Active"}].
 // It is thread-safe, using a read lock for access.
 func (g *Generator[T]) MarshalJSON() ([]byte, error) {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	return json.Marshal(g.valueMap)
+	if isString[T]() {
+		return g.MarshalOrderedJSON()
+	}
+	g.rlock()
+	defer g.runlock()
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, pair := range g.pairsLocked(true) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(g.jsonKey(pair.Value))
+		name, _ := json.Marshal(pair.Name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(name)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
-// UnmarshalJSON implements json.Unmarshaler, deserializing a JSON object into the
-// Generator's value-to-name map. It clears existing state and populates valueMap, nameMap,
-// and values. It is thread-safe, using a write lock for state modification.
+// orientationKey is the member of a JSON object that states which side holds the names
+// (see UnmarshalJSON).
+const orientationKey = "_orientation"
+
+// Orientations accepted under orientationKey.
+const (
+	orientationValueToName = "value_to_name"
+	orientationNameToValue = "name_to_value"
+)
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the Generator's entries with those
+// in data, which may be any of:
+//
+//   - the array form of MarshalOrderedJSON, [{"value":1,"name":"Pending"}], kept in order;
+//   - a value-to-name object as written by MarshalJSON, {"1":"Pending"}, with keys parsed
+//     as decimal integers, floats, raw strings, or single characters under WithRuneFormatting;
+//   - an object with an explicit "_orientation" member, either "value_to_name" as above or
+//     "name_to_value", e.g. {"_orientation":"name_to_value","Pending":1}.
+//
+// Entries from objects are ordered by value. For string types, an object without
+// "_orientation" is rejected: its keys could be values or names, and guessing wrong
+// would silently invert the data.
+//
+// It populates valueMap, nameMap, and values, and leaves the Generator unchanged if the
+// JSON is invalid or assigns one name to several values (a *BijectionError). It
+// delegates to Reload, so the new entries are validated in full and swapped in at once.
+// It is thread-safe, using a write lock for state modification.
 //
 // Note: This sets incrementer to nil, making the Generator behave like one created with NewMapped.
 func (g *Generator[T]) UnmarshalJSON(data []byte) error {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-	g.valueMap = make(map[T]string)
-	g.nameMap = make(map[string]T)
-	g.values = nil
+	_, err := g.Reload(data)
+	return err
+}
+
+// decodeJSONEntries decodes data as UnmarshalJSON does, returning the entries and maps
+// that would replace the Generator's state.
+func (g *Generator[T]) decodeJSONEntries(data []byte) ([]Value[T], map[T]string, map[string]T, error) {
+	pairs, ordered, err := g.decodeJSONPairs(data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	valueMap := make(map[T]string, len(pairs))
+	values := make([]Value[T], 0, len(pairs))
+	for _, pair := range pairs {
+		if err := g.checkName(pair.Name); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := g.checkParent(pair.Name, pair.Value); err != nil {
+			return nil, nil, nil, err
+		}
+		if other, ok := valueMap[pair.Value]; ok {
+			// Distinct keys such as "1.0" and "1" can denote the same value.
+			return nil, nil, nil, sharedValueError(other, pair.Name, pair.Value)
+		}
+		valueMap[pair.Value] = pair.Name
+		values = append(values, g.entry(pair.Value, pair.Name))
+	}
+	if !ordered {
+		sortByValue(values)
+	}
+	nameMap := make(map[string]T, len(valueMap))
+	matched := make(map[string]string)
+	for _, entry := range values {
+		if other, ok := nameMap[entry.name]; ok {
+			return nil, nil, nil, &BijectionError{Names: []string{entry.name}, Values: []any{other, entry.value}}
+		}
+		nameMap[entry.name] = entry.value
+		if g.matcher == nil {
+			continue
+		}
+		key := g.matcher.Normalize(entry.name)
+		if other, ok := matched[key]; ok {
+			return nil, nil, nil, fmt.Errorf("%w: name %q matches %q after normalization", ErrInvalidName, entry.name, other)
+		}
+		matched[key] = entry.name
+	}
+	for _, entry := range values {
+		if err := g.validate(context.Background(), entry.name, entry.value); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return values, valueMap, nameMap, nil
+}
+
+// replaceLocked replaces the Generator's entries with decoded ones, as UnmarshalJSON
+// does. The caller must hold the write lock.
+func (g *Generator[T]) replaceLocked(values []Value[T], valueMap map[T]string, nameMap map[string]T) {
+	g.valueMap = valueMap
+	g.extraNames = nil
+	g.nameMap = nameMap
+	g.values = values
 	g.incrementer = nil
-	return json.Unmarshal(data, &g.valueMap)
+	g.reindexLocked()
+	g.bump()
+}
+
+// decodeJSONPairs decodes any of the forms accepted by UnmarshalJSON. It reports whether
+// the pairs are in a meaningful order (the array form) rather than map order.
+func (g *Generator[T]) decodeJSONPairs(data []byte) ([]Pair[T], bool, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var pairs []Pair[T]
+		if err := json.Unmarshal(trimmed, &pairs); err != nil {
+			return nil, false, err
+		}
+		return pairs, true, nil
+	}
+
+	raw, orientation, err := g.decodeJSONObject(data)
+	if err != nil {
+		return nil, false, err
+	}
+	pairs := make([]Pair[T], 0, len(raw))
+	for key, member := range raw {
+		pair, err := g.decodeJSONMember(orientation, key, member)
+		if err != nil {
+			return nil, false, fmt.Errorf("enum: invalid entry %q: %w", key, err)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, false, nil
 }
 
-// Parse attempts to parse a string into an enum value.
-// It first checks if the string matches a known name in nameMap. If not, it attempts to parse
-// the string as a value literal using parseStringToValue and checks if the parsed value exists
-// in valueMap. It is thread-safe, using a read lock for access.
+// decodeJSONObject decodes the object form accepted by UnmarshalJSON into its members,
+// without the orientation marker, and the orientation they are in.
+func (g *Generator[T]) decodeJSONObject(data []byte) (map[string]json.RawMessage, string, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, "", err
+	}
+	orientation := orientationValueToName
+	if marker, ok := raw[orientationKey]; ok {
+		if err := json.Unmarshal(marker, &orientation); err != nil {
+			return nil, "", fmt.Errorf("enum: invalid %s: %w", orientationKey, err)
+		}
+		delete(raw, orientationKey)
+		if orientation != orientationValueToName && orientation != orientationNameToValue {
+			return nil, "", fmt.Errorf("enum: unknown %s %q (use %q or %q)",
+				orientationKey, orientation, orientationValueToName, orientationNameToValue)
+		}
+	} else if isString[T]() && len(raw) > 0 {
+		return nil, "", fmt.Errorf("enum: ambiguous JSON object for string enum values; "+
+			"use the array form [{\"value\":...,\"name\":...}] or add %q: %q or %q",
+			orientationKey, orientationValueToName, orientationNameToValue)
+	}
+	return raw, orientation, nil
+}
+
+// decodeJSONMember decodes one member of the object form in the given orientation.
+func (g *Generator[T]) decodeJSONMember(orientation, key string, member json.RawMessage) (Pair[T], error) {
+	var pair Pair[T]
+	var err error
+	switch orientation {
+	case orientationValueToName:
+		if err = json.Unmarshal(member, &pair.Name); err == nil {
+			pair.Value, err = g.parseJSONKey(key)
+		}
+	default: // orientationNameToValue
+		pair.Name = key
+		if err = json.Unmarshal(member, &pair.Value); err != nil {
+			var s string
+			if json.Unmarshal(member, &s) == nil {
+				pair.Value, err = g.parseJSONKey(s)
+			}
+		}
+	}
+	return pair, err
+}
+
+// parseJSONKey parses a value as written in JSON object keys by MarshalJSON.
+func (g *Generator[T]) parseJSONKey(key string) (T, error) {
+	if value, ok := g.parseRune(key); ok {
+		return value, nil
+	}
+	return parseKey[T](key)
+}
+
+// LoadJSON reads JSON in any form accepted by UnmarshalJSON (e.g., a snapshot saved to a
+// file by MarshalJSON) and returns a Generator holding its entries, which behaves like one created with NewMapped.
+// Optional options configure the Generator as in NewMapped; name constraints such as
+// WithMaxNameLength apply to the loaded entries.
+//
+// Example:
+//
+//	f, _ := os.Open("status.v1.json")
+//	defer f.Close()
+//	g, err := LoadJSON[int](f)
+func LoadJSON[T TypesValue](r io.Reader, opts ...Option[T]) (*Generator[T], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	g := NewMapped(map[string]T{}, opts...)
+	if err := g.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// sortByValue orders entries by value, then by name, for deterministic output.
+func sortByValue[T TypesValue](values []Value[T]) {
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].value != values[j].value {
+			return values[i].value < values[j].value
+		}
+		return values[i].name < values[j].name
+	})
+}
+
+// Parse attempts to parse a string into an enum value. It resolves s in this order,
+// stopping at the first match:
+//
+//  1. a registered name;
+//  2. an alias (see AddAlias), in which case the returned Value carries the canonical name;
+//  3. under WithRuneFormatting, a character literal (e.g., "a" or "'a'");
+//  4. a value literal of T (e.g., "2", "0x10", or "1_000" for integers), if an entry
+//     has that value.
+//
+// A name therefore shadows the literal it spells: if "2" is registered as the name of
+// value 5, Parse("2") returns value 5. WithLiteralNameCheck rejects such names.
+// It is thread-safe, using a read lock for access.
 //
 // Returns a Value[T] if successful, or an error if no matching name or value is found.
 func (g *Generator[T]) Parse(s string) (Value[T], error) {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
+	var v Value[T]
+	err := g.ParseInto(s, &v)
+	return v, err
+}
+
+// ParseInto is like Parse but writes the result into *dst, leaving it unchanged on
+// error. Resolving a registered name, alias, or value literal does not allocate, so hot
+// paths can reuse one Value across calls.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	var v Value[int]
+//	for _, field := range fields {
+//		if err := g.ParseInto(field, &v); err != nil {
+//			return err
+//		}
+//		counts[v.Get()]++
+//	}
+func (g *Generator[T]) ParseInto(s string, dst *Value[T]) error {
+	g.rlock()
+	defer g.runlock()
+	v, err := g.parseLocked(s)
+	if g.stats != nil {
+		if err != nil {
+			g.stats.miss(s)
+		} else {
+			g.stats.counter(v.value).parses.Add(1)
+		}
+	}
+	if err != nil {
+		if g.logger != nil {
+			g.log(slog.LevelDebug, "enum parse miss",
+				slog.String(LogKeyInput, s), slog.String(LogKeySuggestion, nearestName(s, g.nameMap)))
+		}
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+// parseLocked implements Parse. The caller must hold the read lock.
+func (g *Generator[T]) parseLocked(s string) (Value[T], error) {
+	if _, own := g.nameMap[s]; !own && g.parent != nil {
+		if _, alias := g.aliases[s]; !alias {
+			if v, ok := g.parentName(s, false); ok {
+				return v, nil
+			}
+		}
+	}
+	v, err := g.parseOwnLocked(s)
+	if err != nil && g.parent != nil {
+		if pv, ok := g.parent.probe(s); ok {
+			return g.entry(pv.value, pv.name), nil
+		}
+	}
+	return v, err
+}
+
+// parseOwnLocked resolves s against the Generator's own entries, leaving out the
+// parent of an overlay. The caller must hold the read lock.
+func (g *Generator[T]) parseOwnLocked(s string) (Value[T], error) {
 	if val, ok := g.nameMap[s]; ok {
-		return NewValue(val, s), nil
+		return g.entry(val, s), nil
+	}
+	if val, ok := g.aliases[s]; ok {
+		return g.entry(val, g.canonicalLocked(val)), nil
+	}
+	if v, ok := g.parseMatchLocked(s); ok {
+		return v, nil
+	}
+	if val, ok := g.parseRune(s); ok {
+		if name, ok := g.valueMap[val]; ok {
+			return g.entry(val, name), nil
+		}
+		return Value[T]{}, fmt.Errorf("no matching enum value for %q", s)
 	}
 	parsedVal, err := parseStringToValue[T](s)
 	if err != nil {
 		return Value[T]{}, err
 	}
 	if name, ok := g.valueMap[parsedVal]; ok {
-		return NewValue(parsedVal, name), nil
+		return g.entry(parsedVal, name), nil
 	}
 	return Value[T]{}, fmt.Errorf("no matching enum value for %q", s)
 }
 
-// MustParse is like Parse but panics on error.
+// MustParse is like Parse but panics on error, naming the Generator as raise does.
 // It is thread-safe, using a read lock for access.
 func (g *Generator[T]) MustParse(s string) Value[T] {
 	val, err := g.Parse(s)
 	if err != nil {
-		panic(err)
+		panic(g.panicError(err))
 	}
 	return val
 }
@@ -396,31 +1436,134 @@ func (g *Generator[T]) MustParse(s string) Value[T] {
 // Returns nil if the value exists, or an error otherwise.
 func (g *Generator[T]) Validate(value T) error {
 	if !g.Contains(value) {
-		return fmt.Errorf("invalid enum value: %v", value)
+		if g.stats != nil {
+			g.stats.validateFailures.Add(1)
+		}
+		if g.logger != nil {
+			g.log(slog.LevelDebug, "enum validate failed", slog.Any(LogKeyValue, value))
+		}
+		return fmt.Errorf("%w: %s", ErrUnknownValue, g.formatValue(value))
+	}
+	if g.stats != nil {
+		g.stats.counter(value).validations.Add(1)
 	}
 	return nil
 }
 
+// ValidateEntry is like Validate but takes a Value, failing with ErrUnset if the Value
+// was never set, so an unset Value is not mistaken for a registered zero value, and with
+// ErrForeignEntry if it was handed out by another Generator created with WithProvenance,
+// even if its value is registered here too. Untagged values are checked by value alone.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	var v Value[int]
+//	err := g.ValidateEntry(v) // errors.Is(err, ErrUnset) even if 0 is registered
+//
+//	payment := payments.Next("Refunded") // 3, from a Generator using WithProvenance
+//	err = orders.ValidateEntry(payment)  // errors.Is(err, ErrForeignEntry) even if 3 is registered
+func (g *Generator[T]) ValidateEntry(v Value[T]) error {
+	if !v.set {
+		return ErrUnset
+	}
+	if v.origin != 0 && v.origin != g.origin {
+		return fmt.Errorf("%w: %q (%s)", ErrForeignEntry, v.name, g.formatValue(v.value))
+	}
+	return g.Validate(v.value)
+}
+
 // ValidateName checks if a name is valid for this enum set.
 // It is thread-safe, using a read lock for access.
 //
 // Returns nil if the name exists, or an error otherwise.
 func (g *Generator[T]) ValidateName(name string) error {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	if _, ok := g.nameMap[name]; !ok {
+	g.rlock()
+	defer g.runlock()
+	if _, ok := g.nameMap[name]; !ok && (g.parent == nil || !g.parent.ContainsName(name)) {
 		return fmt.Errorf("invalid enum name: %q", name)
 	}
 	return nil
 }
 
-// ValidValues returns a slice of all valid values in the enum set.
+// ParseAll parses each string with Parse, returning the values in input order.
+// All failures are collected into a single error (via errors.Join) listing the
+// failing indices and inputs; in that case the returned slice is nil.
 // It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	vals, err := g.ParseAll([]string{"Active", "Bogus", "Closed"})
+//	// err: element 1 "Bogus": no matching enum value for "Bogus"
+func (g *Generator[T]) ParseAll(ss []string) ([]Value[T], error) {
+	return g.parseAll(ss, false)
+}
+
+// ParseAllUnique is like ParseAll but drops repeated values, keeping the
+// first occurrence of each in input order.
+func (g *Generator[T]) ParseAllUnique(ss []string) ([]Value[T], error) {
+	return g.parseAll(ss, true)
+}
+
+// parseAll implements ParseAll and ParseAllUnique.
+func (g *Generator[T]) parseAll(ss []string, unique bool) ([]Value[T], error) {
+	result := make([]Value[T], 0, len(ss))
+	var seen map[T]bool
+	if unique {
+		seen = make(map[T]bool, len(ss))
+	}
+	var errs []error
+	for i, s := range ss {
+		v, err := g.Parse(s)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("element %d %q: %w", i, s, err))
+			continue
+		}
+		if unique {
+			if seen[v.value] {
+				continue
+			}
+			seen[v.value] = true
+		}
+		result = append(result, v)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// ValidateAll checks that every value is valid for this enum set.
+// All failures are collected into a single error (via errors.Join) listing the
+// failing indices and values, each wrapping ErrUnknownValue.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ValidateAll(values []T) error {
+	var errs []error
+	for i, v := range values {
+		if err := g.Validate(v); err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidValues returns a slice of all valid values in the enum set, including the
+// parent's on an overlay. The WithUnknown sentinel is left out if the Generator was
+// created with OmitUnknown. It is thread-safe, using a read lock for access.
 func (g *Generator[T]) ValidValues() []T {
-	g.mu.RLock()
-	defer g.mu.RUnlock()
-	values := make([]T, 0, len(g.valueMap))
+	var values []T
+	if g.parent != nil {
+		values = g.parent.ValidValues()
+	}
+	g.rlock()
+	defer g.runlock()
+	if values == nil {
+		values = make([]T, 0, len(g.valueMap))
+	}
 	for v := range g.valueMap {
+		if g.omitUnknown && g.isUnknown(v) {
+			continue
+		}
 		values = append(values, v)
 	}
 	return values
@@ -428,8 +1571,9 @@ func (g *Generator[T]) ValidValues() []T {
 
 // defaultIncrementer provides default increment logic for supported types.
 // For integers and floats, it adds 1. For strings, it increments alphabetically
-// (e.g., "A" -> "B", "Z" -> "AA", "AZ" -> "BA"). It is used when no custom
-// incrementer is provided to NewGenerator.
+// (e.g., "A" -> "B", "Z" -> "AA", "AZ" -> "BA"). Named types are incremented like
+// their underlying type. It is used when no custom incrementer is provided to
+// NewGenerator.
 func defaultIncrementer[T TypesValue](x T) T {
 	switch v := any(x).(type) {
 	case string:
@@ -470,5 +1614,17 @@ func defaultIncrementer[T TypesValue](x T) T {
 	case float64:
 		return any(v + 1).(T)
 	}
-	return x
+	// Named types (e.g., type Status int) match no case above.
+	switch kindOf[T]().Class {
+	case ClassString:
+		return fromString[T](defaultIncrementer(stringOf(x)))
+	case ClassInt:
+		next, _ := fromInt[T](intOf(x) + 1)
+		return next
+	case ClassUint:
+		next, _ := fromUint[T](uintOf(x) + 1)
+		return next
+	default:
+		return fromFloat[T](floatOf(x) + 1)
+	}
 }
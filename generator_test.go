@@ -2,8 +2,11 @@ package enum
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -175,6 +178,64 @@ func TestGenerator_JSON(t *testing.T) {
 	})
 }
 
+func TestGenerator_JSON_Keys(t *testing.T) {
+	t.Run("Float64 round trip", func(t *testing.T) {
+		g := NewMapped(map[string]float64{"Third": 1.0 / 3, "Half": 0.5, "Big": 1e21, "Inf": math.Inf(1)}, WithSortedEntries[float64]())
+		b, err := json.Marshal(g)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		want := `{"0.3333333333333333":"Third","0.5":"Half","1e+21":"Big","+Inf":"Inf"}`
+		if string(b) != want {
+			t.Errorf("Unexpected JSON\n got %s\nwant %s", b, want)
+		}
+		var back Generator[float64]
+		if err := json.Unmarshal(b, &back); err != nil {
+			t.Fatalf("UnmarshalJSON failed: %v", err)
+		}
+		if string(back.CanonicalBytes()) != string(g.CanonicalBytes()) {
+			t.Errorf("Expected identical round trip, got %v", back.Values())
+		}
+	})
+
+	t.Run("Float32", func(t *testing.T) {
+		g := NewMapped(map[string]float32{"Tenth": 0.1})
+		b, _ := json.Marshal(g)
+		if string(b) != `{"0.1":"Tenth"}` {
+			t.Errorf("Expected shortest float32 form, got %s", b)
+		}
+	})
+
+	t.Run("Entry order and escaping", func(t *testing.T) {
+		g := NewGenerator[string](WithStart("b\"q"), WithIncrementer(func(s string) string { return "a" }))
+		g.Next("Quoted")
+		g.Next("Plain")
+		b, _ := json.Marshal(g)
+		if string(b) != `[{"value":"b\"q","name":"Quoted"},{"value":"a","name":"Plain"}]` {
+			t.Errorf("Unexpected JSON %s", b)
+		}
+		ints := NewGenerator[int](WithStart(10), WithIncrementer(func(i int) int { return i - 8 }))
+		ints.Next("Ten")
+		ints.Next("Two")
+		if b, _ := json.Marshal(ints); string(b) != `{"10":"Ten","2":"Two"}` {
+			t.Errorf("Expected entry order, got %s", b)
+		}
+	})
+
+	t.Run("Invalid keys", func(t *testing.T) {
+		var g Generator[int8]
+		for _, in := range []string{`{"x":"A"}`, `{"300":"A"}`, `{"1.5":"A"}`} {
+			if err := json.Unmarshal([]byte(in), &g); err == nil {
+				t.Errorf("Expected error for %s", in)
+			}
+		}
+		var f Generator[float64]
+		if err := json.Unmarshal([]byte(`{"1":"A","1.0":"B"}`), &f); !errors.Is(err, ErrNotBijective) {
+			t.Errorf("Expected keys denoting the same value to fail, got %v", err)
+		}
+	})
+}
+
 func TestGenerator_Parse(t *testing.T) {
 	g := NewGenerator[int]()
 	g.Next("One") // 0
@@ -206,6 +267,91 @@ func TestGenerator_Parse(t *testing.T) {
 	})
 }
 
+func TestGenerator_ParseInto(t *testing.T) {
+	g := NewGenerator[int]()
+	g.Next("One") // 0
+	g.Next("Two") // 1
+	g.AddAlias("Two", "Second")
+
+	t.Run("Hits", func(t *testing.T) {
+		for _, in := range []string{"Two", "Second", "1"} {
+			var v Value[int]
+			if err := g.ParseInto(in, &v); err != nil || v != NewValue(1, "Two") {
+				t.Errorf("ParseInto(%q) = %v, %v; want {1 Two}", in, v, err)
+			}
+		}
+	})
+	t.Run("MissKeepsDst", func(t *testing.T) {
+		v := NewValue(0, "One")
+		if err := g.ParseInto("Three", &v); err == nil {
+			t.Error("Expected error for parsing unknown name, got nil")
+		}
+		if v != NewValue(0, "One") {
+			t.Errorf("Expected dst unchanged on error, got %v", v)
+		}
+	})
+	t.Run("NoAllocs", func(t *testing.T) {
+		var v Value[int]
+		for _, in := range []string{"Two", "Second", "1"} {
+			if allocs := testing.AllocsPerRun(100, func() { _ = g.ParseInto(in, &v) }); allocs != 0 {
+				t.Errorf("ParseInto(%q): expected zero allocations, got %v", in, allocs)
+			}
+			if allocs := testing.AllocsPerRun(100, func() { v, _ = g.Parse(in) }); allocs != 0 {
+				t.Errorf("Parse(%q): expected zero allocations, got %v", in, allocs)
+			}
+		}
+	})
+}
+
+func TestGenerator_GetValue(t *testing.T) {
+	g := NewMapped(map[string]string{"Red": "r"})
+	g.AddAlias("Red", "Crimson")
+	if v, ok := g.GetValue("Red"); !ok || v != NewValue("r", "Red") {
+		t.Errorf("GetValue(Red) = %v, %v; want {r Red}", v, ok)
+	}
+	for _, name := range []string{"Crimson", "r", "Blue"} {
+		if _, ok := g.GetValue(name); ok {
+			t.Errorf("GetValue(%q) matched; want names only", name)
+		}
+	}
+	if allocs := testing.AllocsPerRun(100, func() { _, _ = g.GetValue("Red") }); allocs != 0 {
+		t.Errorf("Expected zero allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkGenerator_Parse(b *testing.B) {
+	g := NewGenerator[int]()
+	for _, name := range []string{"ident", "number", "string", "lparen", "rparen"} {
+		g.Next(name)
+	}
+	b.Run("Parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			g.Parse("string")
+		}
+	})
+	b.Run("ParseInto", func(b *testing.B) {
+		b.ReportAllocs()
+		var v Value[int]
+		for i := 0; i < b.N; i++ {
+			g.ParseInto("string", &v)
+		}
+	})
+	b.Run("ParseIntoLiteral", func(b *testing.B) {
+		b.ReportAllocs()
+		var v Value[int]
+		for i := 0; i < b.N; i++ {
+			g.ParseInto("2", &v)
+		}
+	})
+	b.Run("GetValue", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			g.GetValue("string")
+		}
+	})
+}
+
 func TestGenerator_Validate(t *testing.T) {
 	g := NewMapped(map[string]int{"OK": 200, "Error": 500})
 	if err := g.Validate(200); err != nil {
@@ -283,3 +429,320 @@ func TestGenerator_Additional(t *testing.T) {
 		}
 	})
 }
+
+func TestGenerator_CheckConsistency(t *testing.T) {
+	t.Run("Sequential", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		g.Next("B")
+		if err := g.CheckConsistency(); err != nil {
+			t.Errorf("CheckConsistency failed: %v", err)
+		}
+	})
+
+	t.Run("Shared values", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 1, "Alias": 1})
+		if err := g.CheckConsistency(); err != nil {
+			t.Errorf("CheckConsistency failed: %v", err)
+		}
+	})
+
+	t.Run("After UnmarshalJSON", func(t *testing.T) {
+		var g Generator[int]
+		if err := json.Unmarshal([]byte(`{"2":"B","1":"A"}`), &g); err != nil {
+			t.Fatalf("UnmarshalJSON failed: %v", err)
+		}
+		if err := g.CheckConsistency(); err != nil {
+			t.Errorf("CheckConsistency failed: %v", err)
+		}
+		if !reflect.DeepEqual(g.Names(), []string{"A", "B"}) {
+			t.Errorf("Expected names ordered by value, got %v", g.Names())
+		}
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		g.nameMap["Ghost"] = 7
+		if err := g.CheckConsistency(); err == nil {
+			t.Error("Expected inconsistency after corrupting nameMap")
+		}
+	})
+}
+
+func TestGenerator_ParseAll(t *testing.T) {
+	g := NewMapped(map[string]int{"Active": 1, "Closed": 2})
+
+	t.Run("Success", func(t *testing.T) {
+		vals, err := g.ParseAll([]string{"Closed", "1", "Closed"})
+		if err != nil {
+			t.Fatalf("ParseAll failed: %v", err)
+		}
+		if len(vals) != 3 || vals[0].Get() != 2 || vals[1].String() != "Active" {
+			t.Errorf("Unexpected result %v", vals)
+		}
+	})
+
+	t.Run("Unique", func(t *testing.T) {
+		vals, err := g.ParseAllUnique([]string{"Closed", "1", "2", "Active"})
+		if err != nil {
+			t.Fatalf("ParseAllUnique failed: %v", err)
+		}
+		if len(vals) != 2 || vals[0].String() != "Closed" || vals[1].String() != "Active" {
+			t.Errorf("Expected [Closed Active], got %v", vals)
+		}
+	})
+
+	t.Run("Collects failures", func(t *testing.T) {
+		vals, err := g.ParseAll([]string{"Active", "Bogus", "Closed", "9"})
+		if err == nil || vals != nil {
+			t.Fatalf("Expected error and nil result, got %v, %v", vals, err)
+		}
+		msg := err.Error()
+		if !strings.Contains(msg, `element 1 "Bogus"`) || !strings.Contains(msg, `element 3 "9"`) {
+			t.Errorf("Expected failing indices and inputs in %q", msg)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		vals, err := g.ParseAll(nil)
+		if err != nil || len(vals) != 0 {
+			t.Errorf("Expected empty result, got %v, %v", vals, err)
+		}
+	})
+}
+
+func TestGenerator_ValidateAll(t *testing.T) {
+	g := NewMapped(map[string]int{"Active": 1, "Closed": 2})
+	if err := g.ValidateAll([]int{1, 2, 1}); err != nil {
+		t.Errorf("Expected valid values, got %v", err)
+	}
+	err := g.ValidateAll([]int{1, 5, 2, 7})
+	if !errors.Is(err, ErrUnknownValue) {
+		t.Fatalf("Expected ErrUnknownValue, got %v", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "element 1: invalid enum value: 5") || !strings.Contains(msg, "element 3") {
+		t.Errorf("Unexpected message %q", msg)
+	}
+}
+
+func TestGenerator_ContainsName(t *testing.T) {
+	g := NewMapped(map[string]string{"Read": "r", "Write": "w"})
+	if !g.ContainsName("Read") || g.ContainsName("r") {
+		t.Error("ContainsName returned unexpected results")
+	}
+	if !g.ContainsAll("r", "w") || g.ContainsAll("r", "x") || !g.ContainsAll() {
+		t.Error("ContainsAll returned unexpected results")
+	}
+	if !g.ContainsAnyName("Admin", "Write") || g.ContainsAnyName("Admin") || g.ContainsAnyName() {
+		t.Error("ContainsAnyName returned unexpected results")
+	}
+}
+
+func TestGenerator_WithCapacityHint(t *testing.T) {
+	g := NewGenerator[int](WithCapacityHint[int](100))
+	if cap(g.values) != 100 {
+		t.Errorf("Expected values capacity 100, got %d", cap(g.values))
+	}
+	for i := 0; i < 150; i++ {
+		g.Next(fmt.Sprintf("V%d", i))
+	}
+	if g.Len() != 150 {
+		t.Errorf("Expected to grow past the hint, got %d entries", g.Len())
+	}
+}
+
+func benchmarkPopulate(b *testing.B, hint int) {
+	names := make([]string, 50000)
+	for i := range names {
+		names[i] = fmt.Sprintf("Value%d", i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g := NewGenerator[int](WithCapacityHint[int](hint))
+		for _, name := range names {
+			g.Next(name)
+		}
+	}
+}
+
+func BenchmarkGenerator_Populate50k(b *testing.B) {
+	b.Run("NoHint", func(b *testing.B) { benchmarkPopulate(b, 0) })
+	b.Run("WithHint", func(b *testing.B) { benchmarkPopulate(b, 50000) })
+}
+
+func TestNewFromValues(t *testing.T) {
+	t.Run("Valid keeps order", func(t *testing.T) {
+		g, err := NewFromValues([]Value[int]{NewValue(10, "High"), NewValue(1, "Low")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(g.Names(), []string{"High", "Low"}) {
+			t.Errorf("Expected input order, got %v", g.Names())
+		}
+		if _, err := g.TryNext("More"); err == nil {
+			t.Error("Expected Next to be unsupported")
+		}
+		if err := g.CheckConsistency(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Errors by index", func(t *testing.T) {
+		_, err := NewFromValues([]Value[int]{
+			NewValue(1, "Low"), NewValue(2, ""), NewValue(3, "Low"), NewValue(4, "Two Words"),
+		}, IdentifierRule)
+		if !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("Expected ErrInvalidName, got %v", err)
+		}
+		for _, want := range []string{"element 1", `element 2: name "Low" already exists`, "element 3"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %v", want, err)
+			}
+		}
+	})
+}
+
+func TestNewCyclicT(t *testing.T) {
+	t.Run("uint8 months", func(t *testing.T) {
+		g := NewCyclicT[uint8](1, 12)
+		var got []uint8
+		for i := 0; i < 14; i++ {
+			got = append(got, g.Next(fmt.Sprintf("M%d", i)).Get())
+		}
+		want := []uint8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 1, 2}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("uint8 top of range", func(t *testing.T) {
+		g := NewCyclicT[uint8](250, 6)
+		for _, want := range []uint8{250, 251, 252, 253, 254, 255, 250} {
+			if v := g.Next(fmt.Sprint(want, "-", g.Len())); v.Get() != want {
+				t.Fatalf("Expected %d, got %d", want, v.Get())
+			}
+		}
+	})
+
+	t.Run("Negative base", func(t *testing.T) {
+		g := NewCyclicT[int16](-2, 3)
+		for _, want := range []int16{-2, -1, 0, -2} {
+			if v := g.Next(fmt.Sprint("V", g.Len())); v.Get() != want {
+				t.Fatalf("Expected %d, got %d", want, v.Get())
+			}
+		}
+	})
+
+	t.Run("Capacity equals modulus", func(t *testing.T) {
+		g := NewCyclicT[uint8](1, 12, WithOverflowPolicy[uint8](OverflowError))
+		for i := 0; i < 12; i++ {
+			if _, err := g.TryNext(fmt.Sprint("M", i)); err != nil {
+				t.Fatalf("Entry %d failed: %v", i, err)
+			}
+		}
+		if _, err := g.TryNext("Extra"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted after 12 entries, got %v", err)
+		}
+	})
+
+	t.Run("Panics", func(t *testing.T) {
+		for name, fn := range map[string]func(){
+			"float":    func() { NewCyclicT(0.0, 3.0) },
+			"string":   func() { NewCyclicT("a", "c") },
+			"overflow": func() { NewCyclicT[uint8](250, 7) },
+		} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s: expected panic", name)
+					}
+				}()
+				fn()
+			}()
+		}
+	})
+}
+
+func TestGenerator_JSON_StringOrientation(t *testing.T) {
+	tokens := NewMapped(map[string]string{"Active": "tok_a", "Pending": "tok_p"})
+
+	t.Run("Round trip", func(t *testing.T) {
+		b, err := json.Marshal(tokens)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back Generator[string]
+		if err := json.Unmarshal(b, &back); err != nil {
+			t.Fatalf("UnmarshalJSON failed on %s: %v", b, err)
+		}
+		if string(back.CanonicalBytes()) != string(tokens.CanonicalBytes()) {
+			t.Errorf("Expected identical round trip, got %v", back.Values())
+		}
+	})
+
+	t.Run("Explicit orientations", func(t *testing.T) {
+		for _, in := range []string{
+			`{"_orientation":"value_to_name","tok_a":"Active","tok_p":"Pending"}`,
+			`{"_orientation":"name_to_value","Active":"tok_a","Pending":"tok_p"}`,
+		} {
+			var g Generator[string]
+			if err := json.Unmarshal([]byte(in), &g); err != nil {
+				t.Fatalf("%s: %v", in, err)
+			}
+			if name, ok := g.Name("tok_a"); !ok || name != "Active" {
+				t.Errorf("%s: expected tok_a -> Active, got %q", in, name)
+			}
+			if value, ok := g.Get("Pending"); !ok || value != "tok_p" {
+				t.Errorf("%s: expected Pending -> tok_p, got %q", in, value)
+			}
+		}
+	})
+
+	t.Run("Ambiguous bare object", func(t *testing.T) {
+		var g Generator[string]
+		err := json.Unmarshal([]byte(`{"Active":"tok_a"}`), &g)
+		if err == nil || !strings.Contains(err.Error(), "_orientation") {
+			t.Errorf("Expected an error pointing at the explicit formats, got %v", err)
+		}
+		if err := json.Unmarshal([]byte(`{"_orientation":"sideways"}`), &g); err == nil {
+			t.Error("Expected an error for an unknown orientation")
+		}
+	})
+
+	t.Run("Other types", func(t *testing.T) {
+		var g Generator[int]
+		if err := json.Unmarshal([]byte(`{"_orientation":"name_to_value","Two":2,"One":1}`), &g); err != nil {
+			t.Fatal(err)
+		}
+		if names := g.Names(); !reflect.DeepEqual(names, []string{"One", "Two"}) {
+			t.Errorf("Expected entries ordered by value, got %v", names)
+		}
+		if err := json.Unmarshal([]byte(`[{"value":5,"name":"Five"},{"value":1,"name":"One"}]`), &g); err != nil {
+			t.Fatal(err)
+		}
+		if names := g.Names(); !reflect.DeepEqual(names, []string{"Five", "One"}) {
+			t.Errorf("Expected array order kept, got %v", names)
+		}
+	})
+}
+
+func TestGenerator_NamedTypes(t *testing.T) {
+	type status int8
+	type code string
+	s := NewGenerator[status](WithStart[status](126), WithOverflowPolicy[status](OverflowError))
+	if a, b := s.Next("A").Get(), s.Next("B").Get(); a != 126 || b != 127 {
+		t.Errorf("Expected 126, 127, got %d, %d", a, b)
+	}
+	if _, err := s.TryNext("C"); !errors.Is(err, ErrExhausted) {
+		t.Errorf("Expected ErrExhausted past the int8 range, got %v", err)
+	}
+	c := NewGenerator[code](WithStart[code]("Y"))
+	if a, b := c.Next("A").Get(), c.Next("B").Get(); a != "Y" || b != "Z" {
+		t.Errorf("Expected Y, Z, got %s, %s", a, b)
+	}
+	if v := c.Next("C").Get(); v != "AA" {
+		t.Errorf("Expected AA, got %s", v)
+	}
+}
@@ -0,0 +1,216 @@
+package enum
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"io"
+)
+
+// LoadGoConsts reads Go source from src and returns a Generator holding every constant
+// of the named type, keyed by the constant identifiers. This lets tooling (exporters,
+// docs) work against legacy iota enums without code changes.
+//
+// Constants belong to the type when their spec declares it (A Status = iota), when they
+// repeat such a spec implicitly, or when their value is a conversion (A = Status(1)).
+// Values may use iota, integer and rune literals, arithmetic and shift operators,
+// conversions, and references to other constants in the same source; blank (_) names
+// are skipped. The type itself does not need to be declared in src. Entries are ordered
+// by value, then by name.
+//
+// Errors include the source position (line:column) of the offending constant.
+//
+// Example:
+//
+//	src := `package p
+//	const (
+//		Pending Status = iota
+//		Active
+//		_
+//		Closed
+//	)`
+//	g, err := LoadGoConsts(strings.NewReader(src), "Status")
+//	fmt.Println(g.Name(3)) // Output: Closed true
+func LoadGoConsts(src io.Reader, typeName string) (*Generator[int64], error) {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("enum.LoadGoConsts: %w", err)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", data, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("enum.LoadGoConsts: %w", err)
+	}
+
+	known := make(map[string]constant.Value) // Every evaluable constant, for references.
+	found := make(map[string]int64)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		var lastType ast.Expr
+		var lastValues []ast.Expr
+		for index, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Values) > 0 {
+				lastType, lastValues = vs.Type, vs.Values
+			}
+			for i, ident := range vs.Names {
+				if ident.Name == "_" {
+					continue
+				}
+				member := typeIdent(lastType) == typeName
+				if i >= len(lastValues) {
+					if member {
+						return nil, fmt.Errorf("enum.LoadGoConsts: %s: missing value for constant %q", fset.Position(ident.Pos()), ident.Name)
+					}
+					continue
+				}
+				expr := lastValues[i]
+				if lastType == nil {
+					if call, ok := expr.(*ast.CallExpr); ok && typeIdent(call.Fun) == typeName {
+						member = true
+					}
+				}
+				v, err := evalConst(expr, int64(index), known)
+				if err != nil {
+					if member {
+						return nil, fmt.Errorf("enum.LoadGoConsts: %s: constant %q: %w", fset.Position(err.pos), ident.Name, err)
+					}
+					continue // Unrelated constants may use expressions we do not evaluate.
+				}
+				known[ident.Name] = v
+				if !member {
+					continue
+				}
+				iv := constant.ToInt(v)
+				if iv.Kind() != constant.Int {
+					return nil, fmt.Errorf("enum.LoadGoConsts: %s: constant %q is not an integer", fset.Position(ident.Pos()), ident.Name)
+				}
+				n, exact := constant.Int64Val(iv)
+				if !exact {
+					return nil, fmt.Errorf("enum.LoadGoConsts: %s: constant %q overflows int64", fset.Position(ident.Pos()), ident.Name)
+				}
+				found[ident.Name] = n
+			}
+		}
+	}
+	if len(found) == 0 {
+		return nil, fmt.Errorf("enum.LoadGoConsts: no constants of type %q found", typeName)
+	}
+	return NewMapped(found, WithSortedEntries[int64]()), nil
+}
+
+// constError is an evaluation error carrying the position of the offending expression.
+type constError struct {
+	pos token.Pos
+	msg string
+}
+
+func (e *constError) Error() string { return e.msg }
+
+// typeIdent returns the name of a type expression such as Status or pkg.Status,
+// or "" for anything else.
+func typeIdent(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return ""
+}
+
+// evalConst evaluates a constant expression using go/constant.
+func evalConst(expr ast.Expr, iotaValue int64, known map[string]constant.Value) (constant.Value, *constError) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		v := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		if v.Kind() == constant.Unknown {
+			return nil, &constError{e.Pos(), fmt.Sprintf("invalid literal %s", e.Value)}
+		}
+		return v, nil
+	case *ast.Ident:
+		if e.Name == "iota" {
+			return constant.MakeInt64(iotaValue), nil
+		}
+		if v, ok := known[e.Name]; ok {
+			return v, nil
+		}
+		return nil, &constError{e.Pos(), fmt.Sprintf("undefined constant %q", e.Name)}
+	case *ast.ParenExpr:
+		return evalConst(e.X, iotaValue, known)
+	case *ast.CallExpr:
+		// A single-argument call in a constant expression is a conversion.
+		if len(e.Args) != 1 || typeIdent(e.Fun) == "" {
+			return nil, &constError{e.Pos(), "unsupported call in constant expression"}
+		}
+		return evalConst(e.Args[0], iotaValue, known)
+	case *ast.UnaryExpr:
+		x, err := evalConst(e.X, iotaValue, known)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Op {
+		case token.ADD, token.SUB:
+			if isNumericConst(x) {
+				return constant.UnaryOp(e.Op, x, 0), nil
+			}
+		case token.XOR:
+			if x.Kind() == constant.Int {
+				return constant.UnaryOp(e.Op, x, 0), nil
+			}
+		}
+		return nil, &constError{e.OpPos, fmt.Sprintf("unsupported operator %s", e.Op)}
+	case *ast.BinaryExpr:
+		x, err := evalConst(e.X, iotaValue, known)
+		if err != nil {
+			return nil, err
+		}
+		y, err := evalConst(e.Y, iotaValue, known)
+		if err != nil {
+			return nil, err
+		}
+		if !isNumericConst(x) || !isNumericConst(y) {
+			return nil, &constError{e.OpPos, fmt.Sprintf("unsupported operands for %s", e.Op)}
+		}
+		switch e.Op {
+		case token.SHL, token.SHR:
+			s, ok := constant.Uint64Val(constant.ToInt(y))
+			if !ok || constant.ToInt(x).Kind() != constant.Int {
+				return nil, &constError{e.Y.Pos(), "invalid shift count"}
+			}
+			return constant.Shift(constant.ToInt(x), e.Op, uint(s)), nil
+		case token.QUO, token.REM:
+			if constant.Sign(y) == 0 {
+				return nil, &constError{e.Y.Pos(), "division by zero"}
+			}
+			if x.Kind() == constant.Int && y.Kind() == constant.Int {
+				if e.Op == token.QUO {
+					return constant.BinaryOp(x, token.QUO_ASSIGN, y), nil // Integer division.
+				}
+				return constant.BinaryOp(x, e.Op, y), nil
+			}
+			if e.Op == token.QUO {
+				return constant.BinaryOp(x, e.Op, y), nil
+			}
+		case token.ADD, token.SUB, token.MUL:
+			return constant.BinaryOp(x, e.Op, y), nil
+		case token.AND, token.OR, token.XOR, token.AND_NOT:
+			if x.Kind() == constant.Int && y.Kind() == constant.Int {
+				return constant.BinaryOp(x, e.Op, y), nil
+			}
+		}
+		return nil, &constError{e.OpPos, fmt.Sprintf("unsupported operator %s", e.Op)}
+	}
+	return nil, &constError{expr.Pos(), "unsupported constant expression"}
+}
+
+// isNumericConst reports whether v is an integer or floating-point constant.
+// Rune literals evaluate to integer constants.
+func isNumericConst(v constant.Value) bool {
+	return v.Kind() == constant.Int || v.Kind() == constant.Float
+}
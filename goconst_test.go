@@ -0,0 +1,83 @@
+package enum
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadGoConsts(t *testing.T) {
+	t.Run("Iota block", func(t *testing.T) {
+		src := `package p
+
+import "strings"
+
+type Status int
+
+const (
+	Pending Status = iota
+	Active
+	_
+	Closed
+	Archived Status = 10 * (iota + 1)
+	Purged
+)
+
+const Unrelated = strings.ToUpper
+
+const Legacy = Status(Closed + 100)
+`
+		g, err := LoadGoConsts(strings.NewReader(src), "Status")
+		if err != nil {
+			t.Fatalf("LoadGoConsts failed: %v", err)
+		}
+		want := map[string]int64{"Pending": 0, "Active": 1, "Closed": 3, "Archived": 50, "Purged": 60, "Legacy": 103}
+		if g.Len() != len(want) {
+			t.Errorf("Expected %d constants, got %v", len(want), g.Names())
+		}
+		for name, value := range want {
+			if v, ok := g.Get(name); !ok || v != value {
+				t.Errorf("Expected %s=%d, got %d (found: %v)", name, value, v, ok)
+			}
+		}
+	})
+
+	t.Run("Flags and other types", func(t *testing.T) {
+		src := `package p
+const (
+	Read Perm = 1 << iota
+	Write
+	Exec
+)
+const (
+	Red Color = 'r'
+	Blue Color = 'b'
+)
+`
+		g, err := LoadGoConsts(strings.NewReader(src), "Perm")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, _ := g.Get("Exec"); v != 4 || g.Len() != 3 {
+			t.Errorf("Expected Exec=4 among 3 constants, got %d, %v", v, g.Names())
+		}
+	})
+
+	t.Run("Errors include positions", func(t *testing.T) {
+		tests := []struct {
+			src  string
+			want string
+		}{
+			{"package p\nconst (\n\tA Status = iota\n\tB Status = missing\n)\n", `4:13: constant "B": undefined constant "missing"`},
+			{"package p\nconst A Status = \"text\"\n", `2:7: constant "A" is not an integer`},
+			{"package p\nconst A Status = 1 << 70\n", `2:7: constant "A" overflows int64`},
+			{"package p\nconst A Other = 1\n", `no constants of type "Status"`},
+			{"package p\nconst (\n", `2:9`},
+		}
+		for _, tt := range tests {
+			_, err := LoadGoConsts(strings.NewReader(tt.src), "Status")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Handle is a cached reference to a named entry, for hot paths that resolve the same
+// few names over and over. While the Generator is not mutated, Get reads the cached
+// value without locking or map lookups; after any mutation (see Version), the next
+// call revalidates the name once. Handles are small, may be copied, and are safe for
+// concurrent use; copies share their cache.
+type Handle[T TypesValue] struct {
+	state *handleState[T]
+}
+
+// handleState is the cache shared by copies of a Handle.
+type handleState[T TypesValue] struct {
+	g    *Generator[T]
+	name string
+	snap atomic.Pointer[handleSnapshot[T]]
+}
+
+// handleSnapshot is the result of resolving a Handle's name at a given version.
+type handleSnapshot[T TypesValue] struct {
+	version uint64
+	value   T
+	err     error
+}
+
+// Bind returns a Handle for name. It fails with ErrNotFound if the name is not
+// registered; aliases are not accepted. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	ident, err := tokens.Bind("ident")
+//	// ...in the hot loop:
+//	v, err := ident.Get() // no lock while tokens is unchanged
+func (g *Generator[T]) Bind(name string) (Handle[T], error) {
+	h := Handle[T]{state: &handleState[T]{g: g, name: name}}
+	snap := h.state.resolve()
+	if snap.err != nil {
+		return Handle[T]{}, snap.err
+	}
+	return h, nil
+}
+
+// Get returns the value the Handle's name maps to. It fails with ErrNotFound once the
+// entry has been removed (or renamed), and succeeds again if the name is re-registered.
+func (h Handle[T]) Get() (T, error) {
+	if h.state == nil {
+		var zero T
+		return zero, ErrNilRegistry
+	}
+	snap := h.state.snap.Load()
+	if snap.version != h.state.g.version.Load() {
+		snap = h.state.resolve()
+	}
+	return snap.value, snap.err
+}
+
+// Value is like Get but returns the entry as a Value.
+func (h Handle[T]) Value() (Value[T], error) {
+	v, err := h.Get()
+	if err != nil {
+		return Value[T]{}, err
+	}
+	return h.state.g.entry(v, h.state.name), nil
+}
+
+// Name returns the name the Handle is bound to.
+func (h Handle[T]) Name() string {
+	if h.state == nil {
+		return ""
+	}
+	return h.state.name
+}
+
+// resolve looks the name up and caches the result with the version it was read at.
+func (s *handleState[T]) resolve() *handleSnapshot[T] {
+	s.g.rlock()
+	value, ok := s.g.nameMap[s.name]
+	snap := &handleSnapshot[T]{version: s.g.version.Load(), value: value}
+	s.g.runlock()
+	if !ok {
+		snap.err = fmt.Errorf("%w: %q", ErrNotFound, s.name)
+	}
+	s.snap.Store(snap)
+	return snap
+}
@@ -0,0 +1,107 @@
+package enum
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestGenerator_Bind(t *testing.T) {
+	g := NewGenerator[int](WithStart(1))
+	g.Next("ident")
+	g.Next("number")
+
+	t.Run("Get", func(t *testing.T) {
+		h, err := g.Bind("number")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, err := h.Get(); err != nil || v != 2 {
+			t.Errorf("Expected 2, got %d, err: %v", v, err)
+		}
+		if v, err := h.Value(); err != nil || v.String() != "number" || h.Name() != "number" {
+			t.Errorf("Expected number entry, got %v, err: %v", v, err)
+		}
+		if _, err := g.Bind("missing"); !errors.Is(err, ErrNotFound) || !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Revalidation", func(t *testing.T) {
+		h, _ := g.Bind("ident")
+		copied := h
+		g.Next("string")
+		if v, err := h.Get(); err != nil || v != 1 {
+			t.Errorf("Expected unrelated mutation to keep the handle valid, got %d, err: %v", v, err)
+		}
+		g.Remove("ident")
+		if _, err := copied.Get(); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound after removal, got %v", err)
+		}
+		g.Next("ident")
+		if v, err := h.Get(); err != nil || v != 4 {
+			t.Errorf("Expected re-registered name to resolve to 4, got %d, err: %v", v, err)
+		}
+	})
+
+	t.Run("Zero Handle", func(t *testing.T) {
+		var h Handle[int]
+		if _, err := h.Get(); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		h, _ := g.Bind("number")
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 1000; j++ {
+					if v, err := h.Get(); err != nil || v != 2 {
+						t.Errorf("Expected 2, got %d, err: %v", v, err)
+						return
+					}
+				}
+			}()
+		}
+		for i := 0; i < 20; i++ {
+			g.SetDescription(2, "bump")
+		}
+		wg.Wait()
+	})
+}
+
+func BenchmarkHandle(b *testing.B) {
+	g := NewGenerator[int]()
+	for _, name := range []string{"ident", "number", "string", "lparen", "rparen"} {
+		g.Next(name)
+	}
+	h, _ := g.Bind("ident")
+
+	b.Run("Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g.Get("ident")
+		}
+	})
+	b.Run("Handle", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h.Get()
+		}
+	})
+	b.Run("GetParallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				g.Get("ident")
+			}
+		})
+	})
+	b.Run("HandleParallel", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				h.Get()
+			}
+		})
+	})
+}
@@ -0,0 +1,252 @@
+package enum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Handler returns an http.Handler serving enum catalogs for API clients:
+//
+//   - GET /          returns the sorted JSON list of enum names.
+//   - GET /{name}    returns the entries of the named enum in definition order, as
+//     [{"value":1,"name":"Pending"}, ...], or the compact map form
+//     {"1":"Pending", ...} with ?form=compact.
+//
+// Unknown names yield 404 and methods other than GET and HEAD yield 405. Responses carry
+// an ETag derived from the enum's Fingerprint when available (or from the body
+// otherwise), and requests with a matching If-None-Match receive 304 Not Modified.
+//
+// Each value in registries must implement Registry (*Generator[T], *BasicRegistry, *Maker[T, E]);
+// Handler panics otherwise. If registries is nil, the package-level registry (see Register)
+// is served instead, including enums registered later. Mount it with http.StripPrefix:
+//
+//	mux.Handle("/meta/enums/", http.StripPrefix("/meta/enums", enum.Handler(map[string]any{
+//		"status": Statuses,
+//	})))
+func Handler(registries map[string]any) http.Handler {
+	h := &catalogHandler{}
+	if registries != nil {
+		h.registries = make(map[string]Registry, len(registries))
+		for name, e := range registries {
+			r, ok := e.(Registry)
+			if !ok || r == nil {
+				panic(fmt.Sprintf("enum.Handler: %q: %T does not implement Registry", name, e))
+			}
+			h.registries[name] = r
+		}
+	}
+	return h
+}
+
+// catalogHandler implements the handler returned by Handler.
+type catalogHandler struct {
+	registries map[string]Registry // nil means the package-level registry.
+}
+
+func (h *catalogHandler) lookup(name string) (Registry, bool) {
+	if h.registries == nil {
+		return Lookup(name)
+	}
+	r, ok := h.registries[name]
+	return r, ok
+}
+
+func (h *catalogHandler) names() []string {
+	if h.registries == nil {
+		return Registered()
+	}
+	names := make([]string, 0, len(h.registries))
+	for name := range h.registries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ServeHTTP implements http.Handler.
+func (h *catalogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.Trim(r.URL.Path, "/")
+	if name == "" {
+		body, err := json.Marshal(h.names())
+		h.write(w, r, body, "", err)
+		return
+	}
+	reg, ok := h.lookup(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown enum %q", name), http.StatusNotFound)
+		return
+	}
+
+	form := r.URL.Query().Get("form")
+	var body []byte
+	var err error
+	switch form {
+	case "", "verbose":
+		form = "verbose"
+		body, err = catalogJSON(reg)
+	case "compact":
+		body, err = compactJSON(reg)
+	default:
+		http.Error(w, fmt.Sprintf("unknown form %q (use verbose or compact)", form), http.StatusBadRequest)
+		return
+	}
+	etag := ""
+	if fp, ok := reg.(interface{ Fingerprint() string }); ok {
+		etag = fmt.Sprintf(`"%s-%s"`, fp.Fingerprint(), form)
+	}
+	h.write(w, r, body, etag, err)
+}
+
+// write sends body as JSON with an ETag, answering 304 if the client's copy is current.
+// If etag is empty, it is computed from the body.
+func (h *catalogHandler) write(w http.ResponseWriter, r *http.Request, body []byte, etag string, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if etag == "" {
+		sum := sha256.Sum256(body)
+		etag = `"` + hex.EncodeToString(sum[:]) + `"`
+	}
+	w.Header().Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+// etagMatches reports whether an If-None-Match header lists etag (or is "*").
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// catalogEntryJSON is an element of the verbose catalog form served by Handler.
+type catalogEntryJSON struct {
+	Value json.RawMessage `json:"value"`
+	Name  string          `json:"name"`
+}
+
+// orderedCatalog is implemented by registries that list their catalog entries, in
+// definition order, from a single read, so the body and the ETag derived from it
+// reflect one state of the registry even while it changes (e.g., Generator, Maker).
+type orderedCatalog interface {
+	catalogEntries() ([]catalogEntryJSON, error)
+}
+
+// catalogEntries returns the entries of reg for the catalog, from a single read if it
+// is an orderedCatalog and by resolving each of its names otherwise.
+func catalogEntries(reg Registry) ([]catalogEntryJSON, error) {
+	if oc, ok := reg.(orderedCatalog); ok {
+		return oc.catalogEntries()
+	}
+	names := reg.Names()
+	out := make([]catalogEntryJSON, 0, len(names))
+	for _, name := range names {
+		raw, err := entryValueJSON(reg, name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, catalogEntryJSON{Value: raw, Name: name})
+	}
+	return out, nil
+}
+
+// catalogJSON encodes reg as an ordered array of value/name pairs.
+func catalogJSON(reg Registry) ([]byte, error) {
+	entries, err := catalogEntries(reg)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(entries)
+}
+
+// compactJSON encodes reg as a JSON object from value to name, in definition order.
+// Values with several names are keyed once, under their first name.
+func compactJSON(reg Registry) ([]byte, error) {
+	entries, err := catalogEntries(reg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	seen := make(map[string]bool)
+	buf.WriteByte('{')
+	for _, entry := range entries {
+		key := string(entry.Value)
+		if key[0] != '"' {
+			key = `"` + key + `"` // JSON object keys are strings.
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		nameJSON, _ := json.Marshal(entry.Name)
+		buf.WriteString(key)
+		buf.WriteByte(':')
+		buf.Write(nameJSON)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// catalogEntries lists the Generator's entries for the Handler from one call to Values,
+// writing values as characters under WithRuneFormatting.
+func (g *Generator[T]) catalogEntries() ([]catalogEntryJSON, error) {
+	values := g.Values()
+	out := make([]catalogEntryJSON, len(values))
+	for i, entry := range values {
+		var raw []byte
+		var err error
+		if s, ok := g.formatAny(entry); ok {
+			raw, err = json.Marshal(s)
+		} else {
+			raw, err = json.Marshal(entry)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[i] = catalogEntryJSON{Value: raw, Name: entry.name}
+	}
+	return out, nil
+}
+
+// catalogEntries is like Generator.catalogEntries.
+func (r *BasicRegistry) catalogEntries() ([]catalogEntryJSON, error) {
+	return r.meta.catalogEntries()
+}
+
+// entryValueJSON returns the JSON encoding of the value registered under name, for
+// registries that are not an orderedCatalog. Entries returned by ParseAny marshal to
+// their underlying value.
+func entryValueJSON(reg Registry, name string) (json.RawMessage, error) {
+	entry, err := reg.ParseAny(name)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(entry)
+}
@@ -0,0 +1,108 @@
+package enum
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+	b := NewBasicRegistry()
+	b.Add("Small")
+	b.Add("Large")
+	s := NewMapped(map[string]string{"Red": "r"})
+
+	h := Handler(map[string]any{"status": g, "size": b, "hex": s})
+	get := func(path string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if len(header) == 2 {
+			req.Header.Set(header[0], header[1])
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/", http.StatusOK, `["hex","size","status"]`},
+		{"/status", http.StatusOK, `[{"value":1,"name":"Pending"},{"value":2,"name":"Active"}]`},
+		{"/status?form=compact", http.StatusOK, `{"1":"Pending","2":"Active"}`},
+		{"/size", http.StatusOK, `[{"value":0,"name":"Small"},{"value":1,"name":"Large"}]`},
+		{"/hex?form=compact", http.StatusOK, `{"r":"Red"}`},
+		{"/missing", http.StatusNotFound, ""},
+		{"/status?form=xml", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		rec := get(tt.path)
+		if rec.Code != tt.code {
+			t.Errorf("GET %s: expected %d, got %d", tt.path, tt.code, rec.Code)
+			continue
+		}
+		if tt.body != "" && rec.Body.String() != tt.body {
+			t.Errorf("GET %s: unexpected body\n got %s\nwant %s", tt.path, rec.Body, tt.body)
+		}
+	}
+
+	t.Run("ETag", func(t *testing.T) {
+		rec := get("/status")
+		etag := rec.Header().Get("ETag")
+		if want := `"` + g.Fingerprint() + `-verbose"`; etag != want {
+			t.Fatalf("Expected the ETag %s from the Fingerprint, got %q", want, etag)
+		}
+		if rec := get("/status", "If-None-Match", etag); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+			t.Errorf("Expected 304 with empty body, got %d", rec.Code)
+		}
+		if compact := get("/status?form=compact").Header().Get("ETag"); compact == etag {
+			t.Error("Expected forms to have different ETags")
+		}
+		if get("/size").Header().Get("ETag") == "" {
+			t.Error("Expected a body-derived ETag for registries without Fingerprint")
+		}
+	})
+
+	t.Run("Single read", func(t *testing.T) {
+		counted := NewMapped(map[string]int{"A": 1, "B": 2}, WithSortedEntries[int](), WithStats[int]())
+		h := Handler(map[string]any{"c": counted})
+		for _, path := range []string{"/c", "/c?form=compact"} {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		}
+		for _, v := range counted.Stats().Values {
+			if v.Parses != 0 {
+				t.Errorf("Expected serving the catalog not to count as parsing %s, got %d", v.Name, v.Parses)
+			}
+		}
+	})
+
+	t.Run("Method", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/status", nil))
+		if rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Expected 405, got %d", rec.Code)
+		}
+	})
+
+	t.Run("Invalid registry", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for non-Registry value")
+			}
+		}()
+		Handler(map[string]any{"bad": 42})
+	})
+
+	t.Run("Package registry", func(t *testing.T) {
+		resetRegistry(t)
+		defer resetRegistry(t)
+		Register("status", g)
+		rec := httptest.NewRecorder()
+		Handler(nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		if rec.Body.String() != `["status"]` {
+			t.Errorf("Unexpected body %s", rec.Body)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ChangeKind identifies the type of mutation recorded in a Generator's history.
+type ChangeKind int
+
+const (
+	ChangeAdd    ChangeKind = iota // An entry was added via Next, NextAs, or PopulateFrom.
+	ChangeRemove                   // An entry was removed via Remove or RemoveAs.
+	ChangeRename                   // An entry was renamed via Rename or RenameAs.
+)
+
+// String returns the lowercase name of the change kind (e.g., "add").
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdd:
+		return "add"
+	case ChangeRemove:
+		return "remove"
+	case ChangeRename:
+		return "rename"
+	}
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// MarshalText implements encoding.TextMarshaler, so change kinds appear by name in JSON.
+func (k ChangeKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// ChangeRecord describes a single mutation of a Generator.
+type ChangeRecord[T TypesValue] struct {
+	Kind    ChangeKind `json:"kind"`               // Type of mutation.
+	Name    string     `json:"name"`               // Name of the entry after the change.
+	OldName string     `json:"old_name,omitempty"` // Previous name, set for renames.
+	Value   T          `json:"value"`              // Value of the affected entry.
+	Actor   string     `json:"actor,omitempty"`    // Who made the change, if known.
+	Time    time.Time  `json:"time"`               // When the change was made.
+}
+
+// history is a fixed-size ring buffer of change records.
+type history[T TypesValue] struct {
+	records []ChangeRecord[T]
+	next    int  // Index of the slot to write next.
+	full    bool // Whether the buffer has wrapped around.
+}
+
+// WithHistory enables recording of mutations (Next, Remove, Rename and their
+// actor-attributed variants) into a ring buffer holding the most recent limit
+// records. History is disabled by default; a limit <= 0 leaves it disabled.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithHistory[int](100))
+//	g.NextAs("Archived", "alice")
+//	for _, r := range g.History() {
+//	    fmt.Println(r.Kind, r.Name, r.Actor) // Output: add Archived alice
+//	}
+func WithHistory[T TypesValue](limit int) Option[T] {
+	return func(g *Generator[T]) {
+		if limit <= 0 {
+			g.history = nil
+			return
+		}
+		g.history = &history[T]{records: make([]ChangeRecord[T], limit)}
+	}
+}
+
+// NextAs is like Next but attributes the change to actor in the history.
+func (g *Generator[T]) NextAs(name, actor string) Value[T] {
+	return g.next(name, actor)
+}
+
+// RemoveAs is like Remove but attributes the change to actor in the history.
+func (g *Generator[T]) RemoveAs(name, actor string) error {
+	return g.remove(name, actor)
+}
+
+// RenameAs is like Rename but attributes the change to actor in the history.
+func (g *Generator[T]) RenameAs(oldName, newName, actor string) error {
+	return g.rename(oldName, newName, actor)
+}
+
+// History returns the recorded changes, oldest first. It returns nil if history
+// is not enabled. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) History() []ChangeRecord[T] {
+	g.rlock()
+	defer g.runlock()
+	h := g.history
+	if h == nil {
+		return nil
+	}
+	if !h.full {
+		out := make([]ChangeRecord[T], h.next)
+		copy(out, h.records[:h.next])
+		return out
+	}
+	out := make([]ChangeRecord[T], 0, len(h.records))
+	out = append(out, h.records[h.next:]...)
+	return append(out, h.records[:h.next]...)
+}
+
+// HistoryJSON serializes the recorded changes, oldest first, as a JSON array.
+func (g *Generator[T]) HistoryJSON() ([]byte, error) {
+	records := g.History()
+	if records == nil {
+		records = []ChangeRecord[T]{}
+	}
+	return json.Marshal(records)
+}
+
+// record appends a change to the history and the journal, if enabled.
+// The caller must hold the write lock.
+func (g *Generator[T]) record(kind ChangeKind, name, oldName string, value T, actor string) {
+	if g.history == nil && g.journal == nil {
+		return
+	}
+	rec := ChangeRecord[T]{
+		Kind:    kind,
+		Name:    name,
+		OldName: oldName,
+		Value:   value,
+		Actor:   actor,
+		Time:    time.Now(),
+	}
+	if g.journal != nil {
+		line := journalLine{Op: kind.String(), Name: name, OldName: oldName, Actor: actor, TS: rec.Time}
+		if kind == ChangeAdd && g.incrementer != nil {
+			line.Next, _ = marshalValue(g.current, g.marshal)
+		}
+		g.journalLocked(line, value)
+	}
+	h := g.history
+	if h == nil {
+		return
+	}
+	h.records[h.next] = rec
+	h.next++
+	if h.next == len(h.records) {
+		h.next = 0
+		h.full = true
+	}
+}
@@ -0,0 +1,110 @@
+package enum
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_History(t *testing.T) {
+	t.Run("Disabled by default", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		if g.History() != nil {
+			t.Error("Expected nil history when disabled")
+		}
+		if b, err := g.HistoryJSON(); err != nil || string(b) != "[]" {
+			t.Errorf("Expected empty JSON array, got %s, err: %v", b, err)
+		}
+	})
+
+	t.Run("Records mutations", func(t *testing.T) {
+		g := NewGenerator[int](WithHistory[int](10))
+		g.NextAs("Draft", "alice")
+		g.Next("Closed")
+		if err := g.RenameAs("Closed", "Completed", "bob"); err != nil {
+			t.Fatalf("RenameAs failed: %v", err)
+		}
+		if err := g.RemoveAs("Draft", "carol"); err != nil {
+			t.Fatalf("RemoveAs failed: %v", err)
+		}
+
+		h := g.History()
+		if len(h) != 4 {
+			t.Fatalf("Expected 4 records, got %d", len(h))
+		}
+		if h[0].Kind != ChangeAdd || h[0].Name != "Draft" || h[0].Actor != "alice" || h[0].Time.IsZero() {
+			t.Errorf("Unexpected first record %+v", h[0])
+		}
+		if h[2].Kind != ChangeRename || h[2].OldName != "Closed" || h[2].Name != "Completed" || h[2].Value != 1 {
+			t.Errorf("Unexpected rename record %+v", h[2])
+		}
+		if h[3].Kind != ChangeRemove || h[3].Actor != "carol" {
+			t.Errorf("Unexpected remove record %+v", h[3])
+		}
+	})
+
+	t.Run("Ring buffer keeps latest", func(t *testing.T) {
+		g := NewGenerator[int](WithHistory[int](2))
+		g.Next("A")
+		g.Next("B")
+		g.Next("C")
+		h := g.History()
+		if len(h) != 2 || h[0].Name != "B" || h[1].Name != "C" {
+			t.Errorf("Expected [B C], got %+v", h)
+		}
+	})
+
+	t.Run("JSON export", func(t *testing.T) {
+		g := NewGenerator[string](WithStart("a"), WithHistory[string](5))
+		g.NextAs("First", "alice")
+		b, err := g.HistoryJSON()
+		if err != nil {
+			t.Fatalf("HistoryJSON failed: %v", err)
+		}
+		var out []map[string]any
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatalf("Invalid JSON %s: %v", b, err)
+		}
+		if len(out) != 1 || out[0]["kind"] != "add" || out[0]["value"] != "a" || out[0]["actor"] != "alice" {
+			t.Errorf("Unexpected JSON %s", b)
+		}
+		if strings.Contains(string(b), "old_name") {
+			t.Errorf("Expected old_name to be omitted, got %s", b)
+		}
+	})
+}
+
+func TestGenerator_RemoveRename(t *testing.T) {
+	g := NewMapped(map[string]int{"A": 1, "Alias": 1, "B": 2}, WithSortedEntries[int]())
+
+	if err := g.Remove("Missing"); err == nil {
+		t.Error("Expected error removing unknown name")
+	}
+	if err := g.Rename("A", "B"); err == nil {
+		t.Error("Expected error renaming onto an existing name")
+	}
+
+	if err := g.Remove("Alias"); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if name, ok := g.Name(1); !ok || name != "A" {
+		t.Errorf("Expected 1 to resolve to A after removing alias, got %q", name)
+	}
+	if g.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", g.Len())
+	}
+
+	if err := g.Rename("B", "Bee"); err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+	if name, _ := g.Name(2); name != "Bee" {
+		t.Errorf("Expected Bee for 2, got %q", name)
+	}
+	if _, ok := g.Get("B"); ok {
+		t.Error("Expected old name to be gone")
+	}
+	if names := g.Names(); names[1] != "Bee" {
+		t.Errorf("Expected rename to keep position, got %v", names)
+	}
+}
@@ -0,0 +1,109 @@
+package enum
+
+import (
+	"context"
+	"fmt"
+)
+
+// validatorFunc is a check added by WithValidator.
+type validatorFunc[T TypesValue] func(ctx context.Context, name string, value T) error
+
+// WithValidator adds a check run before committing any new name, given the name and
+// the value it would receive: entries added by Next and its variants, GetOrAdd,
+// BasicRegistry.Add and FromValue, Basic.With, Typed.AddValue, NewMapped, PopulateFrom,
+// UnmarshalJSON, and Reload, as well as new names given by Rename and AddAlias.
+// A non-nil error rejects the change and is returned (or, for methods that do not
+// return errors, panicked or recorded under WithErrorMode). Several validators run in
+// the order they were added. Removing entries and attaching data to existing names
+// (descriptions, keys, display names) are not validated.
+//
+// Validators may be slow (e.g., consult a reserved-words service): they run without
+// the Generator's lock held and receive the context passed to NextCtx, or
+// context.Background() from the other methods. If the Generator changes while they run,
+// they run again for the new next value before anything is committed. A validator must
+// not add entries to the Generator it validates.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithValidator(func(ctx context.Context, name string, _ int) error {
+//	    return reserved.Check(ctx, name)
+//	}))
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	v, err := g.NextCtx(ctx, "Pending")
+func WithValidator[T TypesValue](fn func(ctx context.Context, name string, value T) error) Option[T] {
+	return func(g *Generator[T]) {
+		g.validators = append(g.validators, fn)
+	}
+}
+
+// NextCtx is like TryNext but passes ctx to the validators added with WithValidator.
+// If ctx is done before the entry is committed, nothing is added and the error wraps
+// ctx.Err() (context.Canceled or context.DeadlineExceeded). Without validators, ctx is
+// only checked before taking the lock.
+// It is thread-safe, using a write lock to protect state modifications.
+func (g *Generator[T]) NextCtx(ctx context.Context, name string) (Value[T], error) {
+	if err := ctx.Err(); err != nil {
+		return Value[T]{}, fmt.Errorf("enum: cannot add %q: %w", name, err)
+	}
+	return g.tryNext(ctx, name, "")
+}
+
+// nextValidated implements tryNext for a Generator with validators. It reads the next
+// value, runs the validators without holding the lock, and commits only if the
+// Generator did not change meanwhile, retrying otherwise.
+func (g *Generator[T]) nextValidated(ctx context.Context, name, actor string) (Value[T], error) {
+	err := g.lockValidated(ctx, func() []Pair[T] {
+		return []Pair[T]{{Name: name, Value: g.current}}
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && err == ctxErr {
+			return Value[T]{}, fmt.Errorf("enum: cannot add %q: %w", name, err)
+		}
+		return Value[T]{}, err
+	}
+	defer g.unlock()
+	return g.nextLocked(name, actor)
+}
+
+// validate runs the validators on a name and the value it would receive, wrapping the
+// first error.
+func (g *Generator[T]) validate(ctx context.Context, name string, value T) error {
+	for _, validate := range g.validators {
+		if err := validate(ctx, name, value); err != nil {
+			return fmt.Errorf("enum: %q rejected: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// lockValidated takes the write lock once the validators accept the names that plan
+// returns, with the values they would receive. plan runs under a read lock; the
+// validators run without it, and if the Generator changes meanwhile, plan and the
+// validators run again. Without validators, it only takes the lock. On error, which is
+// the first validator error or ctx.Err(), the lock is not held.
+func (g *Generator[T]) lockValidated(ctx context.Context, plan func() []Pair[T]) error {
+	if len(g.validators) == 0 {
+		g.lock()
+		return nil
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		g.rlock()
+		pairs, version := plan(), g.version.Load()
+		g.runlock()
+		for _, p := range pairs {
+			if err := g.validate(ctx, p.Name, p.Value); err != nil {
+				return err
+			}
+		}
+
+		g.lock()
+		if g.version.Load() == version && ctx.Err() == nil {
+			return nil
+		}
+		g.unlock()
+	}
+}
@@ -0,0 +1,155 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithValidator(t *testing.T) {
+	errReserved := errors.New("reserved word")
+	reserved := func(_ context.Context, name string, _ int) error {
+		if name == "default" {
+			return errReserved
+		}
+		return nil
+	}
+
+	t.Run("Rejects", func(t *testing.T) {
+		g := NewGenerator[int](WithValidator(reserved))
+		if _, err := g.TryNext("default"); !errors.Is(err, errReserved) {
+			t.Errorf("Expected validator error, got %v", err)
+		}
+		if v := g.Next("Pending"); v.Get() != 0 {
+			t.Errorf("Expected rejected entry not to consume a value, got %d", v.Get())
+		}
+		if g.Len() != 1 {
+			t.Errorf("Expected 1 entry, got %d", g.Len())
+		}
+	})
+
+	t.Run("Receives the next value", func(t *testing.T) {
+		var seen []int
+		g := NewGenerator[int](WithStart(5), WithValidator(func(_ context.Context, _ string, v int) error {
+			seen = append(seen, v)
+			return nil
+		}))
+		g.Next("A")
+		g.Next("B")
+		if len(seen) != 2 || seen[0] != 5 || seen[1] != 6 {
+			t.Errorf("Expected [5 6], got %v", seen)
+		}
+	})
+
+	t.Run("Basic and error mode", func(t *testing.T) {
+		b := NewBasicRegistry(WithValidator(reserved), WithErrorMode[int]())
+		b.Add("default")
+		if !errors.Is(b.Err(), errReserved) {
+			t.Errorf("Expected recorded validator error, got %v", b.Err())
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		slow := func(ctx context.Context, _ string, _ int) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+				return nil
+			}
+		}
+		g := NewGenerator[int](WithValidator(slow))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if _, err := g.NextCtx(ctx, "Pending"); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected DeadlineExceeded, got %v", err)
+		}
+		if g.Len() != 0 {
+			t.Error("Expected nothing to be committed")
+		}
+	})
+
+	t.Run("Canceled before start", func(t *testing.T) {
+		g := NewGenerator[int]()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := g.NextCtx(ctx, "Pending"); !errors.Is(err, context.Canceled) || g.Len() != 0 {
+			t.Errorf("Expected Canceled and no entry, got %v", err)
+		}
+		if v, err := g.NextCtx(context.Background(), "Pending"); err != nil || v.Get() != 0 {
+			t.Errorf("Expected NextCtx to add without validators, got %v, err: %v", v, err)
+		}
+	})
+
+	t.Run("Retries after concurrent change", func(t *testing.T) {
+		var g *Generator[int]
+		var calls atomic.Int32
+		g = NewGenerator[int](WithValidator(func(_ context.Context, name string, v int) error {
+			if name == "Slow" && calls.Add(1) == 1 {
+				go g.Next("Fast") // Runs while the validator holds no lock.
+				for g.Len() == 0 {
+					time.Sleep(time.Millisecond)
+				}
+			}
+			return nil
+		}))
+		v, err := g.NextCtx(context.Background(), "Slow")
+		if err != nil || v.Get() != 1 || calls.Load() != 2 {
+			t.Errorf("Expected Slow=1 after a retry, got %v, calls: %d, err: %v", v, calls.Load(), err)
+		}
+	})
+
+	t.Run("Every path that adds a name", func(t *testing.T) {
+		check := func(ctx context.Context, name string, v int) error {
+			if v == 7 {
+				return errReserved
+			}
+			return reserved(ctx, name, v)
+		}
+		paths := map[string]func(g *Generator[int]) error{
+			"GetOrAdd": func(g *Generator[int]) error {
+				_, err := g.GetOrAdd("default")
+				return err
+			},
+			"FromValue": func(g *Generator[int]) error {
+				_, err := (&BasicRegistry{meta: g}).TryFromValue(NewValue(7, "default"))
+				return err
+			},
+			"AddValue": func(g *Generator[int]) error {
+				_, err := TypedOf(g).TryAddValue("default", 7)
+				return err
+			},
+			"AddAlias": func(g *Generator[int]) error { return g.TryAddAlias("Pending", "default") },
+			"Rename":   func(g *Generator[int]) error { return g.Rename("Pending", "default") },
+			"Reload": func(g *Generator[int]) error {
+				_, err := g.Reload([]byte(`{"7": "default"}`))
+				return err
+			},
+			"With": func(g *Generator[int]) error {
+				b, err := (&BasicRegistry{meta: g}).Parse("Pending")
+				if err != nil {
+					return err
+				}
+				_, err = b.TryWith(7)
+				return err
+			},
+		}
+		for name, add := range paths {
+			g := NewGenerator[int](WithValidator(check))
+			g.Next("Pending")
+			if err := add(g); !errors.Is(err, errReserved) {
+				t.Errorf("%s: expected validator error, got %v", name, err)
+			}
+			if _, ok := g.Get("default"); ok || g.Len() != 1 || !g.Contains(0) {
+				t.Errorf("%s: expected nothing committed, got %v", name, g.Names())
+			}
+		}
+
+		g := NewMapped(map[string]int{"default": 0, "Pending": 1}, WithValidator(reserved), WithErrorMode[int]())
+		if !errors.Is(g.Err(), errReserved) || g.ContainsName("default") {
+			t.Errorf("NewMapped: expected default to be skipped and recorded, got %v (%v)", g.Names(), g.Err())
+		}
+	})
+}
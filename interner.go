@@ -0,0 +1,60 @@
+package enum
+
+// Interner maps strings to stable integer IDs, assigned sequentially from 0 in order
+// of first use. It is a facade over Generator[int] tuned for the get-or-add workload:
+// lookups of known strings take only a read lock, and the write lock is taken only
+// when a new string is added. It is safe for concurrent use.
+//
+// Example:
+//
+//	in := NewInterner()
+//	a := in.Intern("GET")  // 0
+//	b := in.Intern("POST") // 1
+//	c := in.Intern("GET")  // 0
+//	s, _ := in.Lookup(1)   // "POST"
+type Interner struct {
+	g *Generator[int]
+}
+
+// NewInterner creates an empty Interner. Options configure the underlying Generator
+// (e.g., WithStart to begin IDs elsewhere, or WithCapacityHint).
+func NewInterner(opts ...Option[int]) *Interner {
+	return &Interner{g: NewGenerator[int](opts...)}
+}
+
+// Intern returns the ID of s, assigning the next ID if s has not been seen before.
+// It is Generator.GetOrAdd, so interned strings are evictable under WithEviction.
+//
+// Panics if the underlying Generator cannot produce another ID (e.g., under OverflowError).
+// Use TryIntern to receive this condition as an error.
+func (in *Interner) Intern(s string) int {
+	id, err := in.TryIntern(s)
+	if err != nil {
+		in.g.raise(err)
+	}
+	return id
+}
+
+// TryIntern is like Intern but returns an error instead of panicking.
+func (in *Interner) TryIntern(s string) (int, error) {
+	v, err := in.g.GetOrAdd(s)
+	if err != nil {
+		return 0, err
+	}
+	return v.value, nil
+}
+
+// Lookup returns the string interned under id.
+func (in *Interner) Lookup(id int) (string, bool) {
+	return in.g.Name(id)
+}
+
+// Len returns the number of interned strings.
+func (in *Interner) Len() int {
+	return in.g.Len()
+}
+
+// Generator returns the underlying Generator, e.g. to serialize the interned set.
+func (in *Interner) Generator() *Generator[int] {
+	return in.g
+}
@@ -0,0 +1,90 @@
+package enum
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestInterner(t *testing.T) {
+	in := NewInterner()
+	if a, b, c := in.Intern("GET"), in.Intern("POST"), in.Intern("GET"); a != 0 || b != 1 || c != 0 {
+		t.Errorf("Expected 0, 1, 0, got %d, %d, %d", a, b, c)
+	}
+	if s, ok := in.Lookup(1); !ok || s != "POST" {
+		t.Errorf("Expected POST, got %q", s)
+	}
+	if _, ok := in.Lookup(5); ok {
+		t.Error("Expected unknown ID to fail")
+	}
+
+	t.Run("Concurrent", func(t *testing.T) {
+		in := NewInterner()
+		var wg sync.WaitGroup
+		ids := make([][]int, 8)
+		for w := range ids {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					ids[w] = append(ids[w], in.Intern(fmt.Sprintf("s%d", i)))
+				}
+			}(w)
+		}
+		wg.Wait()
+		if in.Len() != 100 {
+			t.Errorf("Expected 100 strings, got %d", in.Len())
+		}
+		for w := 1; w < len(ids); w++ {
+			for i := range ids[w] {
+				if ids[w][i] != ids[0][i] {
+					t.Fatalf("Expected stable IDs across goroutines, got %d and %d for s%d", ids[0][i], ids[w][i], i)
+				}
+			}
+		}
+		if err := in.Generator().CheckConsistency(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// mapInterner is the hand-rolled mutex+map baseline the Interner is compared against.
+type mapInterner struct {
+	mu    sync.Mutex
+	ids   map[string]int
+	names []string
+}
+
+func (m *mapInterner) Intern(s string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id, ok := m.ids[s]; ok {
+		return id
+	}
+	m.ids[s] = len(m.names)
+	m.names = append(m.names, s)
+	return len(m.names) - 1
+}
+
+func BenchmarkInterner(b *testing.B) {
+	keys := make([]string, 256)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("label-%d", i)
+	}
+	b.Run("Interner", func(b *testing.B) {
+		in := NewInterner()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				in.Intern(keys[i%len(keys)])
+			}
+		})
+	})
+	b.Run("MutexMap", func(b *testing.B) {
+		m := &mapInterner{ids: make(map[string]int)}
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				m.Intern(keys[i%len(keys)])
+			}
+		})
+	})
+}
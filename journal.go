@@ -0,0 +1,365 @@
+package enum
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// journal is the destination of a Generator's journal lines, set by Journal. Lines are
+// queued in commit order while the Generator's write lock is held and written once it
+// is released. Lock order: Generator.mu, then journal.mu.
+type journal struct {
+	w       io.Writer
+	mu      sync.Mutex    // Guards the fields below and serializes writes to w.
+	pending []pendingLine // Committed lines not yet written, oldest first.
+	err     error         // First write error; journaling stops once set.
+}
+
+// pendingLine is an encoded journal line waiting to be written, with the op and name
+// that identify it in errors.
+type pendingLine struct {
+	data     []byte
+	op, name string
+}
+
+// journalLine is one line of a journal: a JSON object such as
+// {"op":"add","name":"Archived","value":4,"next":5,"ts":"2024-05-01T12:00:00Z"}.
+type journalLine struct {
+	Op       string          `json:"op"`                  // One of the ops listed in Journal.
+	Name     string          `json:"name,omitempty"`      // Name of the entry after the change.
+	OldName  string          `json:"old_name,omitempty"`  // Previous name, set for renames.
+	Value    json.RawMessage `json:"value,omitempty"`     // Value of the affected entry.
+	OldValue json.RawMessage `json:"old_value,omitempty"` // Previous value, set for revalues.
+	Next     json.RawMessage `json:"next,omitempty"`      // Next value in the sequence after an add.
+	Aliases  []string        `json:"aliases,omitempty"`   // Aliases added to Name.
+	Text     string          `json:"text,omitempty"`      // Description or display name.
+	Locale   string          `json:"locale,omitempty"`    // Locale of a display name.
+	Keyspace string          `json:"keyspace,omitempty"`  // Keyspace of a secondary key.
+	Key      string          `json:"key,omitempty"`       // Secondary key.
+	Since    string          `json:"since,omitempty"`     // Start of a version range.
+	Until    string          `json:"until,omitempty"`     // End of a version range.
+	By       string          `json:"by,omitempty"`        // Sort order: "value" or "name".
+	Actor    string          `json:"actor,omitempty"`     // Who made the change, if known.
+	TS       time.Time       `json:"ts"`                  // When the change was made.
+}
+
+// Journal makes the Generator append one JSON line to w for each mutation it commits
+// from then on, for incremental persistence without rewriting a snapshot. The op of
+// each line names the mutation:
+//
+//   - "add": Next and its variants, GetOrAdd, PopulateFrom, Typed.AddValue, and
+//     BasicRegistry.FromValue, with the next value in the sequence after the add
+//   - "remove": Remove, RemoveAs, and eviction
+//   - "rename": Rename and RenameAs
+//   - "alias": AddAlias and TryAddAlias
+//   - "describe": SetDescription
+//   - "deprecate": Deprecate
+//   - "display": SetDisplayName and LoadDisplayNames, one line per name
+//   - "key": AddKey
+//   - "range": SetVersionRange
+//   - "sort": SortByValue and SortByName
+//   - "revalue": Basic.With and Basic.TryWith
+//
+// Lines look like {"op":"rename","name":"Closed","old_name":"Done","value":3,"ts":"..."},
+// with values encoded as MarshalJSON encodes them. Existing entries are not written, and
+// bulk replacements (UnmarshalJSON, Reload, UnmarshalCatalogText, Resync) are not
+// journaled: take a snapshot after them and start a new journal. A nil w stops
+// journaling.
+//
+// Lines are queued in commit order under the write lock and written after it is
+// released, each with a single Write call, so a slow w does not block readers or
+// writers of the Generator; open files with os.O_APPEND. A mutating method returns
+// only after its line has been written. The mutation is already committed when its
+// line is written: if the write fails, the Generator keeps the change, stops journaling
+// (a journal missing a line cannot be replayed reliably), logs the error if WithLogger
+// is set, and reports it in JournalErr.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Example:
+//
+//	f, _ := os.OpenFile("statuses.journal", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+//	g, _ := ReplayJournal[int](bytes.NewReader(previous))
+//	g.Journal(f)
+//	g.Next("Archived") // Appends {"op":"add","name":"Archived","value":4,"next":5,"ts":"..."}
+func (g *Generator[T]) Journal(w io.Writer) {
+	g.lock()
+	defer g.unlock()
+	if w == nil {
+		g.journal = nil
+		return
+	}
+	g.journal = &journal{w: w}
+}
+
+// JournalErr returns the error that stopped journaling, or nil if every line was
+// written (or Journal was never called). It is thread-safe, using a read lock for access.
+func (g *Generator[T]) JournalErr() error {
+	g.rlock()
+	j := g.journal
+	g.runlock()
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// journalLocked queues line for the journal, if any, with value encoded as its value.
+// The caller must hold the write lock.
+func (g *Generator[T]) journalLocked(line journalLine, value T) {
+	if g.journal == nil {
+		return
+	}
+	data, err := marshalValue(value, g.marshal)
+	if err != nil {
+		j := g.journal
+		j.mu.Lock()
+		g.stopJournal(j, line.Op, line.Name, err)
+		j.mu.Unlock()
+		return
+	}
+	line.Value = data
+	g.queueJournalLocked(line)
+}
+
+// queueJournalLocked queues line for the journal, if any. The caller must hold the
+// write lock.
+func (g *Generator[T]) queueJournalLocked(line journalLine) {
+	j := g.journal
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.err != nil {
+		return
+	}
+	if line.TS.IsZero() {
+		line.TS = time.Now()
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		g.stopJournal(j, line.Op, line.Name, err)
+		return
+	}
+	j.pending = append(j.pending, pendingLine{data: append(data, '\n'), op: line.Op, name: line.Name})
+}
+
+// flush writes the queued lines in order. It must be called without the Generator's
+// lock. When several goroutines flush at once, whichever takes the journal's mutex
+// first writes every queued line, and the others wait until it is done, so each
+// returns only after the lines queued before its call are written.
+func (g *Generator[T]) flush(j *journal) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for len(j.pending) > 0 && j.err == nil {
+		line := j.pending[0]
+		j.pending = j.pending[1:]
+		if _, err := j.w.Write(line.data); err != nil {
+			g.stopJournal(j, line.op, line.name, err)
+		}
+	}
+	j.pending = nil
+}
+
+// stopJournal records err as the error that stopped j, logging it if a logger is set.
+// The caller must hold j.mu.
+func (g *Generator[T]) stopJournal(j *journal, op, name string, err error) {
+	if j.err == nil {
+		j.err = fmt.Errorf("enum: journal stopped at %s of %q: %w", op, name, err)
+	}
+	if g.logger != nil {
+		g.log(slog.LevelError, "enum journal write failed", slog.String(LogKeyName, name), slog.String(LogKeyError, err.Error()))
+	}
+}
+
+// ReplayJournal creates a Generator with the given options and applies the journal read
+// from r to it (see ApplyJournal), reconstructing the entries in journal order. The
+// sequence resumes where the journaled Generator left it, so Next continues as it
+// would have if the same options are used.
+//
+// Example:
+//
+//	f, _ := os.Open("statuses.journal")
+//	g, err := ReplayJournal[int](f)
+func ReplayJournal[T TypesValue](r io.Reader, opts ...Option[T]) (*Generator[T], error) {
+	g := NewGenerator[T](opts...)
+	if err := g.ApplyJournal(r); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ApplyJournal replays the journal lines read from r onto the Generator, e.g. one
+// restored from a snapshot taken when the journal was started. Adds fail if the name is
+// taken, or the value under WithBijective; like Next, they may share a value otherwise,
+// as cyclic and saturating sequences do. Every other op fails unless its entry exists
+// with the journaled value, so a journal that does not belong to the Generator's state
+// is rejected. Adds run the validators added with WithValidator, and ops that attach
+// data apply the same checks as the methods that journaled them.
+//
+// A final line without a trailing newline that is not valid JSON is the mark of a write
+// cut short by a crash, and is ignored; any other invalid line is an error. Replayed
+// changes are journaled like any other, so call Journal afterwards. Since a torn line
+// would run into the next one, do not append to a journal that ends in one: write a
+// snapshot and start a new journal instead.
+//
+// Lines up to a failing one stay applied; the error names the line number.
+func (g *Generator[T]) ApplyJournal(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		data, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("enum: journal line %d: %w", n, err)
+		}
+		partial := errors.Is(err, io.EOF)
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+			var line journalLine
+			if jerr := json.Unmarshal(trimmed, &line); jerr != nil {
+				if partial {
+					return nil // A torn final write.
+				}
+				return fmt.Errorf("enum: journal line %d: %w", n, jerr)
+			}
+			if aerr := g.applyJournalLine(line); aerr != nil {
+				return fmt.Errorf("enum: journal line %d: %w", n, aerr)
+			}
+		}
+		if partial {
+			return nil
+		}
+	}
+}
+
+// applyJournalLine applies one decoded journal line.
+func (g *Generator[T]) applyJournalLine(line journalLine) error {
+	switch line.Op {
+	case "sort":
+		switch line.By {
+		case "value":
+			g.SortByValue()
+		case "name":
+			g.SortByName()
+		default:
+			return fmt.Errorf("enum: unknown sort order %q", line.By)
+		}
+		return nil
+	case "add", "remove", "rename", "alias", "describe", "deprecate", "display", "key", "range", "revalue":
+	default:
+		return fmt.Errorf("enum: unknown journal op %q", line.Op)
+	}
+
+	value, err := unmarshalValue[T](line.Value)
+	if err != nil {
+		return err
+	}
+	if line.Op == "add" {
+		return g.replayAdd(line, value)
+	}
+
+	g.lock()
+	defer g.unlock()
+	name := line.Name
+	if line.Op == "rename" {
+		name = line.OldName
+	}
+	if line.Op == "revalue" {
+		old, err := unmarshalValue[T](line.OldValue)
+		if err != nil {
+			return err
+		}
+		return g.revalueLocked(line.Name, old, value)
+	}
+	v, ok := g.nameMap[name]
+	if line.Op == "alias" {
+		v, ok = g.aliasTargetLocked(name)
+	}
+	if !ok || v != value {
+		return fmt.Errorf("%w: %q with value %s is not registered", ErrUnknownValue, name, g.formatValue(value))
+	}
+	switch line.Op {
+	case "remove":
+		return g.removeLocked(name, line.Actor)
+	case "rename":
+		return g.renameLocked(line.OldName, line.Name, line.Actor)
+	case "alias":
+		return g.addAliasLocked(name, value, line.Aliases)
+	case "describe":
+		g.setDescriptionLocked(value, line.Text)
+	case "deprecate":
+		g.deprecateLocked(value)
+	case "display":
+		g.setDisplayName(value, line.Locale, line.Text)
+	case "key":
+		return g.addKeyLocked(value, line.Keyspace, line.Key)
+	case "range":
+		return g.setVersionRangeLocked(name, value, line.Since, line.Until)
+	}
+	return nil
+}
+
+// replayAdd applies an "add" line: it adds the entry with its journaled value, sharing
+// the value with other names unless the Generator is bijective, and moves the sequence
+// to the journaled next value (or advances it once for lines that have none).
+func (g *Generator[T]) replayAdd(line journalLine, value T) error {
+	name := line.Name
+	if _, err := NewValueChecked(value, name); err != nil {
+		return err
+	}
+	if err := g.checkName(name); err != nil {
+		return err
+	}
+	if err := g.validate(context.Background(), name, value); err != nil {
+		return err
+	}
+	var next T
+	if line.Next != nil {
+		var err error
+		if next, err = unmarshalValue[T](line.Next); err != nil {
+			return err
+		}
+	}
+
+	g.lock()
+	defer g.unlock()
+	if existing, exists := g.nameMap[name]; exists {
+		return fmt.Errorf("enum: name %q already exists with value %s", name, g.formatValue(existing))
+	}
+	if existing, used := g.valueMap[value]; used && g.bijective {
+		return sharedValueError(existing, name, value)
+	}
+	if err := g.checkLiteralLocked(name, value); err != nil {
+		return err
+	}
+	if err := g.checkMatchLocked(name, "", nil); err != nil {
+		return err
+	}
+	if err := g.checkParent(name, value); err != nil {
+		return err
+	}
+	switch {
+	case g.incrementer == nil:
+	case line.Next != nil:
+		g.current = next
+	default:
+		g.advance()
+	}
+	if g.arena != nil {
+		name = g.arena.intern(name)
+	}
+	g.appendValue(g.entry(value, name))
+	g.addName(value, name)
+	g.nameMap[name] = value
+	g.record(ChangeAdd, name, "", value, line.Actor)
+	g.bump()
+	return nil
+}
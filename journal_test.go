@@ -0,0 +1,277 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// failingWriter fails every write after the first n.
+type failingWriter struct {
+	n   int
+	buf bytes.Buffer
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errors.New("disk full")
+	}
+	w.n--
+	return w.buf.Write(p)
+}
+
+func TestGenerator_Journal(t *testing.T) {
+	t.Run("Replay", func(t *testing.T) {
+		var buf bytes.Buffer
+		g := NewGenerator[int]()
+		g.Journal(&buf)
+		g.Next("Pending") // 0
+		g.Next("Active")  // 1
+		g.Next("Done")    // 2
+		if err := g.RenameAs("Done", "Closed", "alice"); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Remove("Pending"); err != nil {
+			t.Fatal(err)
+		}
+		g.Next("Archived") // 3
+
+		replayed, err := ReplayJournal[int](bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(replayed.Values(), g.Values()) {
+			t.Errorf("Expected %v, got %v", g.Values(), replayed.Values())
+		}
+		if v := replayed.Next("Next"); v.Get() != 4 {
+			t.Errorf("Expected the sequence to continue at 4, got %d", v.Get())
+		}
+		if err := replayed.JournalErr(); err != nil {
+			t.Errorf("Expected no journal error, got %v", err)
+		}
+	})
+
+	t.Run("LineFormat", func(t *testing.T) {
+		var buf bytes.Buffer
+		g := NewMapped(map[string]string{"Red": "r"})
+		g.Journal(&buf)
+		if err := g.RenameAs("Red", "Crimson", "bob"); err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		if len(lines) != 1 {
+			t.Fatalf("Expected one line, got %q", buf.String())
+		}
+		var got map[string]any
+		if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got["op"] != "rename" || got["name"] != "Crimson" || got["old_name"] != "Red" || got["value"] != "r" || got["actor"] != "bob" || got["ts"] == nil {
+			t.Errorf("Unexpected line %s", lines[0])
+		}
+	})
+
+	t.Run("TrailingPartialLine", func(t *testing.T) {
+		journal := `{"op":"add","name":"A","value":0,"ts":"2024-01-01T00:00:00Z"}
+{"op":"add","name":"B","value":1,"ts":"2024-01-01T00:00:00Z"}
+{"op":"add","name":"C","va`
+		g, err := ReplayJournal[int](strings.NewReader(journal))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := g.Names(); !reflect.DeepEqual(got, []string{"A", "B"}) {
+			t.Errorf("Expected [A B], got %v", got)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := map[string]string{
+			"corrupt line":  "{\"op\":\"add\",\"name\":\"A\",\"value\":0}\n{\"op\n{\"op\":\"add\",\"name\":\"B\",\"value\":1}\n",
+			"remove":        "{\"op\":\"remove\",\"name\":\"A\",\"value\":0}\n",
+			"wrong value":   "{\"op\":\"add\",\"name\":\"A\",\"value\":0}\n{\"op\":\"rename\",\"name\":\"B\",\"old_name\":\"A\",\"value\":5}\n",
+			"duplicate add": "{\"op\":\"add\",\"name\":\"A\",\"value\":0}\n{\"op\":\"add\",\"name\":\"A\",\"value\":1}\n",
+			"unknown op":    "{\"op\":\"merge\",\"name\":\"A\",\"value\":0}\n",
+		}
+		for name, journal := range tests {
+			if _, err := ReplayJournal[int](strings.NewReader(journal)); err == nil || !strings.Contains(err.Error(), "journal line") {
+				t.Errorf("%s: expected an error naming the line, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("OntoSnapshot", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+		snapshot, _ := json.Marshal(g)
+		var buf bytes.Buffer
+		g.Journal(&buf)
+		if err := g.Rename("Active", "Live"); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Remove("Pending"); err != nil {
+			t.Fatal(err)
+		}
+
+		restored := NewMapped(map[string]int{})
+		if err := json.Unmarshal(snapshot, restored); err != nil {
+			t.Fatal(err)
+		}
+		if err := restored.ApplyJournal(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := restored.FormatValueMap(), "2=Live"; got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("WriteFailure", func(t *testing.T) {
+		w := &failingWriter{n: 1}
+		g := NewGenerator[int]()
+		g.Journal(w)
+		g.Next("A")
+		g.Next("B")
+		g.Next("C")
+		if !g.ContainsName("B") || !g.ContainsName("C") {
+			t.Error("Expected the mutations to be kept")
+		}
+		if err := g.JournalErr(); err == nil || !strings.Contains(err.Error(), `add of "B": disk full`) {
+			t.Errorf("Expected the first write error, got %v", err)
+		}
+		if strings.Count(w.buf.String(), "\n") != 1 {
+			t.Errorf("Expected journaling to stop, got %q", w.buf.String())
+		}
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		var buf bytes.Buffer
+		g := NewGenerator[int]()
+		g.Journal(&buf)
+		g.Next("A")
+		g.Clone().Next("FromClone")
+		g.Journal(nil)
+		g.Next("B")
+		if strings.Count(buf.String(), "\n") != 1 {
+			t.Errorf("Expected only the line of A, got %q", buf.String())
+		}
+	})
+
+	t.Run("SharedValues", func(t *testing.T) {
+		var cyclic bytes.Buffer
+		g := NewCyclic(2)
+		g.Journal(&cyclic)
+		g.Next("A") // 0
+		g.Next("B") // 1
+		g.Next("C") // 0 again, after the wrap.
+		replayed := NewCyclic(2)
+		if err := replayed.ApplyJournal(&cyclic); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(replayed.Values(), g.Values()) || replayed.Next("D").Get() != 1 {
+			t.Errorf("Expected the cyclic sequence to resume at 1, got %v", replayed.Values())
+		}
+
+		var saturated bytes.Buffer
+		opts := []Option[int8]{WithStart(int8(126)), WithOverflowPolicy[int8](OverflowSaturate)}
+		s := NewGenerator(opts...)
+		s.Journal(&saturated)
+		s.Next("A") // 126
+		s.Next("B") // 127
+		s.Next("C") // 127 again.
+		restored, err := ReplayJournal(&saturated, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(restored.Values(), s.Values()) || restored.Next("D").Get() != 127 {
+			t.Errorf("Expected the saturated sequence to stay at 127, got %v", restored.Values())
+		}
+	})
+
+	t.Run("AllMutations", func(t *testing.T) {
+		var buf bytes.Buffer
+		g := NewGenerator[int]()
+		g.Journal(&buf)
+		g.Next("Pending")
+		g.Next("Active")
+		g.AddAlias("Active", "Live")
+		g.SetDescription(0, "Awaiting payment")
+		g.Deprecate(0)
+		g.SetDisplayName(1, "de-DE", "Aktiv")
+		if err := g.AddKey(1, "code", "A"); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.SetVersionRange("Active", "v2", ""); err != nil {
+			t.Fatal(err)
+		}
+		g.SortByName()
+		with, err := (&BasicRegistry{meta: g}).Parse("Pending")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := with.TryWith(10); err != nil {
+			t.Fatal(err)
+		}
+
+		replayed, err := ReplayJournal[int](bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := replayed.Names(), g.Names(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected names %v, got %v", want, got)
+		}
+		if v, err := replayed.Parse("Live"); err != nil || v.Get() != 1 {
+			t.Errorf("Expected the alias to be replayed, got %v (%v)", v, err)
+		}
+		if desc, _ := replayed.Description(0); desc != "Awaiting payment" || !replayed.IsDeprecated(0) {
+			t.Errorf("Expected the description and deprecation to be replayed, got %q", desc)
+		}
+		if name, _ := replayed.DisplayName(1, "de-DE"); name != "Aktiv" {
+			t.Errorf("Expected the display name to be replayed, got %q", name)
+		}
+		if v, ok := replayed.ByKey("code", "A"); !ok || v.Get() != 1 {
+			t.Errorf("Expected the key to be replayed, got %v", v)
+		}
+		if r, ok := replayed.VersionRange("Active"); !ok || r.Since != "v2" {
+			t.Errorf("Expected the version range to be replayed, got %v", r)
+		}
+		if v, _ := replayed.Get("Pending"); v != 10 {
+			t.Errorf("Expected the revalue to be replayed, got %d", v)
+		}
+	})
+
+	t.Run("WritesAfterUnlock", func(t *testing.T) {
+		w := &blockingWriter{entered: make(chan struct{}), release: make(chan struct{})}
+		g := NewGenerator[int]()
+		g.Journal(w)
+		done := make(chan struct{})
+		go func() {
+			g.Next("A")
+			close(done)
+		}()
+		<-w.entered
+		if !g.ContainsName("A") { // Would deadlock if the write held the lock.
+			t.Error("Expected the entry to be committed before its line is written")
+		}
+		select {
+		case <-done:
+			t.Error("Expected Next to wait for its line to be written")
+		default:
+		}
+		close(w.release)
+		<-done
+	})
+}
+
+// blockingWriter blocks each write until release is closed, signaling entered first.
+type blockingWriter struct {
+	entered, release chan struct{}
+	once             sync.Once
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.entered) })
+	<-w.release
+	return len(p), nil
+}
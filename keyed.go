@@ -0,0 +1,166 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// keyIndex holds the secondary keys added with AddKey, indexed both ways.
+type keyIndex[T TypesValue] struct {
+	byKey   map[string]map[string]T // Keyspace -> key -> value.
+	byValue map[T]map[string]string // Value -> keyspace -> key.
+}
+
+// clone returns a deep copy of the index, or nil for a nil index.
+func (k *keyIndex[T]) clone() *keyIndex[T] {
+	if k == nil {
+		return nil
+	}
+	c := &keyIndex[T]{
+		byKey:   make(map[string]map[string]T, len(k.byKey)),
+		byValue: make(map[T]map[string]string, len(k.byValue)),
+	}
+	for keyspace, keys := range k.byKey {
+		c.byKey[keyspace] = make(map[string]T, len(keys))
+		for key, value := range keys {
+			c.byKey[keyspace][key] = value
+		}
+	}
+	for value, keys := range k.byValue {
+		c.byValue[value] = make(map[string]string, len(keys))
+		for keyspace, key := range keys {
+			c.byValue[value][keyspace] = key
+		}
+	}
+	return c
+}
+
+// drop removes every key of value.
+func (k *keyIndex[T]) drop(value T) {
+	if k == nil {
+		return
+	}
+	for keyspace, key := range k.byValue[value] {
+		delete(k.byKey[keyspace], key)
+	}
+	delete(k.byValue, value)
+}
+
+// AddKey registers key as the secondary key of value in keyspace, so that ByKey can
+// resolve it. Keyspaces are independent sets of lookup keys, e.g. "alpha2" for ISO
+// country codes next to the names and numeric values of a country enum; each value
+// has at most one key per keyspace, and each key names one value within its keyspace.
+// Adding the key a value already has is a no-op. Keys are listed by Catalog.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Returns an error if the value does not exist, keyspace or key is empty, the key is
+// used by another value in the keyspace, or the value has a different key there.
+//
+// Example:
+//
+//	countries := NewMapped(map[string]int{"United States": 840, "Germany": 276})
+//	countries.AddKey(840, "alpha2", "US")
+//	countries.AddKey(840, "alpha3", "USA")
+//	v, ok := countries.ByKey("alpha2", "US") // {840 United States}, true
+func (g *Generator[T]) AddKey(value T, keyspace, key string) error {
+	g.lock()
+	defer g.unlock()
+	return g.addKeyLocked(value, keyspace, key)
+}
+
+// addKeyLocked implements AddKey. The caller must hold the write lock.
+func (g *Generator[T]) addKeyLocked(value T, keyspace, key string) error {
+	if _, ok := g.valueMap[value]; !ok {
+		return fmt.Errorf("enum: cannot add key for unknown value %s", g.formatValue(value))
+	}
+	if keyspace == "" || key == "" {
+		return fmt.Errorf("enum: cannot add key %q in keyspace %q: both must be non-empty", key, keyspace)
+	}
+	if g.keys == nil {
+		g.keys = &keyIndex[T]{byKey: make(map[string]map[string]T), byValue: make(map[T]map[string]string)}
+	}
+	if owner, ok := g.keys.byKey[keyspace][key]; ok {
+		if owner == value {
+			return nil
+		}
+		if name, ok := g.valueMap[owner]; ok {
+			return fmt.Errorf("enum: key %q in keyspace %q is already used by %q", key, keyspace, name)
+		}
+		g.keys.drop(owner) // Left behind by UnmarshalJSON replacing the entries.
+	}
+	if existing, ok := g.keys.byValue[value][keyspace]; ok {
+		return fmt.Errorf("enum: value %s already has key %q in keyspace %q", g.formatValue(value), existing, keyspace)
+	}
+
+	if g.keys.byKey[keyspace] == nil {
+		g.keys.byKey[keyspace] = make(map[string]T)
+	}
+	if g.keys.byValue[value] == nil {
+		g.keys.byValue[value] = make(map[string]string)
+	}
+	g.keys.byKey[keyspace][key] = value
+	g.keys.byValue[value][keyspace] = key
+	g.journalLocked(journalLine{Op: "key", Name: g.valueMap[value], Keyspace: keyspace, Key: key}, value)
+	g.bump()
+	return nil
+}
+
+// ByKey returns the entry whose secondary key in keyspace is key, with its canonical
+// name. Returns false if no entry has that key. It is thread-safe, using a read lock
+// for access.
+func (g *Generator[T]) ByKey(keyspace, key string) (Value[T], bool) {
+	g.rlock()
+	defer g.runlock()
+	if g.keys == nil {
+		return Value[T]{}, false
+	}
+	value, ok := g.keys.byKey[keyspace][key]
+	if !ok {
+		return Value[T]{}, false
+	}
+	name, ok := g.valueMap[value]
+	if !ok {
+		return Value[T]{}, false
+	}
+	return g.entry(value, name), true
+}
+
+// Key returns the secondary key of value in keyspace, added with AddKey.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Key(value T, keyspace string) (string, bool) {
+	g.rlock()
+	defer g.runlock()
+	if g.keys == nil {
+		return "", false
+	}
+	key, ok := g.keys.byValue[value][keyspace]
+	return key, ok
+}
+
+// Keyspaces returns the keyspaces that have at least one key, sorted.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Keyspaces() []string {
+	g.rlock()
+	defer g.runlock()
+	var out []string
+	if g.keys != nil {
+		for keyspace, keys := range g.keys.byKey {
+			if len(keys) > 0 {
+				out = append(out, keyspace)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// encodeKeys returns the keys of value as a JSON object with sorted members, or "" if
+// it has none. The caller must hold the read lock.
+func (g *Generator[T]) encodeKeys(value T) string {
+	if g.keys == nil || len(g.keys.byValue[value]) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(g.keys.byValue[value]) // A map[string]string always marshals.
+	return string(b)
+}
@@ -0,0 +1,131 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_AddKey(t *testing.T) {
+	newCountries := func(t *testing.T) *Generator[int] {
+		g := NewMapped(map[string]int{"United States": 840, "Germany": 276, "France": 250}, WithSortedEntries[int]())
+		for _, k := range []struct {
+			value         int
+			keyspace, key string
+		}{
+			{840, "alpha2", "US"}, {840, "alpha3", "USA"},
+			{276, "alpha2", "DE"}, {276, "alpha3", "DEU"},
+			{250, "alpha2", "FR"},
+		} {
+			if err := g.AddKey(k.value, k.keyspace, k.key); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return g
+	}
+
+	t.Run("ByKey", func(t *testing.T) {
+		g := newCountries(t)
+		if v, ok := g.ByKey("alpha2", "US"); !ok || v.Get() != 840 || v.name != "United States" {
+			t.Errorf("Expected {840 United States}, got %v, %t", v, ok)
+		}
+		if v, ok := g.ByKey("alpha3", "DEU"); !ok || v.Get() != 276 {
+			t.Errorf("Expected 276, got %v, %t", v, ok)
+		}
+		if _, ok := g.ByKey("alpha3", "US"); ok {
+			t.Error("Expected keyspaces to be independent")
+		}
+		if _, ok := g.ByKey("numeric", "840"); ok {
+			t.Error("Expected an unknown keyspace to miss")
+		}
+		if key, ok := g.Key(250, "alpha2"); !ok || key != "FR" {
+			t.Errorf("Expected FR, got %q, %t", key, ok)
+		}
+		if _, ok := g.Key(250, "alpha3"); ok {
+			t.Error("Expected no alpha3 key for France")
+		}
+		if got := g.Keyspaces(); !reflect.DeepEqual(got, []string{"alpha2", "alpha3"}) {
+			t.Errorf("Expected [alpha2 alpha3], got %v", got)
+		}
+	})
+
+	t.Run("Uniqueness", func(t *testing.T) {
+		g := newCountries(t)
+		if err := g.AddKey(840, "alpha2", "US"); err != nil {
+			t.Errorf("Expected re-adding the same key to succeed, got %v", err)
+		}
+		for _, k := range []struct {
+			value         int
+			keyspace, key string
+		}{
+			{250, "alpha2", "US"}, // Used by another value.
+			{840, "alpha2", "UM"}, // Value already has a key there.
+			{999, "alpha2", "XX"}, // Unknown value.
+			{250, "", "FR"},       // Empty keyspace.
+			{250, "alpha3", ""},   // Empty key.
+		} {
+			if err := g.AddKey(k.value, k.keyspace, k.key); err == nil {
+				t.Errorf("AddKey(%d, %q, %q): expected an error", k.value, k.keyspace, k.key)
+			}
+		}
+		if err := g.AddKey(250, "alpha3", "USA"); err == nil {
+			t.Error("Expected keys to be unique within a keyspace")
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		g := newCountries(t)
+		if err := g.Remove("Germany"); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := g.ByKey("alpha2", "DE"); ok {
+			t.Error("Expected keys to be removed with their value")
+		}
+		if err := g.AddKey(250, "alpha3", "DEU"); err != nil {
+			t.Errorf("Expected the removed key to be free, got %v", err)
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		g := newCountries(t)
+		c := g.Clone()
+		if err := c.AddKey(250, "alpha3", "FRA"); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := g.ByKey("alpha3", "FRA"); ok {
+			t.Error("Expected the clone's keys to be independent")
+		}
+		if v, ok := c.ByKey("alpha2", "US"); !ok || v.Get() != 840 {
+			t.Errorf("Expected the clone to keep the keys, got %v, %t", v, ok)
+		}
+	})
+
+	t.Run("Catalog", func(t *testing.T) {
+		g := newCountries(t)
+		c := g.Catalog()
+		if got := c[2].Keys(); !reflect.DeepEqual(got, map[string]string{"alpha2": "US", "alpha3": "USA"}) {
+			t.Errorf("Unexpected keys %v", got)
+		}
+		b, err := json.Marshal(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `[{"id":250,"code":"France","label":"France","description":"France","deprecated":false,"keys":{"alpha2":"FR"}},` +
+			`{"id":276,"code":"Germany","label":"Germany","description":"Germany","deprecated":false,"keys":{"alpha2":"DE","alpha3":"DEU"}},` +
+			`{"id":840,"code":"United States","label":"United States","description":"United States","deprecated":false,"keys":{"alpha2":"US","alpha3":"USA"}}]`
+		if string(b) != want {
+			t.Errorf("Unexpected JSON:\n got %s\nwant %s", b, want)
+		}
+
+		var decoded []CatalogEntry[int]
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded, c) {
+			t.Errorf("Expected round trip to preserve the catalog, got %+v", decoded)
+		}
+		if decoded[0] != c[0] {
+			t.Error("Expected entries with equal keys to compare equal")
+		}
+	})
+}
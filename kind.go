@@ -0,0 +1,155 @@
+package enum
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ValueClass is the family of an enum's underlying type, reported in a ValueKind.
+type ValueClass string
+
+const (
+	ClassString ValueClass = "string" // String types.
+	ClassInt    ValueClass = "int"    // Signed integer types.
+	ClassUint   ValueClass = "uint"   // Unsigned integer types.
+	ClassFloat  ValueClass = "float"  // Floating-point types.
+)
+
+// ValueKind describes the underlying type of an enum's values, so generic tooling can
+// tell strings, integers, and floats apart without a type switch over every
+// instantiation. It is reported by Kind on every Registry, by Config, and in State.
+//
+// It marshals to text as the name of the Go type it describes (e.g., "int", "uint8",
+// "float64", "string").
+type ValueKind struct {
+	Class ValueClass // Family of the type.
+	Bits  int        // Size in bits; 0 for strings and for the platform-sized int and uint.
+}
+
+// String returns the name of the Go type the kind describes (e.g., "int32").
+func (k ValueKind) String() string {
+	if k.Bits == 0 {
+		return string(k.Class)
+	}
+	return string(k.Class) + strconv.Itoa(k.Bits)
+}
+
+// IsString reports whether the kind is a string type.
+func (k ValueKind) IsString() bool {
+	return k.Class == ClassString
+}
+
+// IsInteger reports whether the kind is a signed or unsigned integer type.
+func (k ValueKind) IsInteger() bool {
+	return k.Class == ClassInt || k.Class == ClassUint
+}
+
+// IsFloat reports whether the kind is a floating-point type.
+func (k ValueKind) IsFloat() bool {
+	return k.Class == ClassFloat
+}
+
+// MarshalText implements encoding.TextMarshaler, writing the type name (see String).
+func (k ValueKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing a type name written by
+// MarshalText.
+func (k *ValueKind) UnmarshalText(text []byte) error {
+	for _, kind := range valueKinds {
+		if kind.String() == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("enum: unknown value kind %q", text)
+}
+
+// valueKinds lists every kind a TypesValue type can have.
+var valueKinds = []ValueKind{
+	{Class: ClassString},
+	{Class: ClassInt}, {Class: ClassInt, Bits: 8}, {Class: ClassInt, Bits: 16}, {Class: ClassInt, Bits: 32}, {Class: ClassInt, Bits: 64},
+	{Class: ClassUint}, {Class: ClassUint, Bits: 8}, {Class: ClassUint, Bits: 16}, {Class: ClassUint, Bits: 32}, {Class: ClassUint, Bits: 64},
+	{Class: ClassFloat, Bits: 32}, {Class: ClassFloat, Bits: 64},
+}
+
+// kindOf returns the ValueKind of T. Named types (e.g., type Status int) match no case
+// of the type switch and fall back to underlyingKind.
+func kindOf[T TypesValue]() ValueKind {
+	if k, ok := predeclaredKind(any(*new(T))); ok {
+		return k
+	}
+	return underlyingKind[T]()
+}
+
+// comparableKind is like kindOf for the comparable values of Value, reporting false for
+// types that have no ValueKind (e.g., structs). Named types fall back to namedKind.
+func comparableKind[T comparable]() (ValueKind, bool) {
+	if k, ok := predeclaredKind(any(*new(T))); ok {
+		return k, true
+	}
+	return namedKind[T]()
+}
+
+// predeclaredKind returns the ValueKind of v if its type is a predeclared one of
+// TypesValue.
+func predeclaredKind(v any) (ValueKind, bool) {
+	switch v.(type) {
+	case string:
+		return ValueKind{Class: ClassString}, true
+	case int:
+		return ValueKind{Class: ClassInt}, true
+	case int8:
+		return ValueKind{Class: ClassInt, Bits: 8}, true
+	case int16:
+		return ValueKind{Class: ClassInt, Bits: 16}, true
+	case int32:
+		return ValueKind{Class: ClassInt, Bits: 32}, true
+	case int64:
+		return ValueKind{Class: ClassInt, Bits: 64}, true
+	case uint:
+		return ValueKind{Class: ClassUint}, true
+	case uint8:
+		return ValueKind{Class: ClassUint, Bits: 8}, true
+	case uint16:
+		return ValueKind{Class: ClassUint, Bits: 16}, true
+	case uint32:
+		return ValueKind{Class: ClassUint, Bits: 32}, true
+	case uint64:
+		return ValueKind{Class: ClassUint, Bits: 64}, true
+	case float32:
+		return ValueKind{Class: ClassFloat, Bits: 32}, true
+	case float64:
+		return ValueKind{Class: ClassFloat, Bits: 64}, true
+	}
+	return ValueKind{}, false
+}
+
+// Kind returns the kind of the Generator's underlying type, computed at construction.
+// It is lock-free and thread-safe.
+//
+// Example:
+//
+//	g := NewGenerator[uint8]()
+//	fmt.Println(g.Kind()) // Output: uint8
+func (g *Generator[T]) Kind() ValueKind {
+	return g.kind
+}
+
+// Kind implements Registry. Basic values are always of kind int.
+func (r *BasicRegistry) Kind() ValueKind {
+	return ValueKind{Class: ClassInt}
+}
+
+// Kind is like Generator.Kind. It does not force the build function to run.
+func (l *LazyGenerator[T]) Kind() ValueKind {
+	return kindOf[T]()
+}
+
+// Kind is like BasicRegistry.Kind.
+//
+// Deprecated: Call Kind on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) Kind() ValueKind {
+	return ValueKind{Class: ClassInt}
+}
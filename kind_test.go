@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestKind(t *testing.T) {
+	type Status uint16
+	testCases := []struct {
+		name string
+		got  ValueKind
+		want string
+	}{
+		{"string", NewAlpha().Kind(), "string"},
+		{"int", NewGenerator[int]().Kind(), "int"},
+		{"int8", NewMapped(map[string]int8{"A": 1}).Kind(), "int8"},
+		{"named uint16", NewGenerator[Status]().Kind(), "uint16"},
+		{"float32", NewGenerator[float32]().Kind(), "float32"},
+		{"float64 clone", NewGenerator[float64]().Clone().Kind(), "float64"},
+		{"basic", NewBasicRegistry().Kind(), "int"},
+		{"lazy", Lazy(func(*Generator[uint64]) {}).Kind(), "uint64"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got.String() != tc.want {
+				t.Errorf("Expected %s, got %s", tc.want, tc.got)
+			}
+		})
+	}
+
+	t.Run("Named types", func(t *testing.T) {
+		type (
+			Code    string
+			Level   int8
+			Ratio   float32
+			Big     int64
+			Mask    uint64
+			Point   struct{ X, Y int32 }
+			Labeled int32
+		)
+		if k := kindOf[Code](); !k.IsString() {
+			t.Errorf("Expected string, got %s", k)
+		}
+		for _, tc := range []struct {
+			got  ValueKind
+			want string
+		}{
+			{kindOf[Level](), "int8"},
+			{kindOf[Ratio](), "float32"},
+			{kindOf[Big](), "int64"},
+			{kindOf[Mask](), "uint64"},
+		} {
+			if tc.got.String() != tc.want {
+				t.Errorf("Expected %s, got %s", tc.want, tc.got)
+			}
+		}
+		if k, ok := comparableKind[Labeled](); !ok || k.String() != "int32" {
+			t.Errorf("Expected int32 for a comparable named type, got %s (%v)", k, ok)
+		}
+		if k, ok := comparableKind[Point](); ok {
+			t.Errorf("Expected no kind for a struct, got %s", k)
+		}
+		if k, ok := comparableKind[any](); ok {
+			t.Errorf("Expected no kind for an interface, got %s", k)
+		}
+	})
+
+	t.Run("Predicates", func(t *testing.T) {
+		k := NewGenerator[uint8]().Kind()
+		if !k.IsInteger() || k.IsString() || k.IsFloat() {
+			t.Errorf("Unexpected predicates for %s", k)
+		}
+		if !NewGenerator[float64]().Kind().IsFloat() || !NewAlpha().Kind().IsString() {
+			t.Error("Expected float and string kinds to be reported")
+		}
+	})
+
+	t.Run("Text round trip", func(t *testing.T) {
+		for _, kind := range valueKinds {
+			var back ValueKind
+			text, _ := kind.MarshalText()
+			if err := back.UnmarshalText(text); err != nil || back != kind {
+				t.Errorf("Expected %s to round trip, got %s (%v)", kind, back, err)
+			}
+		}
+		var k ValueKind
+		if err := k.UnmarshalText([]byte("int7")); err == nil {
+			t.Error("Expected an error for an unknown kind")
+		}
+	})
+
+	t.Run("Registry", func(t *testing.T) {
+		var r Registry = NewMapped(map[string]string{"A": "a"})
+		if !r.Kind().IsString() {
+			t.Errorf("Expected string, got %s", r.Kind())
+		}
+	})
+
+	t.Run("State kind check", func(t *testing.T) {
+		data, _ := json.Marshal(NewMapped(map[string]int8{"A": 1}).State())
+		var wrong State[uint8]
+		if err := json.Unmarshal(data, &wrong); err == nil {
+			t.Error("Expected an error decoding an int8 state as uint8")
+		}
+		var back State[int8]
+		if err := json.Unmarshal(data, &back); err != nil || back.Kind.String() != "int8" || len(back.Entries) != 1 {
+			t.Errorf("Expected the state to decode, got %+v (%v)", back, err)
+		}
+		var legacy State[int8]
+		if err := json.Unmarshal([]byte(`{"version":1,"entries":[]}`), &legacy); err != nil {
+			t.Errorf("Expected a state without kind to decode, got %v", err)
+		}
+	})
+}
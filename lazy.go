@@ -0,0 +1,156 @@
+package enum
+
+import "sync"
+
+// LazyGenerator defers populating a Generator until it is first used, so an enum can be
+// declared in a package-level variable without depending on initialization order.
+// The build function runs exactly once, on the first call to any method; all methods
+// then delegate to the built Generator. If build panics, every call, the first and all
+// later ones, panics with the same value. It is safe for concurrent use.
+type LazyGenerator[T TypesValue] struct {
+	once  sync.Once
+	build func(*Generator[T])
+	opts  []Option[T]
+	g     *Generator[T]
+	err   any // The value build panicked with, re-raised on every use.
+}
+
+// Lazy returns a LazyGenerator that creates a Generator with opts and populates it
+// with build on first use.
+//
+// Example:
+//
+//	var Status = Lazy(func(g *Generator[int]) {
+//		g.Next("Pending")
+//		g.Next("Active")
+//	})
+//
+//	v, err := Status.Parse("Active") // build runs here, once
+func Lazy[T TypesValue](build func(*Generator[T]), opts ...Option[T]) *LazyGenerator[T] {
+	return &LazyGenerator[T]{build: build, opts: opts}
+}
+
+// Force builds the Generator if it has not been built yet and returns it. Call it from
+// main to surface panics in the build function at startup rather than on first use.
+func (l *LazyGenerator[T]) Force() *Generator[T] {
+	l.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				l.err = r
+			}
+		}()
+		g := NewGenerator(l.opts...)
+		l.build(g)
+		l.g = g
+	})
+	if l.err != nil {
+		panic(l.err)
+	}
+	return l.g
+}
+
+// Name is like Generator.Name.
+func (l *LazyGenerator[T]) Name(value T) (string, bool) {
+	return l.Force().Name(value)
+}
+
+// Get is like Generator.Get.
+func (l *LazyGenerator[T]) Get(name string) (T, bool) {
+	return l.Force().Get(name)
+}
+
+// Parse is like Generator.Parse.
+func (l *LazyGenerator[T]) Parse(s string) (Value[T], error) {
+	return l.Force().Parse(s)
+}
+
+// MustParse is like Generator.MustParse.
+func (l *LazyGenerator[T]) MustParse(s string) Value[T] {
+	return l.Force().MustParse(s)
+}
+
+// Validate is like Generator.Validate.
+func (l *LazyGenerator[T]) Validate(value T) error {
+	return l.Force().Validate(value)
+}
+
+// ValidateName is like Generator.ValidateName.
+func (l *LazyGenerator[T]) ValidateName(name string) error {
+	return l.Force().ValidateName(name)
+}
+
+// Contains is like Generator.Contains.
+func (l *LazyGenerator[T]) Contains(value T) bool {
+	return l.Force().Contains(value)
+}
+
+// ContainsName is like Generator.ContainsName.
+func (l *LazyGenerator[T]) ContainsName(name string) bool {
+	return l.Force().ContainsName(name)
+}
+
+// Values is like Generator.Values.
+func (l *LazyGenerator[T]) Values() []Value[T] {
+	return l.Force().Values()
+}
+
+// Names is like Generator.Names.
+func (l *LazyGenerator[T]) Names() []string {
+	return l.Force().Names()
+}
+
+// ValidValues is like Generator.ValidValues.
+func (l *LazyGenerator[T]) ValidValues() []T {
+	return l.Force().ValidValues()
+}
+
+// ValueMap is like Generator.ValueMap.
+func (l *LazyGenerator[T]) ValueMap() map[T]string {
+	return l.Force().ValueMap()
+}
+
+// NameMap is like Generator.NameMap.
+func (l *LazyGenerator[T]) NameMap() map[string]T {
+	return l.Force().NameMap()
+}
+
+// Pairs is like Generator.Pairs.
+func (l *LazyGenerator[T]) Pairs() []Pair[T] {
+	return l.Force().Pairs()
+}
+
+// Len is like Generator.Len.
+func (l *LazyGenerator[T]) Len() int {
+	return l.Force().Len()
+}
+
+// View is like Generator.View.
+func (l *LazyGenerator[T]) View() GeneratorView[T] {
+	return l.Force().View()
+}
+
+// NameOfAny is like Generator.NameOfAny, so a LazyGenerator can be passed to Register.
+func (l *LazyGenerator[T]) NameOfAny(v any) (string, bool) {
+	return l.Force().NameOfAny(v)
+}
+
+// ParseAny is like Generator.ParseAny.
+func (l *LazyGenerator[T]) ParseAny(s string) (any, error) {
+	return l.Force().ParseAny(s)
+}
+
+// MarshalJSON is like Generator.MarshalJSON.
+func (l *LazyGenerator[T]) MarshalJSON() ([]byte, error) {
+	return l.Force().MarshalJSON()
+}
+
+// MarshalOrderedJSON is like Generator.MarshalOrderedJSON.
+func (l *LazyGenerator[T]) MarshalOrderedJSON() ([]byte, error) {
+	return l.Force().MarshalOrderedJSON()
+}
+
+// catalogEntries is like Generator.catalogEntries, so the Handler reads a LazyGenerator
+// in one pass.
+func (l *LazyGenerator[T]) catalogEntries() ([]catalogEntryJSON, error) {
+	return l.Force().catalogEntries()
+}
@@ -0,0 +1,73 @@
+package enum
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var builds atomic.Int32
+	status := Lazy(func(g *Generator[int]) {
+		builds.Add(1)
+		g.Next("Pending")
+		g.Next("Active")
+	}, WithStart(1))
+
+	if builds.Load() != 0 {
+		t.Fatal("Expected build to be deferred")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := status.Parse("Active"); err != nil || v.Get() != 2 {
+				t.Errorf("Expected Active=2, got %d, err: %v", v.Get(), err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := builds.Load(); n != 1 {
+		t.Errorf("Expected exactly one build, got %d", n)
+	}
+
+	if name, ok := status.Name(1); !ok || name != "Pending" {
+		t.Errorf("Expected Pending, got %q", name)
+	}
+	if status.Len() != 2 || !status.ContainsName("Pending") || status.Validate(3) == nil {
+		t.Error("Unexpected read API results")
+	}
+	if b, err := json.Marshal(status); err != nil || string(b) != `{"1":"Pending","2":"Active"}` {
+		t.Errorf("Unexpected JSON %s, err: %v", b, err)
+	}
+	if status.Force() != status.Force() {
+		t.Error("Expected Force to return the same Generator")
+	}
+
+	var _ Registry = status
+}
+
+func TestLazy_BuildPanics(t *testing.T) {
+	var builds atomic.Int32
+	status := Lazy(func(g *Generator[int]) {
+		builds.Add(1)
+		g.Next("Pending")
+		g.Next("Pending") // Duplicate name.
+	})
+	for i := 0; i < 3; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Call %d: expected the build panic to be re-raised", i)
+				}
+			}()
+			status.Len()
+		}()
+	}
+	if n := builds.Load(); n != 1 {
+		t.Errorf("Expected exactly one build, got %d", n)
+	}
+}
@@ -0,0 +1,178 @@
+package enum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// SkipReason classifies why LoadJSONLenient skipped an entry.
+type SkipReason string
+
+const (
+	SkipUnparsable     SkipReason = "unparsable"      // The entry's value or name could not be decoded.
+	SkipDuplicateName  SkipReason = "duplicate name"  // An earlier entry has the same name.
+	SkipDuplicateValue SkipReason = "duplicate value" // An earlier entry has the same value.
+	SkipRejected       SkipReason = "rejected"        // A name constraint, literal check, or validator rejected it.
+)
+
+// SkippedEntry describes an entry LoadJSONLenient did not load.
+type SkippedEntry struct {
+	Key    string     // Object key of the entry, or its array index (e.g., "[3]").
+	Name   string     // Name of the entry, if it could be decoded.
+	Reason SkipReason // Why the entry was skipped.
+	Err    error      // Underlying error.
+}
+
+// String returns a one-line description, e.g. `"7": duplicate name: ...`.
+func (s SkippedEntry) String() string {
+	return fmt.Sprintf("%s: %s: %v", s.Key, s.Reason, s.Err)
+}
+
+// LoadReport summarizes a LoadJSONLenient call.
+type LoadReport struct {
+	Loaded  int            // Number of entries loaded.
+	Skipped []SkippedEntry // Entries not loaded, in array order, or by key for the object form.
+}
+
+// LoadJSONLenient is like LoadJSON but, instead of failing on bad entries, loads the
+// valid ones and reports the rest in the LoadReport. An entry is skipped
+// if its value or name cannot be decoded, its name or value repeats an earlier entry's,
+// or it is rejected by WithMaxNameLength, WithNameCharset, WithLiteralNameCheck, or a
+// WithValidator check (run with context.Background()).
+//
+// Entries of the array form are considered in order and those of the object form by
+// value, so the first of two duplicates is the one loaded. The error is non-nil only if
+// r cannot be read or its content is not a JSON array or object in a form UnmarshalJSON
+// accepts; the strict LoadJSON remains the default.
+//
+// Example:
+//
+//	g, report, err := LoadJSONLenient[int](f)
+//	if err != nil {
+//		return err // Unreadable file.
+//	}
+//	for _, s := range report.Skipped {
+//		log.Printf("enum config: skipped %s", s)
+//	}
+func LoadJSONLenient[T TypesValue](r io.Reader, opts ...Option[T]) (*Generator[T], LoadReport, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, LoadReport{}, err
+	}
+	g := NewMapped(map[string]T{}, opts...)
+	candidates, skipped, err := g.decodeLenient(data)
+	if err != nil {
+		return nil, LoadReport{}, err
+	}
+
+	for _, c := range candidates {
+		reason, err := g.admitLenient(c.pair)
+		if err != nil {
+			skipped = append(skipped, lenientEntry[T]{pos: c.pos, skip: SkippedEntry{Key: c.key, Name: c.pair.Name, Reason: reason, Err: err}})
+			continue
+		}
+		entry := g.entry(c.pair.Value, c.pair.Name)
+		g.values = append(g.values, entry)
+		g.nameMap[entry.name] = entry.value
+		g.addName(entry.value, entry.name)
+	}
+	g.registerUnknown()
+
+	sort.Slice(skipped, func(i, j int) bool { return skipped[i].pos < skipped[j].pos })
+	report := LoadReport{Loaded: len(g.values)}
+	for _, s := range skipped {
+		report.Skipped = append(report.Skipped, s.skip)
+	}
+	return g, report, nil
+}
+
+// lenientEntry is an entry of LoadJSONLenient's input at position pos: decoded into
+// pair, or skipped as described by skip.
+type lenientEntry[T TypesValue] struct {
+	pos  int
+	key  string
+	pair Pair[T]
+	skip SkippedEntry
+}
+
+// decodeLenient decodes data into its well-formed entries, in the order they are to be
+// loaded, and its malformed ones. It fails only for unreadable input.
+func (g *Generator[T]) decodeLenient(data []byte) (entries, skipped []lenientEntry[T], err error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var elems []json.RawMessage
+		if err := json.Unmarshal(trimmed, &elems); err != nil {
+			return nil, nil, err
+		}
+		for i, elem := range elems {
+			key := "[" + strconv.Itoa(i) + "]"
+			var pair Pair[T]
+			if err := json.Unmarshal(elem, &pair); err != nil {
+				skipped = append(skipped, lenientEntry[T]{pos: i, skip: SkippedEntry{Key: key, Reason: SkipUnparsable, Err: err}})
+				continue
+			}
+			entries = append(entries, lenientEntry[T]{pos: i, key: key, pair: pair})
+		}
+		return entries, skipped, nil
+	}
+
+	raw, orientation, err := g.decodeJSONObject(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		pair, err := g.decodeJSONMember(orientation, key, raw[key])
+		if err != nil {
+			skipped = append(skipped, lenientEntry[T]{pos: i, skip: SkippedEntry{Key: strconv.Quote(key), Reason: SkipUnparsable, Err: err}})
+			continue
+		}
+		entries = append(entries, lenientEntry[T]{pos: i, key: strconv.Quote(key), pair: pair})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].pair, entries[j].pair
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.Name < b.Name
+	})
+	return entries, skipped, nil
+}
+
+// admitLenient checks whether LoadJSONLenient can add pair to the entries loaded so
+// far, returning the reason and error if not.
+func (g *Generator[T]) admitLenient(pair Pair[T]) (SkipReason, error) {
+	if isNaN(pair.Value) {
+		return SkipUnparsable, fmt.Errorf("NaN value for %q cannot be used as an enum key", pair.Name)
+	}
+	if other, ok := g.nameMap[pair.Name]; ok {
+		return SkipDuplicateName, fmt.Errorf("name %q already used for %s", pair.Name, g.formatValue(other))
+	}
+	if other, ok := g.valueMap[pair.Value]; ok {
+		return SkipDuplicateValue, fmt.Errorf("value %s already used for %q", g.formatValue(pair.Value), other)
+	}
+	if err := g.checkName(pair.Name); err != nil {
+		return SkipRejected, err
+	}
+	if err := g.checkParent(pair.Name, pair.Value); err != nil {
+		return SkipRejected, err
+	}
+	if err := g.checkLiteralLocked(pair.Name, pair.Value); err != nil {
+		return SkipRejected, err
+	}
+	if err := g.checkMatchLocked(pair.Name, "", nil); err != nil {
+		return SkipRejected, err
+	}
+	if err := g.validate(context.Background(), pair.Name, pair.Value); err != nil {
+		return SkipRejected, err
+	}
+	return "", nil
+}
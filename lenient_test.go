@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLoadJSONLenient(t *testing.T) {
+	type skip struct {
+		key, name string
+		reason    SkipReason
+	}
+	skips := func(report LoadReport) []skip {
+		out := make([]skip, len(report.Skipped))
+		for i, s := range report.Skipped {
+			if s.Err == nil {
+				t.Errorf("Expected an error for %s", s.Key)
+			}
+			out[i] = skip{s.Key, s.Name, s.Reason}
+		}
+		return out
+	}
+
+	t.Run("Array", func(t *testing.T) {
+		input := `[
+			{"value": 1, "name": "Pending"},
+			{"value": "one", "name": "Broken"},
+			{"value": 2, "name": "Active"},
+			{"value": 3, "name": "Pending"},
+			"garbage",
+			{"value": 2, "name": "Running"},
+			{"value": 4, "name": "Closed"}
+		]`
+		g, report, err := LoadJSONLenient[int](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Pair[int]{{1, "Pending"}, {2, "Active"}, {4, "Closed"}}
+		if got := g.Pairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v loaded, got %v", want, got)
+		}
+		if report.Loaded != 3 {
+			t.Errorf("Expected Loaded 3, got %d", report.Loaded)
+		}
+		wantSkips := []skip{
+			{"[1]", "", SkipUnparsable},
+			{"[3]", "Pending", SkipDuplicateName},
+			{"[4]", "", SkipUnparsable},
+			{"[5]", "Running", SkipDuplicateValue},
+		}
+		if got := skips(report); !reflect.DeepEqual(got, wantSkips) {
+			t.Errorf("Expected skipped %v, got %v", wantSkips, got)
+		}
+	})
+
+	t.Run("Object", func(t *testing.T) {
+		input := `{"3": "Closed", "x": "Broken", "1": "Pending", "1.0": "Uno", "2.5": "Pending"}`
+		g, report, err := LoadJSONLenient[float64](strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Pair[float64]{{1, "Pending"}, {3, "Closed"}}
+		if got := g.Pairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v loaded, got %v", want, got)
+		}
+		wantSkips := []skip{
+			{`"1.0"`, "Uno", SkipDuplicateValue},
+			{`"2.5"`, "Pending", SkipDuplicateName},
+			{`"x"`, "", SkipUnparsable},
+		}
+		if got := skips(report); !reflect.DeepEqual(got, wantSkips) {
+			t.Errorf("Expected skipped %v, got %v", wantSkips, got)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		reserved := errors.New("reserved")
+		g, report, err := LoadJSONLenient(strings.NewReader(`[
+			{"value": 1, "name": "Pending"},
+			{"value": 2, "name": "in progress"},
+			{"value": 3, "name": "Null"}
+		]`),
+			WithNameCharset[int](regexp.MustCompile(`[A-Z][a-z]*`)),
+			WithValidator(func(_ context.Context, name string, _ int) error {
+				if name == "Null" {
+					return reserved
+				}
+				return nil
+			}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := g.Names(); !reflect.DeepEqual(got, []string{"Pending"}) {
+			t.Errorf("Expected [Pending] loaded, got %v", got)
+		}
+		wantSkips := []skip{{"[1]", "in progress", SkipRejected}, {"[2]", "Null", SkipRejected}}
+		if got := skips(report); !reflect.DeepEqual(got, wantSkips) {
+			t.Errorf("Expected skipped %v, got %v", wantSkips, got)
+		}
+		if !errors.Is(report.Skipped[0].Err, ErrInvalidName) || !errors.Is(report.Skipped[1].Err, reserved) {
+			t.Errorf("Unexpected errors: %v", report.Skipped)
+		}
+		if want := `[2]: rejected: enum: "Null" rejected: reserved`; report.Skipped[1].String() != want {
+			t.Errorf("Expected %q, got %q", want, report.Skipped[1])
+		}
+	})
+
+	t.Run("Unreadable", func(t *testing.T) {
+		for _, input := range []string{`not json`, `[{"value": 1,`, `42`, `{"_orientation": "sideways"}`} {
+			if _, _, err := LoadJSONLenient[int](strings.NewReader(input)); err == nil {
+				t.Errorf("Expected an error for %s", input)
+			}
+		}
+	})
+
+	t.Run("StrictUnchanged", func(t *testing.T) {
+		if _, err := LoadJSON[int](strings.NewReader(`[{"value": 1, "name": "A"}, {"value": 1, "name": "B"}]`)); err == nil {
+			t.Error("Expected LoadJSON to reject duplicates")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package enum
+
+import "fmt"
+
+// WithLiteralNameCheck makes the Generator reject names that Parse would also read as
+// a value literal of another entry, since the name then shadows that literal and what
+// Parse returns depends on registration order. For numeric types, a name that parses as
+// a number (e.g., "2") is rejected unless it is the entry's own value; for string
+// types, a name equal to another entry's value is rejected, and so is a value equal to
+// another entry's name. Under WithRuneFormatting, character literals count as well.
+//
+// The check applies to Next, Basic's Add and With, NewMapped, Rename, and AddAlias. NewFromSlice, NewFromSliceChecked, NewFromKeys, and NewFromKeysChecked
+// enable it by default. Rejected names fail with an error wrapping ErrInvalidName.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithLiteralNameCheck[int]())
+//	g.Next("Zero")
+//	g.Next("1")                // ok: "1" is the literal of its own value
+//	_, err := g.TryNext("7")   // err: enum: invalid entry: name "7" is the literal of value 7, not 2
+func WithLiteralNameCheck[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.literalCheck = true
+	}
+}
+
+// literalLocked reports the value s denotes as a literal in Parse, regardless of
+// whether an entry has that value. The caller must hold the read lock.
+func (g *Generator[T]) literalLocked(s string) (T, bool) {
+	if v, ok := g.parseRune(s); ok {
+		return v, true
+	}
+	v, err := parseStringToValue[T](s)
+	return v, err == nil
+}
+
+// checkLiteralLocked returns an error if registering name for value would make a name
+// and a value literal ambiguous under WithLiteralNameCheck. The caller must hold the
+// read lock.
+func (g *Generator[T]) checkLiteralLocked(name string, value T) error {
+	if !g.literalCheck {
+		return nil
+	}
+	if lit, ok := g.literalLocked(name); ok && lit != value {
+		if !g.kind.IsString() {
+			return fmt.Errorf("%w: name %q is the literal of value %s, not %s",
+				ErrInvalidName, name, g.formatValue(lit), g.formatValue(value))
+		}
+		if other, ok := g.valueMap[lit]; ok {
+			return fmt.Errorf("%w: name %q is the value of %q", ErrInvalidName, name, other)
+		}
+	}
+	if g.kind.IsString() {
+		if other, ok := g.nameMap[fmt.Sprint(value)]; ok && other != value {
+			return fmt.Errorf("%w: value %q of %q is already a name of value %q", ErrInvalidName, fmt.Sprint(value), name, fmt.Sprint(other))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithLiteralNameCheck(t *testing.T) {
+	t.Run("Name registered after the value", func(t *testing.T) {
+		g := NewGenerator[int](WithLiteralNameCheck[int]())
+		g.Next("Zero")
+		g.Next("One")
+		g.Next("Two")
+		if _, err := g.TryNext("2"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		if v, err := g.Parse("2"); err != nil || v.String() != "Two" {
+			t.Errorf("Expected the literal to resolve to Two, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Name registered before the value", func(t *testing.T) {
+		g := NewGenerator[int](WithLiteralNameCheck[int]())
+		if _, err := g.TryNext("2"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		g.Next("Zero")
+		g.Next("One")
+		g.Next("Two")
+		if v, err := g.Parse("2"); err != nil || v.String() != "Two" {
+			t.Errorf("Expected the literal to resolve to Two, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Own literal is allowed", func(t *testing.T) {
+		g := NewGenerator[int](WithLiteralNameCheck[int]())
+		g.Next("0")
+		if _, err := g.TryNext("1"); err != nil {
+			t.Errorf("Expected the name of its own value to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("Without the option", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("2") // value 0
+		g.Next("One")
+		g.Next("Two")
+		if v, _ := g.Parse("2"); v.Get() != 0 {
+			t.Errorf("Expected the name to shadow the literal, got %v", v)
+		}
+	})
+
+	t.Run("String values", func(t *testing.T) {
+		g := NewMapped(map[string]string{"Pending": "tok_p", "tok_p2": "tok_a"}, WithLiteralNameCheck[string](), WithErrorMode[string]())
+		if g.Len() != 2 || g.Err() != nil {
+			t.Fatalf("Expected names that are not values to be accepted, got %v (%v)", g.Names(), g.Err())
+		}
+		if err := g.Rename("tok_p2", "tok_p"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected renaming to another entry's value to fail, got %v", err)
+		}
+		if err := g.TryAddAlias("Pending", "tok_a"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected an alias equal to another entry's value to fail, got %v", err)
+		}
+
+		s := NewGenerator[string](WithLiteralNameCheck[string](), WithStart("A"))
+		s.Next("B") // value "A"
+		if _, err := s.TryNext("Other"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected value \"B\" to collide with the name B, got %v", err)
+		}
+	})
+
+	t.Run("NewMapped skips collisions", func(t *testing.T) {
+		g := NewMapped(map[string]int{"One": 1, "1": 2}, WithLiteralNameCheck[int](), WithErrorMode[int]())
+		if g.Len() != 1 || !errors.Is(g.Err(), ErrInvalidName) {
+			t.Errorf("Expected \"1\" to be rejected, got %v (%v)", g.Names(), g.Err())
+		}
+	})
+
+	t.Run("Basic With", func(t *testing.T) {
+		b := NewBasicRegistry(WithLiteralNameCheck[int]())
+		zero := b.Add("Zero")
+		if _, err := zero.TryWith(3); err != nil {
+			t.Errorf("Expected Zero to move to 3, got %v", err)
+		}
+		if _, err := b.Add("Nine").TryWith(9); err != nil {
+			t.Errorf("Expected Nine to move to 9, got %v", err)
+		}
+		if v, err := b.TryFromValue(NewValue(5, "5")); err != nil || v.Get() != 5 {
+			t.Errorf("Expected 5 to take its own literal, got %v (%v)", v, err)
+		}
+		if _, err := b.TryFromValue(NewValue(7, "8")); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+	})
+
+	t.Run("Default for NewFromSlice", func(t *testing.T) {
+		if _, err := NewFromSliceChecked([]string{"Low", "7"}, 0); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		if _, err := NewFromSliceChecked([]string{"0", "1"}, 0); err != nil {
+			t.Errorf("Expected names matching their values to be accepted, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Attribute keys used by every log record the package emits, so that records
+// from different enums can be filtered and aggregated consistently.
+const (
+	LogKeyEnum       = "enum"       // Label of the enum set (see WithLabel).
+	LogKeyInput      = "input"      // Input string that failed to parse.
+	LogKeyValue      = "value"      // Enum value involved in the operation.
+	LogKeyName       = "name"       // Enum name involved in the operation.
+	LogKeyLocale     = "locale"     // Locale of a display name lookup.
+	LogKeySuggestion = "suggestion" // Nearest known name to a failed input.
+)
+
+// WithLogger attaches a structured logger to the Generator. Parse misses, Validate
+// failures, and display name fallbacks are logged at Debug; duplicate-add attempts
+// are logged at Warn; successful Next and Remove calls are logged at Debug.
+// Records carry the attribute keys defined by the LogKey constants.
+//
+// A nil logger (the default) disables logging entirely; no attributes are built.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithLabel[int]("Status"), WithLogger[int](slog.Default()))
+//	g.Parse("Actve") // logs: enum parse miss enum=Status input=Actve suggestion=Active
+func WithLogger[T TypesValue](l *slog.Logger) Option[T] {
+	return func(g *Generator[T]) {
+		g.logger = l
+	}
+}
+
+// WithLabel sets a human-readable label for the enum set (e.g., "Status"), used to
+// identify it in log records and in the messages of panics such as a duplicate name
+// passed to Next or BasicRegistry.Add. Panics of unlabeled enum sets name the type of
+// their values and a short fingerprint instead.
+func WithLabel[T TypesValue](label string) Option[T] {
+	return func(g *Generator[T]) {
+		g.label = label
+	}
+}
+
+// Label returns the label set with WithLabel, or "" if none was set.
+func (g *Generator[T]) Label() string {
+	return g.label
+}
+
+// log emits a record tagged with the enum label. Callers must check that
+// g.logger is non-nil first, so that a Generator without a logger does no work.
+func (g *Generator[T]) log(level slog.Level, msg string, attrs ...slog.Attr) {
+	attrs = append([]slog.Attr{slog.String(LogKeyEnum, g.label)}, attrs...)
+	g.logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
+// nearestName returns the name closest to s by edit distance, or "" if there are no names.
+// Ties are broken by the lexically smaller name so the result is deterministic.
+func nearestName[T TypesValue](s string, names map[string]T) string {
+	best, bestDist := "", -1
+	for name := range names {
+		d := editDistance(s, name)
+		if bestDist < 0 || d < bestDist || (d == bestDist && name < best) {
+			best, bestDist = name, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b, counted in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,159 @@
+package enum
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+// recordingHandler is a slog.Handler that keeps every record for inspection.
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordingHandler) WithGroup(string) slog.Handler      { return h }
+
+// last returns the message, level, and attributes of the most recent record.
+func (h *recordingHandler) last(t *testing.T) (string, slog.Level, map[string]string) {
+	t.Helper()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.records) == 0 {
+		t.Fatal("Expected a log record, got none")
+	}
+	r := h.records[len(h.records)-1]
+	attrs := make(map[string]string)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.String()
+		return true
+	})
+	return r.Message, r.Level, attrs
+}
+
+func TestGenerator_WithLogger(t *testing.T) {
+	h := &recordingHandler{}
+	g := NewGenerator[int](WithLabel[int]("Status"), WithLogger[int](slog.New(h)))
+
+	t.Run("Next", func(t *testing.T) {
+		g.Next("Pending")
+		g.Next("Active")
+		msg, level, attrs := h.last(t)
+		if msg != "enum value added" || level != slog.LevelDebug || attrs[LogKeyEnum] != "Status" ||
+			attrs[LogKeyName] != "Active" || attrs[LogKeyValue] != "1" {
+			t.Errorf("Unexpected record %q %v %v", msg, level, attrs)
+		}
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		if _, err := g.TryNext("Active"); err == nil {
+			t.Fatal("Expected duplicate error")
+		}
+		msg, level, attrs := h.last(t)
+		if msg != "enum duplicate name" || level != slog.LevelWarn || attrs[LogKeyName] != "Active" {
+			t.Errorf("Unexpected record %q %v %v", msg, level, attrs)
+		}
+	})
+
+	t.Run("Parse miss", func(t *testing.T) {
+		if _, err := g.Parse("Actve"); err == nil {
+			t.Fatal("Expected parse error")
+		}
+		msg, level, attrs := h.last(t)
+		if msg != "enum parse miss" || level != slog.LevelDebug || attrs[LogKeyEnum] != "Status" ||
+			attrs[LogKeyInput] != "Actve" || attrs[LogKeySuggestion] != "Active" {
+			t.Errorf("Unexpected record %q %v %v", msg, level, attrs)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		if err := g.Validate(7); err == nil {
+			t.Fatal("Expected validation error")
+		}
+		msg, _, attrs := h.last(t)
+		if msg != "enum validate failed" || attrs[LogKeyValue] != "7" {
+			t.Errorf("Unexpected record %q %v", msg, attrs)
+		}
+	})
+
+	t.Run("Display fallback", func(t *testing.T) {
+		g.DisplayName(0, "de-DE")
+		msg, _, attrs := h.last(t)
+		if msg != "enum display name fallback" || attrs[LogKeyLocale] != "de-DE" || attrs[LogKeyValue] != "0" {
+			t.Errorf("Unexpected record %q %v", msg, attrs)
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		if err := g.Remove("Pending"); err != nil {
+			t.Fatal(err)
+		}
+		msg, _, attrs := h.last(t)
+		if msg != "enum value removed" || attrs[LogKeyName] != "Pending" {
+			t.Errorf("Unexpected record %q %v", msg, attrs)
+		}
+	})
+
+	t.Run("Successful lookups are silent", func(t *testing.T) {
+		n := len(h.records)
+		g.Parse("Active")
+		g.Validate(1)
+		if len(h.records) != n {
+			t.Errorf("Expected no records, got %d new", len(h.records)-n)
+		}
+	})
+
+	t.Run("Nil logger", func(t *testing.T) {
+		q := NewGenerator[int]()
+		q.Next("A")
+		if _, err := q.Parse("B"); err == nil {
+			t.Error("Expected parse error")
+		}
+	})
+}
+
+func TestBasic_WithLogger(t *testing.T) {
+	h := &recordingHandler{}
+	b := NewBasicRegistry(WithLabel[int]("Color"), WithLogger[int](slog.New(h)))
+	red := b.Add("Red")
+	b.Add("Green")
+
+	func() {
+		defer func() { recover() }()
+		red.With(1)
+	}()
+	msg, level, attrs := h.last(t)
+	if msg != "enum duplicate value" || level != slog.LevelWarn || attrs[LogKeyEnum] != "Color" || attrs[LogKeyValue] != "1" {
+		t.Errorf("Unexpected record %q %v %v", msg, level, attrs)
+	}
+
+	if err := (Basic{value: 9, meta: b.meta}).Validate(); err == nil {
+		t.Fatal("Expected validation error")
+	}
+	if msg, _, attrs := h.last(t); msg != "enum validate failed" || attrs[LogKeyValue] != "9" {
+		t.Errorf("Unexpected record %q %v", msg, attrs)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	if d := editDistance("kitten", "sitting"); d != 3 {
+		t.Errorf("Expected 3, got %d", d)
+	}
+	if s := nearestName("Closd", map[string]int{"Open": 0, "Closed": 1}); s != "Closed" {
+		t.Errorf("Expected Closed, got %q", s)
+	}
+	if s := nearestName("x", map[string]int{}); s != "" {
+		t.Errorf("Expected no suggestion, got %q", s)
+	}
+}
@@ -1,3 +1,5 @@
+//go:build !noreflect
+
 // Package enum provides a generic implementation of enumerated types (enums) in Go.
 // The Maker type in this package offers a reflection-based approach to create enums
 // from struct fields, assigning sequential integer values to exported fields of a struct.
@@ -13,6 +15,13 @@
 // or the Generator type. It is best suited for simple, static enums where convenience
 // outweighs performance concerns.
 //
+// Maker, ResolveStruct, and everything built on them are left out of builds with the
+// noreflect tag (go build -tags=noreflect), for targets such as TinyGo where walking
+// struct fields with reflect is unsupported or costly. Generator and Basic remain
+// available, and the package then does not import reflect: the kind of a named value
+// type is found without it, which reports a named int or uint by its width (e.g.,
+// int64) rather than as platform-sized.
+//
 // Example usage:
 //
 //	type Colors struct {
@@ -28,9 +37,13 @@
 package enum
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 // Maker provides a reflection-based mechanism to create enums from struct fields.
@@ -42,10 +55,13 @@ import (
 // The Maker is not thread-safe, as it is designed for initialization and read-only access
 // after creation. Use Make to create a Maker instance.
 type Maker[T any, E TypesMake] struct {
-	instance *T           // Pointer to the populated struct instance.
-	valueMap map[E]string // Maps enum values to field names.
-	nameMap  map[string]E // Maps field names to enum values.
-	entries  []Value[E]   // Slice of all enum entries.
+	instance     *T           // Pointer to the populated struct instance.
+	valueMap     map[E]string // Maps enum values to field names.
+	nameMap      map[string]E // Maps field names to enum values.
+	entries      []Value[E]   // Slice of all enum entries.
+	aliases      map[string]E // Alternate names accepted by Parse, from `enum:"alias=..."` tags.
+	descriptions map[E]string // Descriptions by value, from `enum:"desc=..."` tags.
+	kind         ValueKind    // Kind of E, reported by Kind.
 }
 
 // Make creates a new Maker instance from a struct pointer, assigning sequential
@@ -53,10 +69,25 @@ type Maker[T any, E TypesMake] struct {
 // through the struct’s fields, setting each exported field to a value (starting from 0)
 // and building value-to-name and name-to-value mappings.
 //
+// A field's value can be fixed explicitly, either with an `enum:"value=N"` struct tag
+// or by pre-setting the field to a non-zero value before calling Make. Other exported
+// fields receive their field index as value, like iota.
+//
+// The `enum` tag is a comma-separated list of key=value pairs. Besides "value", it
+// accepts "alias", a "|"-separated list of alternate names accepted by Parse, and
+// "desc", a description reported by Description and Catalog. A backslash escapes a
+// comma or backslash inside a value (written `\\,` inside the quoted tag):
+//
+//	Live int `enum:"value=2,alias=LIVE|ONLINE,desc=Item is visible\\, and searchable"`
+//
 // Panics if:
 // - The provided construct is not a pointer to a struct.
 // - The number of fields exceeds the capacity of the underlying type E (e.g., 256 for int8).
-// - The struct contains unexported fields that cannot be set (these are skipped silently).
+// - A struct tag is malformed.
+// - Two fields resolve to the same value, whether tagged, pre-set, or auto-assigned.
+//
+// Unexported fields cannot be set and are skipped silently. Use TryMake to receive
+// these conditions as errors instead.
 //
 // Warning: This function uses reflection, which is less performant and lacks the
 // compile-time type safety of Go’s const/iota or the Generator type. Use it for
@@ -67,17 +98,34 @@ type Maker[T any, E TypesMake] struct {
 //	type Status struct {
 //	    Pending int
 //	    Active  int
-//	    Done    int
+//	    Done    int `enum:"value=10"`
 //	}
 //	var s Status
 //	m := Make[Status, int](&s)
 //	fmt.Println(s.Pending)     // Output: 0
 //	fmt.Println(m.Name(1))     // Output: Active, true
-//	fmt.Println(m.Get("Done")) // Output: 2, true
+//	fmt.Println(m.Get("Done")) // Output: 10, true
 func Make[T any, E TypesMake](construct *T) *Maker[T, E] {
+	m, err := TryMake[T, E](construct)
+	if err != nil {
+		panic(makerPanic[T](err))
+	}
+	return m
+}
+
+// makerPanic wraps err for the panics of Make and its variants, naming the struct type
+// that defines the enum, e.g. `enum.Make: ... (in enum main.Colors)`. Recovering callers
+// can inspect the cause with errors.Is and errors.As.
+func makerPanic[T any](err error) error {
+	var zero T
+	return fmt.Errorf("%w (in enum %T)", err, zero)
+}
+
+// TryMake is like Make but returns an error instead of panicking.
+func TryMake[T any, E TypesMake](construct *T) (*Maker[T, E], error) {
 	val := reflect.ValueOf(construct)
-	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
-		panic("enum.Make: construct must be a pointer to a struct")
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("enum.Make: construct must be a pointer to a struct")
 	}
 
 	elem := val.Elem()
@@ -98,12 +146,51 @@ func Make[T any, E TypesMake](construct *T) *Maker[T, E] {
 	}
 
 	if capacity > 0 && uint64(n) >= capacity {
-		panic(fmt.Sprintf("enum.Make: number of struct fields (%d) exceeds the capacity of the underlying enum type %s", n, typeE.Name()))
+		return nil, fmt.Errorf("enum.Make: number of struct fields (%d) exceeds the capacity of the underlying enum type %s", n, typeE.Name())
 	}
 
 	maxFields := 1 << (typeE.Bits() - 1)
 	if n >= maxFields && maxFields > 0 {
-		panic(fmt.Sprintf("enum.Make: number of struct fields (%d) exceeds the capacity of the underlying enum type %s", n, typeE.Name()))
+		return nil, fmt.Errorf("enum.Make: number of struct fields (%d) exceeds the capacity of the underlying enum type %s", n, typeE.Name())
+	}
+
+	values := make([]E, n)
+	explicit := make([]bool, n)
+	tags := make([]makerTag, n)
+	owner := make(map[E]string, n) // Maps claimed values to the field that claimed them.
+
+	// First pass: resolve tagged and pre-set values so auto-assigned fields
+	// can be checked against them.
+	for i := 0; i < n; i++ {
+		field := rc.Field(i)
+		fieldVal := elem.Field(i)
+		if !fieldVal.CanSet() {
+			continue // Skip unexported fields
+		}
+
+		tag, err := parseMakerTag(field)
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = tag
+
+		switch {
+		case tag.hasValue:
+			value, err := parseStringToValue[E](tag.value)
+			if err != nil {
+				return nil, fmt.Errorf("enum.Make: field %q: invalid value %q: %w", field.Name, tag.value, err)
+			}
+			values[i], explicit[i] = value, true
+		case fieldVal.CanConvert(typeE) && !fieldVal.IsZero():
+			values[i], explicit[i] = fieldVal.Convert(typeE).Interface().(E), true
+		default:
+			continue
+		}
+
+		if other, ok := owner[values[i]]; ok {
+			return nil, fmt.Errorf("enum.Make: fields %q and %q both have value %v", other, field.Name, values[i])
+		}
+		owner[values[i]] = field.Name
 	}
 
 	valueMap := make(map[E]string, n)
@@ -113,12 +200,18 @@ func Make[T any, E TypesMake](construct *T) *Maker[T, E] {
 	for i := 0; i < n; i++ {
 		field := rc.Field(i)
 		fieldVal := elem.Field(i)
-
 		if !fieldVal.CanSet() {
 			continue // Skip unexported fields
 		}
 
-		value := E(i)
+		value := values[i]
+		if !explicit[i] {
+			value = E(i)
+			if other, ok := owner[value]; ok {
+				return nil, fmt.Errorf("enum.Make: fields %q and %q both have value %v", other, field.Name, value)
+			}
+			owner[value] = field.Name
+		}
 		fieldVal.Set(reflect.ValueOf(value).Convert(field.Type))
 
 		valueMap[value] = field.Name
@@ -126,12 +219,113 @@ func Make[T any, E TypesMake](construct *T) *Maker[T, E] {
 		entries = append(entries, NewValue(value, field.Name))
 	}
 
-	return &Maker[T, E]{
+	m := &Maker[T, E]{
+		kind:     kindOf[E](),
 		instance: construct,
 		valueMap: valueMap,
 		nameMap:  nameMap,
 		entries:  entries,
 	}
+	aliasOwner := make(map[string]string)
+	for i, tag := range tags {
+		name := rc.Field(i).Name
+		for _, alias := range tag.aliases {
+			if _, exists := nameMap[alias]; exists {
+				return nil, fmt.Errorf("enum.Make: field %q: alias %q is already a field name", name, alias)
+			}
+			if other, exists := aliasOwner[alias]; exists {
+				return nil, fmt.Errorf("enum.Make: field %q: alias %q is already used by field %q", name, alias, other)
+			}
+			aliasOwner[alias] = name
+			if m.aliases == nil {
+				m.aliases = make(map[string]E)
+			}
+			m.aliases[alias] = nameMap[name]
+		}
+		if tag.hasDesc {
+			if m.descriptions == nil {
+				m.descriptions = make(map[E]string)
+			}
+			m.descriptions[nameMap[name]] = tag.desc
+		}
+	}
+	return m, nil
+}
+
+// makerTag holds the parsed `enum` struct tag of a field.
+type makerTag struct {
+	value    string // Explicit value literal, valid if hasValue is set.
+	hasValue bool
+	aliases  []string // Alternate names from "alias".
+	desc     string   // Description from "desc", valid if hasDesc is set.
+	hasDesc  bool
+}
+
+// parseMakerTag parses the `enum` struct tag of field: a comma-separated list of
+// key=value pairs with keys "value", "alias" ("|"-separated names), and "desc". A
+// backslash escapes a comma or a backslash in a value.
+func parseMakerTag(field reflect.StructField) (makerTag, error) {
+	var tag makerTag
+	raw, ok := field.Tag.Lookup("enum")
+	if !ok || raw == "" {
+		return tag, nil
+	}
+	fail := func(format string, args ...any) (makerTag, error) {
+		return makerTag{}, fmt.Errorf("enum.Make: field %q: "+format, append([]any{field.Name}, args...)...)
+	}
+	parts, err := splitTag(raw)
+	if err != nil {
+		return fail("malformed tag %q: %v", raw, err)
+	}
+	seen := make(map[string]bool, len(parts))
+	for _, part := range parts {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found || key == "" {
+			return fail("malformed tag entry %q, expected key=value", part)
+		}
+		if seen[key] {
+			return fail("duplicate tag key %q", key)
+		}
+		seen[key] = true
+		switch key {
+		case "value":
+			tag.value, tag.hasValue = value, true
+		case "alias":
+			for _, alias := range strings.Split(value, "|") {
+				if alias = strings.TrimSpace(alias); alias == "" {
+					return fail("empty alias in %q", value)
+				}
+				tag.aliases = append(tag.aliases, alias)
+			}
+		case "desc":
+			tag.desc, tag.hasDesc = value, true
+		default:
+			return fail("unknown tag key %q", key)
+		}
+	}
+	return tag, nil
+}
+
+// splitTag splits a tag at unescaped commas, resolving the escapes `\,` and `\\`.
+func splitTag(raw string) ([]string, error) {
+	var parts []string
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		switch c := raw[i]; c {
+		case '\\':
+			if i+1 == len(raw) || (raw[i+1] != ',' && raw[i+1] != '\\') {
+				return nil, errors.New(`"\" must be followed by "," or "\"`)
+			}
+			i++
+			b.WriteByte(raw[i])
+		case ',':
+			parts = append(parts, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(parts, b.String()), nil
 }
 
 // MakeManual creates a Maker instance without reflection by using a user-provided
@@ -150,66 +344,105 @@ func Make[T any, E TypesMake](construct *T) *Maker[T, E] {
 //	    return &c
 //	})
 func MakeManual[T any, E TypesMake](construct *T, init func(*Generator[E]) *T) *Maker[T, E] {
+	m, err := TryMakeManual(construct, init)
+	if err != nil {
+		panic(makerPanic[T](err))
+	}
+	return m
+}
+
+// TryMakeManual is like MakeManual but returns an error instead of panicking. The
+// Generator passed to init is in error mode (see WithErrorMode), so failures of Next
+// and other operations in init are returned as well.
+func TryMakeManual[T any, E TypesMake](construct *T, init func(*Generator[E]) *T) (*Maker[T, E], error) {
 	if construct == nil {
-		panic("enum.MakeManual: construct must not be nil")
+		return nil, errors.New("enum.MakeManual: construct must not be nil")
 	}
 
-	// Create a Generator with default settings
-	g := NewGenerator[E]()
+	// Create a Generator with default settings, collecting errors raised in init.
+	g := NewGenerator[E](WithErrorMode[E]())
 
 	// Initialize struct using user-provided function
 	result := init(g)
 	if result != construct {
-		panic("enum.MakeManual: init function must return the same struct pointer as construct")
+		return nil, errors.New("enum.MakeManual: init function must return the same struct pointer as construct")
+	}
+	if err := g.Err(); err != nil {
+		return nil, fmt.Errorf("enum.MakeManual: %w", err)
 	}
 
 	return &Maker[T, E]{
+		kind:     kindOf[E](),
 		instance: construct,
 		valueMap: g.ValueMap(),
 		nameMap:  g.NameMap(),
 		entries:  g.Values(),
-	}
+	}, nil
 }
 
-// MakeManualWithBasic creates a Maker instance without reflection by using a user-provided
-// initialization function to populate the struct with Basic values. The init function should
-// use the provided Basic to create enum values and set them on the struct. The resulting
-// Maker maintains value-to-name and name-to-value mappings consistent with the Basic's state.
+// MakeManualWithRegistry creates a Maker instance without reflection by using a
+// user-provided initialization function to populate the struct with Basic values. The
+// init function should use the provided BasicRegistry to create enum values and set them
+// on the struct. The resulting Maker maintains value-to-name and name-to-value mappings
+// consistent with the registry's state.
 //
 // Example:
 //
 //	type Colors struct{ Red, Blue Basic }
 //	var c Colors
-//	b := NewBasic()
-//	m := MakeManualWithBasic(&c, b, func(b *Basic) *Colors {
+//	b := NewBasicRegistry()
+//	m := MakeManualWithRegistry(&c, b, func(b *BasicRegistry) *Colors {
 //	    c.Red = b.Add("Red")
 //	    c.Blue = b.Add("Blue")
 //	    return &c
 //	})
+func MakeManualWithRegistry[T any](construct *T, b *BasicRegistry, init func(*BasicRegistry) *T) *Maker[T, int] {
+	m, err := TryMakeManualWithRegistry(construct, b, init)
+	if err != nil {
+		panic(makerPanic[T](err))
+	}
+	return m
+}
+
+// MakeManualWithBasic is like MakeManualWithRegistry for the *Basic returned by NewBasic.
+//
+// Deprecated: Use MakeManualWithRegistry with a registry from NewBasicRegistry.
 func MakeManualWithBasic[T any](construct *T, b *Basic, init func(*Basic) *T) *Maker[T, int] {
+	var r *BasicRegistry
+	if b != nil && b.meta != nil {
+		r = &BasicRegistry{meta: b.meta}
+	}
+	return MakeManualWithRegistry(construct, r, func(*BasicRegistry) *T { return init(b) })
+}
+
+// TryMakeManualWithRegistry is like MakeManualWithRegistry but returns an error instead
+// of panicking. Failures inside init follow b's mode: create b with WithErrorMode and
+// check its Err to avoid panics there.
+func TryMakeManualWithRegistry[T any](construct *T, b *BasicRegistry, init func(*BasicRegistry) *T) (*Maker[T, int], error) {
 	if construct == nil {
-		panic("enum.MakeManualWithBasic: construct must not be nil")
+		return nil, errors.New("enum.MakeManualWithRegistry: construct must not be nil")
 	}
 	if b == nil {
-		panic("enum.MakeManualWithBasic: Basic instance must not be nil")
+		return nil, errors.New("enum.MakeManualWithRegistry: BasicRegistry must not be nil")
 	}
 
 	// Initialize the user's struct using their provided function.
 	// This populates the internal state of the Generator within 'b'.
 	result := init(b)
 	if result != construct {
-		panic("enum.MakeManualWithBasic: init function must return the same struct pointer as construct")
+		return nil, errors.New("enum.MakeManualWithRegistry: init function must return the same struct pointer as construct")
 	}
 
 	// Create the Maker by using the public, thread-safe methods of the
 	// underlying Generator. This is safer and cleaner than accessing
 	// internal fields directly.
 	return &Maker[T, int]{
+		kind:     kindOf[int](),
 		instance: construct,
 		valueMap: b.meta.ValueMap(),
 		nameMap:  b.meta.NameMap(),
 		entries:  b.meta.Values(),
-	}
+	}, nil
 }
 
 // Struct returns the pointer to the populated struct instance.
@@ -269,7 +502,7 @@ func (e *Maker[T, E]) Data() map[E]string {
 func (e *Maker[T, E]) Names() []string {
 	names := make([]string, 0, len(e.entries))
 	for _, entry := range e.entries {
-		names = append(names, entry.String())
+		names = append(names, entry.name)
 	}
 	return names
 }
@@ -284,6 +517,48 @@ func (e *Maker[T, E]) Entries() []Value[E] {
 	return e.entries
 }
 
+// NamesSorted returns all enum field names in alphabetical order, leaving the
+// definition order of Names and Entries untouched.
+//
+// Example:
+//
+//	m := Make[Colors, int](&Colors{})
+//	names := m.NamesSorted() // Returns ["Blue", "Red"]
+func (e *Maker[T, E]) NamesSorted() []string {
+	names := e.Names()
+	sort.Strings(names)
+	return names
+}
+
+// ValuesSorted returns a copy of the entries ordered by ascending value.
+//
+// Example:
+//
+//	m := Make[Status, int](&Status{}) // Pending 0, Done 10, Active 2
+//	entries := m.ValuesSorted()       // Returns [{0 Pending}, {2 Active}, {10 Done}]
+func (e *Maker[T, E]) ValuesSorted() []Value[E] {
+	return e.EntriesSortedBy(func(a, b Value[E]) bool {
+		return a.value < b.value
+	})
+}
+
+// EntriesSortedBy returns a copy of the entries ordered by less, which reports whether
+// a sorts before b. The sort is stable, so entries comparing equal keep definition order.
+//
+// Example:
+//
+//	byLength := m.EntriesSortedBy(func(a, b Value[int]) bool {
+//	    return len(a.String()) < len(b.String())
+//	})
+func (e *Maker[T, E]) EntriesSortedBy(less func(a, b Value[E]) bool) []Value[E] {
+	entries := make([]Value[E], len(e.entries))
+	copy(entries, e.entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return less(entries[i], entries[j])
+	})
+	return entries
+}
+
 // Contains checks if a value exists in the enum set.
 //
 // Example:
@@ -308,6 +583,140 @@ func (e *Maker[T, E]) ContainsName(name string) bool {
 	return ok
 }
 
+// Parse resolves a field name, an alias from an `enum:"alias=..."` tag, or a value
+// literal to the matching entry, returned under its field name.
+// Returns an error wrapping ErrUnknownValue if nothing matches.
+//
+// Example:
+//
+//	type Visibility struct {
+//	    Hidden int
+//	    Live   int `enum:"alias=LIVE|ONLINE"`
+//	}
+//	m := Make[Visibility, int](&Visibility{})
+//	v, _ := m.Parse("ONLINE") // Returns Value[int]{value: 1, name: "Live"}
+func (e *Maker[T, E]) Parse(s string) (Value[E], error) {
+	if val, ok := e.nameMap[s]; ok {
+		return NewValue(val, s), nil
+	}
+	if val, ok := e.aliases[s]; ok {
+		return NewValue(val, e.valueMap[val]), nil
+	}
+	if parsedVal, err := parseStringToValue[E](s); err == nil {
+		if name, ok := e.valueMap[parsedVal]; ok {
+			return NewValue(parsedVal, name), nil
+		}
+	}
+	return Value[E]{}, fmt.Errorf("%w: %q", ErrUnknownValue, s)
+}
+
+// Validate checks that value is one of the enum's values. Returns an error wrapping
+// ErrUnknownValue if it is not.
+//
+// Example:
+//
+//	m := Make[Colors, int](&Colors{})
+//	err := m.Validate(99) // invalid enum value: 99
+func (e *Maker[T, E]) Validate(value E) error {
+	if _, ok := e.valueMap[value]; !ok {
+		return fmt.Errorf("%w: %v", ErrUnknownValue, value)
+	}
+	return nil
+}
+
+// MakerMethods holds helper functions bound to a Maker, returned by Maker.Methods. It is
+// meant to be stored in a package-level variable next to the struct, so call sites
+// read like methods of the enum: Statuses.String(Status.Active).
+type MakerMethods[E TypesMake] struct {
+	// String returns the field name of value, or the value as a number if it is
+	// not one of the enum's values.
+	String func(value E) string
+	// IsValid reports whether value is one of the enum's values (see Maker.Validate).
+	IsValid func(value E) bool
+	// Parse resolves a field name, alias, or value literal to its value (see Maker.Parse).
+	Parse func(s string) (E, error)
+}
+
+// Methods returns helper functions bound to the Maker. They share the lookups of
+// Maker.Name, Maker.Validate, and Maker.Parse, including aliases.
+//
+// Example:
+//
+//	type StatusEnum struct{ Pending, Active, Done int }
+//	var Status StatusEnum
+//	var Statuses = Make[StatusEnum, int](&Status).Methods()
+//
+//	fmt.Println(Statuses.String(Status.Active)) // Output: Active
+//	v, err := Statuses.Parse("Done")            // v == Status.Done
+func (e *Maker[T, E]) Methods() MakerMethods[E] {
+	return MakerMethods[E]{
+		String: func(value E) string {
+			if name, ok := e.Name(value); ok {
+				return name
+			}
+			return formatKey(value)
+		},
+		IsValid: func(value E) bool {
+			return e.Validate(value) == nil
+		},
+		Parse: func(s string) (E, error) {
+			v, err := e.Parse(s)
+			return v.Get(), err
+		},
+	}
+}
+
+// Aliases returns the aliases declared for the field name with an `enum:"alias=..."`
+// tag, sorted alphabetically.
+func (e *Maker[T, E]) Aliases(name string) []string {
+	val, ok := e.nameMap[name]
+	if !ok {
+		return nil
+	}
+	var out []string
+	for alias, v := range e.aliases {
+		if v == val {
+			out = append(out, alias)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Description returns the description declared for value with an `enum:"desc=..."` tag.
+func (e *Maker[T, E]) Description(value E) (string, bool) {
+	desc, ok := e.descriptions[value]
+	return desc, ok
+}
+
+// Catalog is like Generator.Catalog: one CatalogEntry per field, in field order, with
+// Description taken from `enum:"desc=..."` tags and falling back to the field name.
+// CatalogLocale and CatalogForVersion have no effect, as Makers hold neither display
+// names nor version ranges.
+func (e *Maker[T, E]) Catalog(opts ...CatalogOption) []CatalogEntry[E] {
+	var cfg catalogConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	out := make([]CatalogEntry[E], 0, len(e.entries))
+	for _, entry := range e.entries {
+		ce := CatalogEntry[E]{
+			ID:          entry.value,
+			Code:        entry.name,
+			Label:       entry.name,
+			Description: entry.name,
+		}
+		if cfg.code != nil {
+			ce.Code = cfg.code(entry.name)
+		}
+		if desc, ok := e.descriptions[entry.value]; ok {
+			ce.Description = desc
+		}
+		out = append(out, ce)
+	}
+	return out
+}
+
 // ValueMap returns the map of enum values to their field names.
 //
 // Example:
@@ -333,8 +742,39 @@ func (e *Maker[T, E]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(e.valueMap)
 }
 
-// UnmarshalJSON implements json.Unmarshaler, deserializing a JSON object into the
-// value-to-name map. It updates valueMap but does not modify the struct instance or
+// MarshalOrderedJSON serializes the Maker as an array of value/name objects in struct
+// field order, e.g. [{"value":0,"name":"Red"},{"value":1,"name":"Blue"}]. Unlike the
+// object form of MarshalJSON, whose keys encoding/json sorts, it preserves the order
+// in which the fields are declared. UnmarshalJSON accepts both forms.
+//
+// Example:
+//
+//	m := Make[Colors, int](&Colors{})
+//	b, _ := m.MarshalOrderedJSON() // [{"value":0,"name":"Red"},{"value":1,"name":"Blue"}]
+func (e *Maker[T, E]) MarshalOrderedJSON() ([]byte, error) {
+	pairs := make([]Pair[E], 0, len(e.entries))
+	for _, entry := range e.entries {
+		pairs = append(pairs, Pair[E]{Value: entry.value, Name: entry.name})
+	}
+	return json.Marshal(pairs)
+}
+
+// catalogEntries lists the Maker's entries for the Handler in struct field order.
+func (e *Maker[T, E]) catalogEntries() ([]catalogEntryJSON, error) {
+	out := make([]catalogEntryJSON, len(e.entries))
+	for i, entry := range e.entries {
+		raw, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = catalogEntryJSON{Value: raw, Name: entry.name}
+	}
+	return out, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, deserializing a JSON object (as written by
+// MarshalJSON) or an array of value/name objects (as written by MarshalOrderedJSON) into
+// the value-to-name map. It updates valueMap but does not modify the struct instance or
 // entries, as the struct’s fields are set during Make and cannot be safely updated
 // via reflection after initialization.
 //
@@ -347,8 +787,8 @@ func (e *Maker[T, E]) UnmarshalJSON(data []byte) error {
 	}
 
 	// Deserialize into temporary map
-	var tempMap map[E]string
-	if err := json.Unmarshal(data, &tempMap); err != nil {
+	tempMap, err := decodeMakerJSON[E](data)
+	if err != nil {
 		return fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
 
@@ -392,3 +832,119 @@ func (e *Maker[T, E]) UnmarshalJSON(data []byte) error {
 	e.entries = tempEntries
 	return nil
 }
+
+// decodeMakerJSON decodes the object or array form of a Maker into a value-to-name map.
+// A value repeated in the array form is an error.
+func decodeMakerJSON[E TypesValue](data []byte) (map[E]string, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		var m map[E]string
+		if err := json.Unmarshal(trimmed, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+	var pairs []Pair[E]
+	if err := json.Unmarshal(trimmed, &pairs); err != nil {
+		return nil, err
+	}
+	m := make(map[E]string, len(pairs))
+	for _, pair := range pairs {
+		if existing, ok := m[pair.Value]; ok {
+			return nil, fmt.Errorf("duplicate value %v for names %q and %q", pair.Value, existing, pair.Name)
+		}
+		m[pair.Value] = pair.Name
+	}
+	return m, nil
+}
+
+// CheckConsistency verifies the Maker's internal invariants: every entry has a unique
+// name and value, and the value-to-name and name-to-value maps agree with the entries.
+// It is intended for tests and fuzzing.
+//
+// Returns nil if the Maker is consistent, or an error describing the first violation.
+func (e *Maker[T, E]) CheckConsistency() error {
+	return checkConsistency(e.entries, e.valueMap, e.nameMap, true)
+}
+
+// EntriesAs returns the entries of a Maker as a slice of Entry[E].
+//
+// Example:
+//
+//	m := Make[Colors, int](&Colors{})
+//	entries := EntriesAs(m) // []Entry[int]{Value{0, "Red"}, Value{1, "Blue"}}
+func EntriesAs[T any, E TypesMake](m *Maker[T, E]) []Entry[E] {
+	return ToEntries(m.Entries())
+}
+
+// CanonicalBytes returns a deterministic binary serialization of the Maker's
+// entries in field order, using the same layout as Generator.CanonicalBytes.
+func (e *Maker[T, E]) CanonicalBytes() []byte {
+	return canonicalBytes(e.entries)
+}
+
+// isNil implements nilable.
+func (e *Maker[T, E]) isNil() bool { return e == nil }
+
+// Fingerprint returns the hex-encoded SHA-256 of CanonicalBytes.
+func (e *Maker[T, E]) Fingerprint() string {
+	return fingerprint(e.CanonicalBytes())
+}
+
+// Kind implements Registry, reporting the kind of the Maker's value type E.
+func (e *Maker[T, E]) Kind() ValueKind {
+	return e.kind
+}
+
+// Version is like Generator.Version, so that consumers such as PGEnum can cache data
+// derived from the Maker. The entries of a Maker are fixed by its struct (UnmarshalJSON
+// only accepts the same ones), so Version always returns 0.
+func (e *Maker[T, E]) Version() uint64 {
+	return 0
+}
+
+// NameOfAny implements Registry, returning the field name for a value of type E
+// or an Entry[E].
+func (e *Maker[T, E]) NameOfAny(value any) (string, bool) {
+	v, ok := underlyingOf[E](value)
+	if !ok {
+		return "", false
+	}
+	return e.Name(v)
+}
+
+// ParseAny implements Registry, parsing a field name, alias, or integer literal
+// and returning the matching Value[E] (see Maker.Parse).
+func (e *Maker[T, E]) ParseAny(s string) (any, error) {
+	v, err := e.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ValuesReversed returns a copy of the entries in reverse definition order.
+//
+// Example:
+//
+//	m := Make[Colors, int](&Colors{})
+//	entries := m.ValuesReversed() // Returns [{1 Blue}, {0 Red}]
+func (e *Maker[T, E]) ValuesReversed() []Value[E] {
+	out := make([]Value[E], len(e.entries))
+	for i, v := range e.entries {
+		out[len(out)-1-i] = v
+	}
+	return out
+}
+
+// Backward returns an iterator over the entries in reverse definition order, without
+// copying them. Like Generator.Backward, it is an iter.Seq[Value[E]].
+func (e *Maker[T, E]) Backward() func(yield func(Value[E]) bool) {
+	return func(yield func(Value[E]) bool) {
+		for i := len(e.entries) - 1; i >= 0; i-- {
+			if !yield(e.entries[i]) {
+				return
+			}
+		}
+	}
+}
@@ -1,9 +1,17 @@
+//go:build !noreflect
+
 // maker_test.go
 package enum
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -73,12 +81,14 @@ func TestMaker(t *testing.T) {
 	})
 
 	t.Run("Panic on Non-Struct Pointer", func(t *testing.T) {
+		var i int
+		_, want := TryMake[int, int](&i)
 		defer func() {
-			if r := recover(); r == nil {
-				t.Error("Expected panic for non-struct pointer")
+			err, ok := recover().(error)
+			if !ok || errors.Unwrap(err).Error() != want.Error() {
+				t.Errorf("Expected panic wrapping %v, got %v", want, err)
 			}
 		}()
-		var i int
 		Make[int, int](&i)
 	})
 
@@ -187,6 +197,46 @@ func TestMaker_JSON_Marshal_and_Unmarshal(t *testing.T) {
 	}
 }
 
+func TestMaker_MarshalOrderedJSON(t *testing.T) {
+	// Twelve fields, so the sorted keys of MarshalJSON ("0", "1", "10", ...) differ from
+	// field order.
+	type Months struct{ Jan, Feb, Mar, Apr, May, Jun, Jul, Aug, Sep, Oct, Nov, Dec int }
+	m := Make[Months, int](&Months{})
+	data, err := m.MarshalOrderedJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "maker_ordered.json.golden", append(data, '\n'))
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		m2 := Make[Months, int](&Months{})
+		if err := m2.UnmarshalJSON(data); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(m2.ValueMap(), m.ValueMap()) {
+			t.Errorf("expected valueMap %v, got %v", m.ValueMap(), m2.ValueMap())
+		}
+		if got, want := m2.Names(), m.Names(); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected names %v, got %v", want, got)
+		}
+	})
+
+	t.Run("RejectsMismatch", func(t *testing.T) {
+		type Colors struct{ Red, Blue int }
+		m := Make[Colors, int](&Colors{})
+		for _, input := range []string{
+			`[{"value":0,"name":"Red"}]`,
+			`[{"value":0,"name":"Red"},{"value":1,"name":"Green"}]`,
+			`[{"value":0,"name":"Red"},{"value":1,"name":"Blue"},{"value":2,"name":"Green"}]`,
+			`[{"value":0,"name":"Red"},{"value":0,"name":"Blue"}]`,
+		} {
+			if err := m.UnmarshalJSON([]byte(input)); err == nil {
+				t.Errorf("expected error for %s", input)
+			}
+		}
+	})
+}
+
 func TestMakeManualWithBasic_JSON(t *testing.T) {
 	type Colors struct{ Red, Blue Basic }
 	var c Colors
@@ -224,3 +274,459 @@ func TestMakeManualWithBasic_JSON(t *testing.T) {
 		t.Errorf("expected valueMap %v, got %v", m.ValueMap(), m2.ValueMap())
 	}
 }
+
+func TestMakeManualWithRegistry(t *testing.T) {
+	type Colors struct{ Red, Blue Basic }
+	var c Colors
+	r := NewBasicRegistry()
+	m := MakeManualWithRegistry(&c, r, func(b *BasicRegistry) *Colors {
+		c.Red = b.Add("Red")
+		c.Blue = b.Add("Blue")
+		return &c
+	})
+	if name, ok := m.Name(1); !ok || name != "Blue" || c.Blue.Registry().meta != r.meta {
+		t.Errorf("Expected Blue from the registry, got %q, %v", name, ok)
+	}
+	if _, err := TryMakeManualWithRegistry(&c, nil, func(*BasicRegistry) *Colors { return &c }); err == nil {
+		t.Error("Expected an error for a nil registry")
+	}
+}
+
+func TestMaker_ExplicitValues(t *testing.T) {
+	t.Run("Tagged and pre-set", func(t *testing.T) {
+		type Status struct {
+			Pending int
+			Active  int `enum:"value=10"`
+			Closed  int
+		}
+		s := Status{Closed: 20}
+		m := Make[Status, int](&s)
+		if s.Pending != 0 || s.Active != 10 || s.Closed != 20 {
+			t.Errorf("Expected {0 10 20}, got %+v", s)
+		}
+		if name, ok := m.Name(10); !ok || name != "Active" {
+			t.Errorf("Expected Active for 10, got %q", name)
+		}
+		if err := m.CheckConsistency(); err != nil {
+			t.Errorf("CheckConsistency failed: %v", err)
+		}
+	})
+
+	t.Run("Tagged collision", func(t *testing.T) {
+		type Status struct {
+			Pending int `enum:"value=5"`
+			Active  int `enum:"value=5"`
+		}
+		_, err := TryMake[Status, int](&Status{})
+		if err == nil || !strings.Contains(err.Error(), `"Pending"`) || !strings.Contains(err.Error(), `"Active"`) {
+			t.Errorf("Expected error naming both fields, got %v", err)
+		}
+	})
+
+	t.Run("Pre-set collides with tag", func(t *testing.T) {
+		type Status struct {
+			Pending int `enum:"value=3"`
+			Active  int
+		}
+		if _, err := TryMake[Status, int](&Status{Active: 3}); err == nil {
+			t.Error("Expected collision between tagged and pre-set fields")
+		}
+	})
+
+	t.Run("Auto collides with tag", func(t *testing.T) {
+		type Status struct {
+			Pending int `enum:"value=1"`
+			Active  int
+		}
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !strings.Contains(err.Error(), `"Pending" and "Active"`) {
+				t.Errorf("Expected panic naming both fields, got %v", r)
+			}
+		}()
+		Make[Status, int](&Status{})
+	})
+
+	t.Run("Malformed tags", func(t *testing.T) {
+		type NoEquals struct {
+			A int `enum:"value"`
+		}
+		type Unknown struct {
+			A int `enum:"color=red"`
+		}
+		type BadValue struct {
+			A int8 `enum:"value=300"`
+		}
+		if _, err := TryMake[NoEquals, int](&NoEquals{}); err == nil {
+			t.Error("Expected error for tag without '='")
+		}
+		if _, err := TryMake[Unknown, int](&Unknown{}); err == nil {
+			t.Error("Expected error for unknown tag key")
+		}
+		if _, err := TryMake[BadValue, int8](&BadValue{}); err == nil || !strings.Contains(err.Error(), `"A"`) {
+			t.Errorf("Expected error naming field A, got %v", err)
+		}
+	})
+
+	t.Run("TryMake non-struct", func(t *testing.T) {
+		var i int
+		if _, err := TryMake[int, int](&i); err == nil {
+			t.Error("Expected error for non-struct pointer")
+		}
+	})
+}
+
+func TestMaker_CheckConsistency(t *testing.T) {
+	type Colors struct{ Red, Blue int }
+	m := Make[Colors, int](&Colors{})
+	if err := m.CheckConsistency(); err != nil {
+		t.Fatalf("CheckConsistency failed: %v", err)
+	}
+	m.valueMap[1] = "Red"
+	if err := m.CheckConsistency(); err == nil {
+		t.Error("Expected inconsistency after corrupting valueMap")
+	}
+}
+
+func TestMaker_Sorted(t *testing.T) {
+	type Status struct {
+		Pending int
+		Done    int `enum:"value=10"`
+		Active  int
+		Blocked int
+	}
+	m := Make[Status, int](&Status{})
+
+	if got, want := m.NamesSorted(), []string{"Active", "Blocked", "Done", "Pending"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	var values []int
+	for _, v := range m.ValuesSorted() {
+		values = append(values, v.Get())
+	}
+	if want := []int{0, 2, 3, 10}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Expected %v, got %v", want, values)
+	}
+	byLength := m.EntriesSortedBy(func(a, b Value[int]) bool { return len(a.String()) < len(b.String()) })
+	var names []string
+	for _, v := range byLength {
+		names = append(names, v.String())
+	}
+	if want := []string{"Done", "Active", "Pending", "Blocked"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected stable order %v, got %v", want, names)
+	}
+	if got, want := m.Names(), []string{"Pending", "Done", "Active", "Blocked"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected definition order to be unchanged, got %v", got)
+	}
+}
+
+func TestMaker_AliasAndDescTags(t *testing.T) {
+	type Visibility struct {
+		Hidden int `enum:"desc=Not shown"`
+		Live   int `enum:"alias=LIVE|ONLINE,desc=Item is visible\\, and searchable"`
+		Draft  int `enum:"value=9, alias=WIP"`
+	}
+	var v Visibility
+	m := Make[Visibility, int](&v)
+
+	t.Run("Parse by alias", func(t *testing.T) {
+		for in, want := range map[string]string{"ONLINE": "Live", "LIVE": "Live", "WIP": "Draft", "Hidden": "Hidden", "9": "Draft"} {
+			got, err := m.Parse(in)
+			if err != nil || got.String() != want {
+				t.Errorf("Parse(%q): expected %s, got %v, err: %v", in, want, got, err)
+			}
+		}
+		if _, err := m.Parse("OFFLINE"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+		if got, err := m.ParseAny("ONLINE"); err != nil || got.(Value[int]).Get() != 1 {
+			t.Errorf("Expected ParseAny to accept aliases, got %v, err: %v", got, err)
+		}
+		if got := m.Aliases("Live"); !reflect.DeepEqual(got, []string{"LIVE", "ONLINE"}) {
+			t.Errorf("Expected sorted aliases, got %v", got)
+		}
+	})
+
+	t.Run("Descriptions", func(t *testing.T) {
+		if desc, ok := m.Description(1); !ok || desc != "Item is visible, and searchable" {
+			t.Errorf("Expected escaped comma in description, got %q", desc)
+		}
+		catalog := m.Catalog(CatalogCode(UpperSnake))
+		want := []CatalogEntry[int]{
+			{ID: 0, Code: "HIDDEN", Label: "Hidden", Description: "Not shown"},
+			{ID: 1, Code: "LIVE", Label: "Live", Description: "Item is visible, and searchable"},
+			{ID: 9, Code: "DRAFT", Label: "Draft", Description: "Draft"},
+		}
+		if !reflect.DeepEqual(catalog, want) {
+			t.Errorf("Expected %+v, got %+v", want, catalog)
+		}
+	})
+}
+
+func TestParseMakerTag(t *testing.T) {
+	field := func(tag string) reflect.StructField {
+		return reflect.StructField{Name: "Live", Tag: reflect.StructTag(tag)}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		tag, err := parseMakerTag(field(`enum:"alias= A | B ,desc=a\\\\b\\,c,value=3"`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tag.aliases, []string{"A", "B"}) || tag.desc != `a\b,c` || tag.value != "3" {
+			t.Errorf("Unexpected tag %+v", tag)
+		}
+	})
+
+	malformed := map[string]string{
+		`enum:"alias"`:              "expected key=value",
+		`enum:"desc=a,desc=b"`:      "duplicate tag key",
+		`enum:"alias=A||B"`:         "empty alias",
+		`enum:"color=red"`:          "unknown tag key",
+		`enum:"desc=trailing\\"`:    "malformed tag",
+		`enum:"desc=bad\\escape"`:   "malformed tag",
+		`enum:"=3"`:                 "expected key=value",
+		`enum:"value=1,,desc=skip"`: "expected key=value",
+	}
+	for tag, want := range malformed {
+		t.Run(tag, func(t *testing.T) {
+			_, err := parseMakerTag(field(tag))
+			if err == nil || !strings.Contains(err.Error(), want) || !strings.Contains(err.Error(), `field "Live"`) {
+				t.Errorf("Expected error mentioning %q and the field, got %v", want, err)
+			}
+		})
+	}
+
+	t.Run("Alias conflicts", func(t *testing.T) {
+		type NameClash struct {
+			Hidden int
+			Live   int `enum:"alias=Hidden"`
+		}
+		if _, err := TryMake[NameClash, int](&NameClash{}); err == nil || !strings.Contains(err.Error(), `field "Live"`) {
+			t.Errorf("Expected error naming the field, got %v", err)
+		}
+		type AliasClash struct {
+			Hidden int `enum:"alias=OFF"`
+			Live   int `enum:"alias=OFF"`
+		}
+		defer func() {
+			err, _ := recover().(error)
+			if err == nil {
+				t.Fatal("Expected panic with an error")
+			}
+			msg := err.Error()
+			if !strings.Contains(msg, `"OFF" is already used by field "Hidden"`) {
+				t.Errorf("Expected panic naming both fields, got %q", msg)
+			}
+			if !strings.HasSuffix(msg, "(in enum enum.AliasClash)") {
+				t.Errorf("Expected panic naming the struct type, got %q", msg)
+			}
+		}()
+		Make[AliasClash, int](&AliasClash{})
+	})
+}
+
+// orderStatus and OrderStatuses show the package-level pattern Maker.Methods is meant for.
+type orderStatusEnum struct {
+	Pending int
+	Active  int `enum:"alias=LIVE"`
+	Done    int `enum:"value=10"`
+}
+
+var (
+	orderStatus   orderStatusEnum
+	OrderStatuses = Make[orderStatusEnum, int](&orderStatus).Methods()
+)
+
+func ExampleMaker_Methods() {
+	fmt.Println(OrderStatuses.String(orderStatus.Active))
+	fmt.Println(OrderStatuses.IsValid(orderStatus.Done), OrderStatuses.IsValid(3))
+
+	v, _ := OrderStatuses.Parse("LIVE")
+	switch v {
+	case orderStatus.Active:
+		fmt.Println("active")
+	}
+	// Output:
+	// Active
+	// true false
+	// active
+}
+
+func TestMaker_Methods(t *testing.T) {
+	type Level struct{ Low, High int8 }
+	var level Level
+	m := Make[Level, int8](&level)
+	methods := m.Methods()
+
+	t.Run("String", func(t *testing.T) {
+		if got := methods.String(level.High); got != "High" {
+			t.Errorf("Expected High, got %q", got)
+		}
+		if got := methods.String(42); got != "42" {
+			t.Errorf("Expected the number for an unknown value, got %q", got)
+		}
+	})
+
+	t.Run("Parse and Validate", func(t *testing.T) {
+		if v, err := methods.Parse("1"); err != nil || v != level.High {
+			t.Errorf("Expected High, got %v (%v)", v, err)
+		}
+		if _, err := methods.Parse("Medium"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+		if err := m.Validate(5); !errors.Is(err, ErrUnknownValue) || methods.IsValid(5) {
+			t.Errorf("Expected 5 to be invalid, got %v", err)
+		}
+	})
+
+}
+
+// describe is a helper written once against Entry[int], usable with any entry source.
+func describe(entries []Entry[int]) string {
+	parts := make([]string, len(entries))
+	for i, e := range entries {
+		parts[i] = fmt.Sprintf("%s=%d", e.String(), e.Get())
+	}
+	return strings.Join(parts, ",")
+}
+
+func ExampleEntriesAs() {
+	g := NewGenerator[int](WithStart(10))
+	g.Next("Pending")
+	g.Next("Active")
+
+	type Colors struct {
+		Red  int
+		Blue int
+	}
+	m := Make[Colors, int](&Colors{})
+
+	b := NewBasicRegistry()
+	b.Add("Small")
+
+	var all []Entry[int]
+	all = append(all, ToEntries(g.Values())...)
+	all = append(all, EntriesAs(m)...)
+	all = append(all, BasicEntries(b)...)
+	fmt.Println(describe(all))
+	// Output: Pending=10,Active=11,Red=0,Blue=1,Small=0
+}
+
+func TestMaker_Fingerprint(t *testing.T) {
+	type Colors struct{ Red, Blue int }
+	m := Make[Colors, int](&Colors{})
+	g := NewGenerator[int]()
+	g.Next("Red")
+	g.Next("Blue")
+	if m.Fingerprint() != g.Fingerprint() {
+		t.Error("Expected Maker and Generator with same entries to share a fingerprint")
+	}
+	if !bytes.Equal(m.CanonicalBytes(), g.CanonicalBytes()) {
+		t.Error("Expected identical canonical bytes")
+	}
+}
+
+func TestMaker_Kind(t *testing.T) {
+	type Colors struct{ Red, Blue int32 }
+	var c Colors
+	if k := Make[Colors, int32](&c).Kind(); k != (ValueKind{Class: ClassInt, Bits: 32}) {
+		t.Errorf("Expected int32, got %s", k)
+	}
+}
+
+func TestMaker_Reverse(t *testing.T) {
+	type Colors struct{ Red, Green, Blue int }
+	m := Make[Colors, int](&Colors{})
+	names := func(values []Value[int]) []string {
+		out := make([]string, len(values))
+		for i, v := range values {
+			out[i] = v.String()
+		}
+		return out
+	}
+	if got := names(m.ValuesReversed()); !reflect.DeepEqual(got, []string{"Blue", "Green", "Red"}) {
+		t.Errorf("Expected [Blue Green Red], got %v", got)
+	}
+	var backward []Value[int]
+	m.Backward()(func(v Value[int]) bool {
+		backward = append(backward, v)
+		return true
+	})
+	if got := names(backward); !reflect.DeepEqual(got, []string{"Blue", "Green", "Red"}) {
+		t.Errorf("Expected [Blue Green Red], got %v", got)
+	}
+	if got := names(m.Entries()); got[0] != "Red" {
+		t.Errorf("Expected Entries to keep definition order, got %v", got)
+	}
+}
+
+func TestTryMakeManual(t *testing.T) {
+	type Colors struct{ Red, Blue int }
+	var c Colors
+	_, err := TryMakeManual(&c, func(g *Generator[int]) *Colors {
+		c.Red = g.Next("Red").Get()
+		c.Blue = g.Next("Red").Get()
+		return &c
+	})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Expected the duplicate name reported, got %v", err)
+	}
+	if _, err := TryMakeManual[Colors, int](nil, nil); err == nil {
+		t.Error("Expected an error for a nil construct")
+	}
+	b := NewBasicRegistry()
+	if _, err := TryMakeManualWithRegistry(&c, b, func(*BasicRegistry) *Colors { return &Colors{} }); err == nil {
+		t.Error("Expected an error when init returns another pointer")
+	}
+}
+
+func TestMaker_Registry(t *testing.T) {
+	resetRegistry(t)
+	defer resetRegistry(t)
+
+	type Colors struct{ Red, Blue int }
+	if err := Register("color", Make[Colors, int](&Colors{})); err != nil {
+		t.Fatal(err)
+	}
+	if err := Register("nil", (*Maker[Colors, int])(nil)); !errors.Is(err, ErrNilRegistry) {
+		t.Errorf("Expected ErrNilRegistry for a nil *Maker, got %v", err)
+	}
+	r, _ := Lookup("color")
+	if name, ok := r.NameOfAny(1); !ok || name != "Blue" {
+		t.Errorf("Expected Blue for 1, got %q", name)
+	}
+	v, err := r.ParseAny("0")
+	if err != nil || v.(Value[int]).String() != "Red" {
+		t.Errorf("Expected Red, got %v, err: %v", v, err)
+	}
+}
+
+func TestMaker_PGEnum(t *testing.T) {
+	type Colors struct{ Red, Blue int }
+	e := NewPGEnum("color", Make[Colors, int](&Colors{}))
+	if err := e.Set("Blue"); err != nil {
+		t.Errorf("Expected Blue, got %v", err)
+	}
+	if e.t.index.Load() == nil {
+		t.Error("Expected the index of a Maker to be cached")
+	}
+}
+
+func TestMaker_Handler(t *testing.T) {
+	type Colors struct {
+		Red  int
+		Blue int
+	}
+	h := Handler(map[string]any{"color": Make[Colors, int](&Colors{})})
+	for path, want := range map[string]string{
+		"/color":              `[{"value":0,"name":"Red"},{"value":1,"name":"Blue"}]`,
+		"/color?form=compact": `{"0":"Red","1":"Blue"}`,
+	} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK || rec.Body.String() != want {
+			t.Errorf("GET %s: expected 200 %s, got %d %s", path, want, rec.Code, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NameMatcher defines how Parse compares input with registered names: two strings match
+// if Normalize maps them to the same key. Implementations must be deterministic and
+// safe for concurrent use.
+//
+// The package ships ExactMatcher and ASCIIFoldMatcher; the enumunicode module provides
+// Unicode-aware matchers (NFC or NFKC with case folding) without adding a dependency
+// to this package.
+type NameMatcher interface {
+	Normalize(name string) string
+}
+
+// ExactMatcher is a NameMatcher matching names byte for byte, as Parse does by default.
+type ExactMatcher struct{}
+
+// Normalize returns name unchanged.
+func (ExactMatcher) Normalize(name string) string {
+	return name
+}
+
+// ASCIIFoldMatcher is a NameMatcher ignoring the case of ASCII letters, so "active",
+// "ACTIVE", and "Active" match. Other characters must match exactly.
+type ASCIIFoldMatcher struct{}
+
+// Normalize returns name with ASCII letters lowercased.
+func (ASCIIFoldMatcher) Normalize(name string) string {
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; 'A' <= c && c <= 'Z' {
+			return strings.Map(func(r rune) rune {
+				if 'A' <= r && r <= 'Z' {
+					return r + 'a' - 'A'
+				}
+				return r
+			}, name)
+		}
+	}
+	return name
+}
+
+// WithNameMatcher makes Parse accept any input that m normalizes to the same key as a
+// registered name or alias, after exact name and alias lookups fail. The Value returned
+// carries the canonical name, as for an alias.
+//
+// The Generator keeps an index of normalized names, updated as entries are added,
+// renamed, aliased, and removed. A name or alias whose normalized form equals that of
+// a different existing name is rejected with an error wrapping ErrInvalidName, so a
+// match is never ambiguous. The check applies to every path that registers names.
+//
+// Panics if m is nil.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithNameMatcher[int](ASCIIFoldMatcher{}))
+//	g.Next("Active")
+//	v, _ := g.Parse("ACTIVE")      // {0 Active}
+//	_, err := g.TryNext("active") // err: enum: invalid entry: name "active" matches "Active" ...
+func WithNameMatcher[T TypesValue](m NameMatcher) Option[T] {
+	if m == nil {
+		panic("enum.WithNameMatcher: nil NameMatcher")
+	}
+	return func(g *Generator[T]) {
+		g.matcher = m
+		g.reindexLocked()
+	}
+}
+
+// parseMatchLocked resolves s through the normalized name index. The caller must hold
+// the read lock.
+func (g *Generator[T]) parseMatchLocked(s string) (Value[T], bool) {
+	if g.matcher == nil {
+		return Value[T]{}, false
+	}
+	name, ok := g.normalized[g.matcher.Normalize(s)]
+	if !ok {
+		return Value[T]{}, false
+	}
+	val, ok := g.nameMap[name]
+	if !ok {
+		val, ok = g.aliases[name]
+	}
+	if !ok {
+		return Value[T]{}, false
+	}
+	return g.entry(val, g.canonicalLocked(val)), true
+}
+
+// checkMatchLocked returns an error wrapping ErrInvalidName if name normalizes to the
+// same key as a registered name or alias other than except, or as a name in pending.
+// If pending is not nil, name is added to it, so that a batch of names can be checked
+// against each other. The caller must hold the read lock.
+func (g *Generator[T]) checkMatchLocked(name, except string, pending map[string]string) error {
+	if g.matcher == nil {
+		return nil
+	}
+	key := g.matcher.Normalize(name)
+	other, ok := g.normalized[key]
+	if !ok || other == name || other == except {
+		other, ok = pending[key]
+	}
+	if ok && other != name {
+		return fmt.Errorf("%w: name %q matches %q after normalization", ErrInvalidName, name, other)
+	}
+	if pending != nil {
+		pending[key] = name
+	}
+	return nil
+}
+
+// indexName adds name to the normalized name index. The caller must hold the write lock.
+func (g *Generator[T]) indexName(name string) {
+	if g.matcher == nil {
+		return
+	}
+	if g.normalized == nil {
+		g.normalized = make(map[string]string)
+	}
+	g.normalized[g.matcher.Normalize(name)] = name
+}
+
+// unindexName removes name from the normalized name index. The caller must hold the
+// write lock.
+func (g *Generator[T]) unindexName(name string) {
+	if g.matcher == nil {
+		return
+	}
+	key := g.matcher.Normalize(name)
+	if g.normalized[key] == name {
+		delete(g.normalized, key)
+	}
+}
+
+// reindexLocked rebuilds the normalized name index from the names and aliases, for
+// operations that replace them wholesale. The caller must hold the write lock.
+func (g *Generator[T]) reindexLocked() {
+	if g.matcher == nil {
+		return
+	}
+	g.normalized = make(map[string]string, len(g.nameMap)+len(g.aliases))
+	for alias := range g.aliases {
+		g.indexName(alias)
+	}
+	for name := range g.nameMap {
+		g.indexName(name) // Names win over aliases with the same key.
+	}
+}
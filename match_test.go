@@ -0,0 +1,136 @@
+package enum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWithNameMatcher(t *testing.T) {
+	newGen := func() *Generator[int] {
+		g := NewGenerator[int](WithNameMatcher[int](ASCIIFoldMatcher{}))
+		g.Next("Pending")
+		g.Next("Active")
+		g.AddAlias("Active", "Live")
+		return g
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		g := newGen()
+		tests := map[string]string{
+			"Pending": "Pending",
+			"PENDING": "Pending",
+			"active":  "Active",
+			"LIVE":    "Active",
+			"1":       "Active",
+		}
+		for input, want := range tests {
+			v, err := g.Parse(input)
+			if err != nil || v.name != want {
+				t.Errorf("Parse(%q): expected %s, got %v, %v", input, want, v, err)
+			}
+		}
+		if _, err := g.Parse("Actîve"); err == nil {
+			t.Error("Expected non-ASCII differences to fail")
+		}
+	})
+
+	t.Run("Exact", func(t *testing.T) {
+		g := NewGenerator[int](WithNameMatcher[int](ExactMatcher{}))
+		g.Next("Pending")
+		if _, err := g.Parse("pending"); err == nil {
+			t.Error("Expected ExactMatcher to be case-sensitive")
+		}
+		if _, err := g.TryNext("pending"); err != nil {
+			t.Errorf("Expected no collision under ExactMatcher, got %v", err)
+		}
+	})
+
+	t.Run("Collisions", func(t *testing.T) {
+		g := newGen()
+		if _, err := g.TryNext("PENDING"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected TryNext to reject a collision, got %v", err)
+		}
+		if err := g.TryAddAlias("Pending", "live"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected TryAddAlias to reject a collision with an alias, got %v", err)
+		}
+		if err := g.TryAddAlias("Pending", "Waiting", "WAITING"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected TryAddAlias to reject colliding aliases, got %v", err)
+		}
+		if err := g.Rename("Pending", "ACTIVE"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected Rename to reject a collision, got %v", err)
+		}
+		if err := g.Rename("Pending", "PENDING"); err != nil {
+			t.Errorf("Expected renaming to a variant of the same name to succeed, got %v", err)
+		}
+		if g.Len() != 2 || g.ContainsName("Waiting") {
+			t.Errorf("Expected rejected names to leave the Generator unchanged, got %v", g.Names())
+		}
+	})
+
+	t.Run("Incremental", func(t *testing.T) {
+		g := newGen()
+		if err := g.Rename("Active", "Running"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := g.Parse("ACTIVE"); err == nil {
+			t.Error("Expected the old name to be unindexed after Rename")
+		}
+		if v, err := g.Parse("RUNNING"); err != nil || v.Get() != 1 {
+			t.Errorf("Expected the new name to be indexed, got %v, %v", v, err)
+		}
+		if err := g.Remove("Running"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := g.Parse("live"); err == nil {
+			t.Error("Expected aliases to be unindexed with their value")
+		}
+		if _, err := g.TryNext("running"); err != nil {
+			t.Errorf("Expected a removed name's form to be free, got %v", err)
+		}
+		if v, err := g.Clone().Parse("RUNNING"); err != nil || v.name != "running" {
+			t.Errorf("Expected the clone to keep the index, got %v, %v", v, err)
+		}
+	})
+
+	t.Run("Bulk", func(t *testing.T) {
+		opt := WithNameMatcher[int](ASCIIFoldMatcher{})
+		g := NewMapped(map[string]int{"Open": 1, "OPEN": 2}, opt, WithErrorMode[int]())
+		if g.Len() != 1 || !errors.Is(g.Err(), ErrInvalidName) {
+			t.Errorf("Expected NewMapped to skip the collision, got %v, %v", g.Names(), g.Err())
+		}
+		if _, err := LoadJSON(strings.NewReader(`{"1":"Open","2":"open"}`), opt); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected LoadJSON to reject the collision, got %v", err)
+		}
+		g, err := LoadJSON(strings.NewReader(`{"1":"Open","2":"Closed"}`), opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, err := g.Parse("closed"); err != nil || v.Get() != 2 {
+			t.Errorf("Expected unmarshaled names to be indexed, got %v, %v", v, err)
+		}
+	})
+
+	t.Run("Panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a nil matcher")
+			}
+		}()
+		WithNameMatcher[int](nil)
+	})
+}
+
+func TestASCIIFoldMatcher(t *testing.T) {
+	tests := map[string]string{
+		"":            "",
+		"abc":         "abc",
+		"MixedCase_9": "mixedcase_9",
+		"ÉTAT":        "État", // Only ASCII letters fold.
+	}
+	for input, want := range tests {
+		if got := (ASCIIFoldMatcher{}).Normalize(input); got != want {
+			t.Errorf("Normalize(%q): expected %q, got %q", input, want, got)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Member is an enum value bound to the Generator it belongs to. Unlike Value[T], it can
+// resolve names on its own, so it scans database values (numbers, names, or []byte text)
+// straight through the registry. Obtain one with Generator.Member.
+//
+// Example:
+//
+//	var status = NewMapped(map[string]string{"Pending": "tok_p", "Active": "tok_a"})
+//	m := status.Member(WithScanTrim(" \n"))
+//	err := row.Scan(&m) // []byte("tok_a \n") -> Get() == "tok_a", String() == "Active"
+type Member[T TypesValue] struct {
+	value T
+	name  string
+	g     *Generator[T]
+	cfg   *memberConfig
+}
+
+// MemberOption configures how a Member scans database values.
+type MemberOption func(*memberConfig)
+
+// memberConfig holds the settings applied by MemberOption values.
+type memberConfig struct {
+	cutset    string
+	transform func(string) string
+}
+
+// WithScanTrim makes Scan strip leading and trailing characters in cutset from text
+// before resolving it (e.g., " \t\r\n" for values imported from CSV).
+func WithScanTrim(cutset string) MemberOption {
+	return func(c *memberConfig) {
+		c.cutset = cutset
+	}
+}
+
+// WithScanTransform makes Scan apply fn to text after trimming and before resolving it,
+// e.g., strings.ToLower or stripping a prefix.
+func WithScanTransform(fn func(string) string) MemberOption {
+	return func(c *memberConfig) {
+		c.transform = fn
+	}
+}
+
+// Member returns an empty Member bound to the Generator, ready to be scanned into.
+func (g *Generator[T]) Member(opts ...MemberOption) Member[T] {
+	cfg := &memberConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return Member[T]{g: g, cfg: cfg}
+}
+
+// Get returns the underlying value of the member.
+func (m Member[T]) Get() T {
+	return m.value
+}
+
+// String returns the name of the member.
+func (m Member[T]) String() string {
+	return m.name
+}
+
+// Generator returns the Generator the member is bound to.
+func (m Member[T]) Generator() *Generator[T] {
+	return m.g
+}
+
+// Set binds the member to v after checking it with Generator.ValidateEntry, so unset
+// values, unregistered values, and entries of another Generator created with
+// WithProvenance are rejected and leave the member unchanged. The member takes its name
+// from the registry. Returns ErrNilRegistry if the member is not bound to a Generator.
+//
+// Example:
+//
+//	m := orders.Member()
+//	err := m.Set(payments.Next("Refunded")) // errors.Is(err, ErrForeignEntry)
+func (m *Member[T]) Set(v Value[T]) error {
+	if m.g == nil {
+		return ErrNilRegistry
+	}
+	if err := m.g.ValidateEntry(v); err != nil {
+		return err
+	}
+	name, _ := m.g.Name(v.value)
+	m.value, m.name = v.value, name
+	return nil
+}
+
+// Value implements driver.Valuer, storing the underlying value like Value[T] does.
+func (m Member[T]) Value() (driver.Value, error) {
+	return NewValue(m.value, m.name).Value()
+}
+
+// Scan implements sql.Scanner. Text (string or []byte) is trimmed and transformed as
+// configured, then resolved with Generator.Parse, so names, aliases, and value literals
+// are accepted. Numbers (int64, float64) are converted to T and looked up by value.
+// A NULL resets the member to its zero value, keeping its binding.
+//
+// Errors are returned as *ScanError, wrapping ErrUnknownValue if the value is not
+// registered or ErrNilRegistry if the member is not bound to a Generator.
+func (m *Member[T]) Scan(src any) error {
+	fail := func(err error) error {
+		var zero T
+		return &ScanError{Source: fmt.Sprintf("%T", src), Target: fmt.Sprintf("%T", zero), Value: src, Err: err}
+	}
+	if m.g == nil {
+		return fail(ErrNilRegistry)
+	}
+	if src == nil {
+		m.value, m.name = *new(T), ""
+		return nil
+	}
+
+	var text string
+	switch v := src.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	case int64:
+		text = strconv.FormatInt(v, 10)
+	case float64:
+		text = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fail(errors.New("unsupported source type"))
+	}
+	if m.cfg != nil {
+		if m.cfg.cutset != "" {
+			text = strings.Trim(text, m.cfg.cutset)
+		}
+		if m.cfg.transform != nil {
+			text = m.cfg.transform(text)
+		}
+	}
+
+	v, err := m.g.Parse(text)
+	if err != nil {
+		return fail(fmt.Errorf("%w: %q", ErrUnknownValue, text))
+	}
+	m.value, m.name = v.value, v.name
+	return nil
+}
@@ -0,0 +1,70 @@
+package enum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMember_Scan(t *testing.T) {
+	tokens := NewMapped(map[string]string{"Pending": "tok_p", "Active": "tok_a"})
+
+	t.Run("Bytes and names", func(t *testing.T) {
+		m := tokens.Member()
+		for _, src := range []any{[]byte("tok_a"), "tok_a", "Active", []byte("Active")} {
+			if err := m.Scan(src); err != nil || m.Get() != "tok_a" || m.String() != "Active" {
+				t.Errorf("Scan(%v): got %q/%q, err: %v", src, m.Get(), m.String(), err)
+			}
+		}
+	})
+
+	t.Run("Trim", func(t *testing.T) {
+		m := tokens.Member(WithScanTrim(" \t\r\n"))
+		if err := m.Scan([]byte("  tok_p\r\n")); err != nil || m.String() != "Pending" {
+			t.Errorf("Expected Pending, got %q, err: %v", m.String(), err)
+		}
+		plain := tokens.Member()
+		if err := plain.Scan(" tok_p"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected no trimming by default, got %v", err)
+		}
+	})
+
+	t.Run("Transform", func(t *testing.T) {
+		m := tokens.Member(WithScanTrim(" "), WithScanTransform(func(s string) string {
+			return "tok_" + strings.TrimPrefix(strings.ToLower(s), "legacy:")
+		}))
+		if err := m.Scan(" LEGACY:A "); err != nil || m.Get() != "tok_a" {
+			t.Errorf("Expected tok_a, got %q, err: %v", m.Get(), err)
+		}
+	})
+
+	t.Run("Numbers", func(t *testing.T) {
+		codes := NewMapped(map[string]int16{"OK": 200, "NotFound": 404})
+		m := codes.Member()
+		if err := m.Scan(int64(404)); err != nil || m.String() != "NotFound" {
+			t.Errorf("Expected NotFound, got %q, err: %v", m.String(), err)
+		}
+		if v, err := m.Value(); err != nil || v != int64(404) {
+			t.Errorf("Expected int64(404), got %v, err: %v", v, err)
+		}
+		var se *ScanError
+		if err := m.Scan(int64(500)); !errors.As(err, &se) || !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ScanError wrapping ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("Null and unbound", func(t *testing.T) {
+		m := tokens.Member()
+		m.Scan("tok_a")
+		if err := m.Scan(nil); err != nil || m.Get() != "" || m.Generator() != tokens {
+			t.Errorf("Expected NULL to reset the value and keep the binding, got %v", err)
+		}
+		var unbound Member[string]
+		if err := unbound.Scan("tok_a"); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+		if err := m.Scan(true); err == nil {
+			t.Error("Expected unsupported source type to fail")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MergePolicy controls which incoming definitions UnmarshalJSONMerge and
+// PopulateFromMerge accept.
+type MergePolicy int
+
+const (
+	// MergeAllowAny accepts any valid payload, like UnmarshalJSON.
+	MergeAllowAny MergePolicy = iota
+	// MergeRequireSuperset accepts the payload only if it keeps every current entry
+	// under the same name and value; it may add entries.
+	MergeRequireSuperset
+	// MergeRequireEqual accepts the payload only if it holds exactly the current entries.
+	MergeRequireEqual
+)
+
+// String returns the name of the policy.
+func (p MergePolicy) String() string {
+	switch p {
+	case MergeAllowAny:
+		return "AllowAny"
+	case MergeRequireSuperset:
+		return "RequireSuperset"
+	case MergeRequireEqual:
+		return "RequireEqual"
+	}
+	return fmt.Sprintf("MergePolicy(%d)", int(p))
+}
+
+// UnmarshalJSONMerge is like UnmarshalJSON but first checks the decoded entries against
+// the current ones according to policy, replacing the state only if they pass. The
+// check and the replacement happen under one write lock, so no concurrent change can
+// slip in between. Entries are compared by canonical name and value.
+//
+// Returns an error wrapping ErrMergeRejected that lists every violation (missing,
+// changed, or, under MergeRequireEqual, added entries) if the payload is rejected, or the
+// error UnmarshalJSON would return if it is invalid. The Generator is unchanged on error.
+//
+// Example:
+//
+//	// Config reload: new entries may appear, but existing ones must not move.
+//	if err := statuses.UnmarshalJSONMerge(payload, MergeRequireSuperset); err != nil {
+//		log.Printf("keeping current statuses: %v", err)
+//	}
+func (g *Generator[T]) UnmarshalJSONMerge(data []byte, policy MergePolicy) error {
+	if policy < MergeAllowAny || policy > MergeRequireEqual {
+		return fmt.Errorf("enum: unknown merge policy %v", policy)
+	}
+	values, valueMap, nameMap, err := g.decodeJSONEntries(data)
+	if err != nil {
+		return err
+	}
+
+	g.lock()
+	defer g.unlock()
+	if policy != MergeAllowAny {
+		var violations []error
+		for _, pair := range g.pairsLocked(true) {
+			incoming, ok := nameMap[pair.Name]
+			switch {
+			case !ok:
+				violations = append(violations, fmt.Errorf("%q is missing", pair.Name))
+			case incoming != pair.Value:
+				violations = append(violations, fmt.Errorf("%q changed from %s to %s", pair.Name, g.formatValue(pair.Value), g.formatValue(incoming)))
+			}
+		}
+		if policy == MergeRequireEqual {
+			for _, entry := range values {
+				if _, ok := g.nameMap[entry.name]; !ok {
+					violations = append(violations, fmt.Errorf("%q is new", entry.name))
+				}
+			}
+		}
+		if len(violations) > 0 {
+			return fmt.Errorf("%w under %v: %w", ErrMergeRejected, policy, errors.Join(violations...))
+		}
+	}
+	g.replaceLocked(values, valueMap, nameMap)
+	return nil
+}
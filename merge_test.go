@@ -0,0 +1,73 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_UnmarshalJSONMerge(t *testing.T) {
+	current := func() *Generator[int] {
+		return NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+	}
+	testCases := []struct {
+		name    string
+		payload string
+		policy  MergePolicy
+		wantErr []string // Violations expected in the error, nil if accepted.
+	}{
+		{"Superset adds", `{"1":"Pending","2":"Active","3":"Closed"}`, MergeRequireSuperset, nil},
+		{"Superset missing", `{"1":"Pending","3":"Closed"}`, MergeRequireSuperset, []string{`"Active" is missing`}},
+		{"Superset moved", `{"1":"Pending","5":"Active"}`, MergeRequireSuperset, []string{`"Active" changed from 2 to 5`}},
+		{"Equal same", `[{"value":1,"name":"Pending"},{"value":2,"name":"Active"}]`, MergeRequireEqual, nil},
+		{"Equal adds", `{"1":"Pending","2":"Active","3":"Closed"}`, MergeRequireEqual, []string{`"Closed" is new`}},
+		{"Allow any", `{"9":"Other"}`, MergeAllowAny, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := current()
+			before := string(g.CanonicalBytes())
+			err := g.UnmarshalJSONMerge([]byte(tc.payload), tc.policy)
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Expected the payload accepted, got %v", err)
+				}
+				want := NewMapped(map[string]int{})
+				_ = want.UnmarshalJSON([]byte(tc.payload))
+				if string(g.CanonicalBytes()) != string(want.CanonicalBytes()) {
+					t.Errorf("Expected the payload committed, got %v", g.Values())
+				}
+				return
+			}
+			if !errors.Is(err, ErrMergeRejected) {
+				t.Fatalf("Expected ErrMergeRejected, got %v", err)
+			}
+			for _, want := range tc.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected error to mention %s, got %v", want, err)
+				}
+			}
+			if string(g.CanonicalBytes()) != before {
+				t.Errorf("Expected the Generator unchanged, got %v", g.Values())
+			}
+		})
+	}
+
+	t.Run("Invalid payload", func(t *testing.T) {
+		g := current()
+		err := g.UnmarshalJSONMerge([]byte(`{"1":"Dup","2":"Dup"}`), MergeAllowAny)
+		if !errors.Is(err, ErrNotBijective) {
+			t.Errorf("Expected ErrNotBijective, got %v", err)
+		}
+		if names := g.Names(); !reflect.DeepEqual(names, []string{"Pending", "Active"}) {
+			t.Errorf("Expected the Generator unchanged, got %v", names)
+		}
+	})
+
+	t.Run("Unknown policy", func(t *testing.T) {
+		if err := current().UnmarshalJSONMerge([]byte(`{}`), MergePolicy(9)); err == nil {
+			t.Error("Expected an error for an unknown policy")
+		}
+	})
+}
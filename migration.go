@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// Migration translates values between two generations of an enum whose entries
+// were renumbered. Entries are matched by name, optionally through an explicit
+// rename map. A Migration is an immutable snapshot taken at construction time and
+// is safe for concurrent use.
+type Migration[T TypesValue] struct {
+	byValue map[T]T      // Maps old values to new values.
+	byName  map[string]T // Maps old names to new values.
+}
+
+// NewMigration builds a Migration from old to next by matching entries by name.
+//
+// Returns an error if a name in old does not exist in next. Use NewMigrationWithRenames
+// when entries were renamed between generations.
+//
+// Example:
+//
+//	v1 := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+//	v2 := NewMapped(map[string]int{"Pending": 10, "Active": 20})
+//	m, _ := NewMigration(v1, v2)
+//	fmt.Println(m.Translate(2)) // Output: 20 true
+func NewMigration[T TypesValue](old, next *Generator[T]) (Migration[T], error) {
+	return NewMigrationWithRenames(old, next, nil)
+}
+
+// NewMigrationWithRenames is like NewMigration but consults renames (old name to
+// new name) for entries whose names changed between generations. Names absent
+// from renames are matched as-is.
+//
+// Returns an error if a name in old, after renaming, does not exist in next.
+func NewMigrationWithRenames[T TypesValue](old, next *Generator[T], renames map[string]string) (Migration[T], error) {
+	oldNames := old.NameMap()
+	newNames := next.NameMap()
+
+	byName := make(map[string]T, len(oldNames))
+	for name := range oldNames {
+		target := name
+		if renamed, ok := renames[name]; ok {
+			target = renamed
+		}
+		val, ok := newNames[target]
+		if !ok {
+			return Migration[T]{}, fmt.Errorf("enum: cannot migrate %q: name %q not found in new generation", name, target)
+		}
+		byName[name] = val
+	}
+
+	byValue := make(map[T]T, len(byName))
+	for value, name := range old.ValueMap() {
+		byValue[value] = byName[name]
+	}
+
+	return Migration[T]{byValue: byValue, byName: byName}, nil
+}
+
+// Translate returns the new value corresponding to an old value.
+// Returns the zero value of T and false if the old value is unknown.
+func (m Migration[T]) Translate(oldValue T) (T, bool) {
+	val, ok := m.byValue[oldValue]
+	return val, ok
+}
+
+// TranslateName returns the new value corresponding to an old name.
+// Returns the zero value of T and false if the old name is unknown.
+func (m Migration[T]) TranslateName(name string) (T, bool) {
+	val, ok := m.byName[name]
+	return val, ok
+}
+
+// Checksum returns a stable hex-encoded SHA-256 hash over the Generator's
+// name/value pairs, ordered by name. It is useful for detecting accidental
+// drift in enum definitions, e.g. in CI. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Checksum() string {
+	nameMap := g.NameMap()
+	names := make([]string, 0, len(nameMap))
+	for name := range nameMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%q=%v\n", name, nameMap[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
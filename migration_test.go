@@ -0,0 +1,58 @@
+package enum
+
+import "testing"
+
+func TestMigration(t *testing.T) {
+	v1 := NewMapped(map[string]int{"Pending": 1, "Active": 2, "Done": 3})
+	v2 := NewMapped(map[string]int{"Pending": 10, "Active": 20, "Completed": 30})
+
+	t.Run("Missing name", func(t *testing.T) {
+		if _, err := NewMigration(v1, v2); err == nil {
+			t.Error("Expected error for name missing from new generation")
+		}
+	})
+
+	t.Run("With renames", func(t *testing.T) {
+		m, err := NewMigrationWithRenames(v1, v2, map[string]string{"Done": "Completed"})
+		if err != nil {
+			t.Fatalf("NewMigrationWithRenames failed: %v", err)
+		}
+		if val, ok := m.Translate(2); !ok || val != 20 {
+			t.Errorf("Expected 2 -> 20, got %d", val)
+		}
+		if val, ok := m.Translate(3); !ok || val != 30 {
+			t.Errorf("Expected 3 -> 30, got %d", val)
+		}
+		if val, ok := m.TranslateName("Done"); !ok || val != 30 {
+			t.Errorf("Expected Done -> 30, got %d", val)
+		}
+		if _, ok := m.Translate(99); ok {
+			t.Error("Expected unknown value to fail")
+		}
+		if _, ok := m.TranslateName("Unknown"); ok {
+			t.Error("Expected unknown name to fail")
+		}
+	})
+
+	t.Run("Rename target missing", func(t *testing.T) {
+		_, err := NewMigrationWithRenames(v1, v2, map[string]string{"Done": "Finished"})
+		if err == nil {
+			t.Error("Expected error for missing rename target")
+		}
+	})
+}
+
+func TestGenerator_Checksum(t *testing.T) {
+	a := NewMapped(map[string]int{"A": 1, "B": 2, "C": 3})
+	b := NewMapped(map[string]int{"C": 3, "B": 2, "A": 1})
+	if a.Checksum() != b.Checksum() {
+		t.Error("Expected equal checksums for equal definitions")
+	}
+	if len(a.Checksum()) != 64 {
+		t.Errorf("Expected 64 hex characters, got %d", len(a.Checksum()))
+	}
+	c := NewMapped(map[string]int{"A": 1, "B": 2, "C": 4})
+	if a.Checksum() == c.Checksum() {
+		t.Error("Expected different checksums after renumbering")
+	}
+}
@@ -0,0 +1,117 @@
+package enum
+
+import "fmt"
+
+// NamesOfValue returns every name mapped to value, canonical name first, then the
+// others in registration order. Returns nil if the value does not exist.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithStart(302), WithIncrementer(func(v int) int { return v }))
+//	g.Next("Found")
+//	g.Next("MovedTemporarily")
+//	fmt.Println(g.NamesOfValue(302)) // Output: [Found MovedTemporarily]
+func (g *Generator[T]) NamesOfValue(value T) []string {
+	g.rlock()
+	defer g.runlock()
+	canonical, ok := g.valueMap[value]
+	if !ok {
+		if g.parent != nil {
+			return g.parent.NamesOfValue(value)
+		}
+		return nil
+	}
+	extra := g.extraNames[value]
+	names := make([]string, 0, 1+len(extra))
+	names = append(names, canonical)
+	return append(names, extra...)
+}
+
+// CanonicalName returns the canonical name of value: the first name registered for it
+// that is still present. It is equivalent to Name, and is the name MarshalJSON emits;
+// Parse accepts any of the value's names. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) CanonicalName(value T) (string, bool) {
+	return g.Name(value)
+}
+
+// addName maps value to name and indexes name for the NameMatcher. The first name
+// registered for a value becomes its canonical name; later ones are kept in registration
+// order. The caller must hold the write lock.
+func (g *Generator[T]) addName(value T, name string) {
+	g.indexName(name)
+	if _, ok := g.valueMap[value]; !ok {
+		g.valueMap[value] = name
+		return
+	}
+	if g.extraNames == nil {
+		g.extraNames = make(map[T][]string)
+	}
+	g.extraNames[value] = append(g.extraNames[value], name)
+}
+
+// dropName unmaps name from value. If name was canonical, the next registered name is
+// promoted; if it was the only name, the value is removed. The caller must hold the write lock.
+func (g *Generator[T]) dropName(value T, name string) {
+	g.unindexName(name)
+	extra := g.extraNames[value]
+	if g.valueMap[value] == name {
+		if len(extra) == 0 {
+			delete(g.valueMap, value)
+			return
+		}
+		g.valueMap[value] = extra[0]
+		extra = extra[1:]
+	} else {
+		for i, n := range extra {
+			if n == name {
+				extra = append(extra[:i:i], extra[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(extra) == 0 {
+		delete(g.extraNames, value)
+	} else {
+		g.extraNames[value] = extra
+	}
+}
+
+// renameName replaces oldName with newName among the names of value, keeping its
+// position. The caller must hold the write lock.
+func (g *Generator[T]) renameName(value T, oldName, newName string) {
+	g.unindexName(oldName)
+	g.indexName(newName)
+	if g.valueMap[value] == oldName {
+		g.valueMap[value] = newName
+		return
+	}
+	for i, n := range g.extraNames[value] {
+		if n == oldName {
+			g.extraNames[value][i] = newName
+			return
+		}
+	}
+}
+
+// checkNames verifies that every non-canonical name maps back to its value and that
+// valueMap and extraNames together account for every name. The caller must hold the read lock.
+func (g *Generator[T]) checkNames() error {
+	count := len(g.valueMap)
+	for value, names := range g.extraNames {
+		canonical, ok := g.valueMap[value]
+		if !ok {
+			return fmt.Errorf("enum: value %v has extra names %q but no canonical name", value, names)
+		}
+		for _, name := range names {
+			if v, ok := g.nameMap[name]; !ok || v != value || name == canonical {
+				return fmt.Errorf("enum: extra name %q of value %v does not map back", name, value)
+			}
+		}
+		count += len(names)
+	}
+	if count != len(g.nameMap) {
+		return fmt.Errorf("enum: values account for %d names, nameMap has %d", count, len(g.nameMap))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_MultiName(t *testing.T) {
+	newRedirects := func() *Generator[int] {
+		g := NewGenerator[int](WithStart(302), WithIncrementer(func(v int) int { return v }))
+		g.Next("Found")
+		g.Next("MovedTemporarily")
+		g.Next("Redirect")
+		return g
+	}
+
+	t.Run("Canonical is first registered", func(t *testing.T) {
+		g := newRedirects()
+		if name, ok := g.CanonicalName(302); !ok || name != "Found" {
+			t.Errorf("Expected Found, got %q", name)
+		}
+		if names := g.NamesOfValue(302); !reflect.DeepEqual(names, []string{"Found", "MovedTemporarily", "Redirect"}) {
+			t.Errorf("Unexpected names %v", names)
+		}
+		if g.NamesOfValue(404) != nil {
+			t.Error("Expected nil for unknown value")
+		}
+	})
+
+	t.Run("Parse any, marshal canonical", func(t *testing.T) {
+		g := newRedirects()
+		v, err := g.Parse("MovedTemporarily")
+		if err != nil || v.Get() != 302 || v.String() != "MovedTemporarily" {
+			t.Errorf("Expected 302/MovedTemporarily, got %v, err: %v", v, err)
+		}
+		b, _ := json.Marshal(g)
+		if string(b) != `{"302":"Found"}` {
+			t.Errorf("Expected canonical name in JSON, got %s", b)
+		}
+	})
+
+	t.Run("Remove promotes next name", func(t *testing.T) {
+		g := newRedirects()
+		g.Remove("Found")
+		if name, _ := g.Name(302); name != "MovedTemporarily" {
+			t.Errorf("Expected MovedTemporarily to become canonical, got %q", name)
+		}
+		g.Remove("Redirect")
+		if names := g.NamesOfValue(302); !reflect.DeepEqual(names, []string{"MovedTemporarily"}) {
+			t.Errorf("Unexpected names %v", names)
+		}
+		if err := g.CheckConsistency(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Rename keeps position", func(t *testing.T) {
+		g := newRedirects()
+		g.Rename("MovedTemporarily", "Moved")
+		g.Rename("Found", "Found302")
+		if names := g.NamesOfValue(302); !reflect.DeepEqual(names, []string{"Found302", "Moved", "Redirect"}) {
+			t.Errorf("Unexpected names %v", names)
+		}
+		if err := g.CheckConsistency(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("WithSortedEntries orders by value, then name", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Moved": 302, "Found": 302, "OK": 200}, WithSortedEntries[int]())
+		if names := g.NamesOfValue(302); !reflect.DeepEqual(names, []string{"Found", "Moved"}) {
+			t.Errorf("Unexpected names %v", names)
+		}
+	})
+
+	t.Run("Clone is independent", func(t *testing.T) {
+		g := newRedirects()
+		c := g.Clone()
+		c.Remove("MovedTemporarily")
+		if len(g.NamesOfValue(302)) != 3 || len(c.NamesOfValue(302)) != 2 {
+			t.Errorf("Expected independent name lists, got %v and %v", g.NamesOfValue(302), c.NamesOfValue(302))
+		}
+	})
+}
+
+func BenchmarkGenerator_CanonicalName(b *testing.B) {
+	for _, n := range []int{10, 10000} {
+		m := make(map[string]int, 2*n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("N%d", i)] = i
+			m[fmt.Sprintf("Alt%d", i)] = i // Second name for every value.
+		}
+		g := NewMapped(m)
+		b.Run(fmt.Sprintf("CanonicalName/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.CanonicalName(i % n)
+			}
+		})
+		b.Run(fmt.Sprintf("NamesOfValue/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.NamesOfValue(i % n)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// nameLimits holds the constraints set by WithMaxNameLength and WithNameCharset. It is
+// not modified after construction, so clones share it.
+type nameLimits struct {
+	maxLen  int            // Maximum length in characters; 0 for no limit.
+	charset *regexp.Regexp // Anchored form of pattern, nil for no constraint.
+	pattern string         // Pattern as given to WithNameCharset, for error messages.
+}
+
+// WithMaxNameLength makes the Generator reject names longer than n characters (runes,
+// not bytes), e.g. to fit a VARCHAR(n) column. The limit applies to every path that
+// registers a name: Next, TryNext, NextAs, NextCtx, GetOrAdd, BasicRegistry.Add and
+// FromValue, AddAlias, Rename, NewMapped, PopulateFrom, UnmarshalJSON,
+// UnmarshalJSONMerge, and LoadJSON. Rejected names fail with an error wrapping
+// ErrInvalidName, checked before any validator added with WithValidator runs.
+//
+// Panics if n is not positive.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithMaxNameLength[int](8))
+//	_, err := g.TryNext("PendingReview")
+//	// err: enum: invalid entry: name "PendingR..." is 13 characters long, over the limit of 8
+func WithMaxNameLength[T TypesValue](n int) Option[T] {
+	if n < 1 {
+		panic(fmt.Sprintf("enum.WithMaxNameLength: limit must be positive, got %d", n))
+	}
+	return func(g *Generator[T]) {
+		limits := g.limitsForUpdate()
+		limits.maxLen = n
+	}
+}
+
+// WithNameCharset makes the Generator reject names that re does not match in full, as
+// if re were anchored with ^ and $. It applies to the same paths as WithMaxNameLength,
+// and rejected names fail with an error wrapping ErrInvalidName.
+//
+// Panics if re is nil.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithNameCharset[int](regexp.MustCompile(`[A-Za-z][A-Za-z0-9_]*`)))
+//	_, err := g.TryNext("in progress")
+//	// err: enum: invalid entry: name "in progress" does not match [A-Za-z][A-Za-z0-9_]*
+func WithNameCharset[T TypesValue](re *regexp.Regexp) Option[T] {
+	if re == nil {
+		panic("enum.WithNameCharset: nil regexp")
+	}
+	charset := regexp.MustCompile(`^(?:` + re.String() + `)$`)
+	return func(g *Generator[T]) {
+		limits := g.limitsForUpdate()
+		limits.charset = charset
+		limits.pattern = re.String()
+	}
+}
+
+// limitsForUpdate returns a copy of the Generator's name limits installed in their
+// place, so options never modify limits shared with a clone.
+func (g *Generator[T]) limitsForUpdate() *nameLimits {
+	limits := &nameLimits{}
+	if g.nameLimits != nil {
+		*limits = *g.nameLimits
+	}
+	g.nameLimits = limits
+	return limits
+}
+
+// checkName returns an error wrapping ErrInvalidName if name violates the limits set by
+// WithMaxNameLength or WithNameCharset.
+func (g *Generator[T]) checkName(name string) error {
+	limits := g.nameLimits
+	if limits == nil {
+		return nil
+	}
+	if limits.maxLen > 0 {
+		if n := utf8.RuneCountInString(name); n > limits.maxLen {
+			return fmt.Errorf("%w: name %q is %d characters long, over the limit of %d",
+				ErrInvalidName, truncateName(name, limits.maxLen), n, limits.maxLen)
+		}
+	}
+	if limits.charset != nil && !limits.charset.MatchString(name) {
+		return fmt.Errorf("%w: name %q does not match %s", ErrInvalidName, name, limits.pattern)
+	}
+	return nil
+}
+
+// truncateName shortens name to its first n characters followed by "...", keeping
+// errors about oversized names readable.
+func truncateName(name string, n int) string {
+	for i := range name {
+		if n == 0 {
+			return name[:i] + "..."
+		}
+		n--
+	}
+	return name
+}
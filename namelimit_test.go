@@ -0,0 +1,230 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestWithMaxNameLength(t *testing.T) {
+	t.Run("Boundary", func(t *testing.T) {
+		g := NewGenerator[int](WithMaxNameLength[int](4))
+		if _, err := g.TryNext("Open"); err != nil {
+			t.Fatalf("Expected a 4-character name to be accepted, got %v", err)
+		}
+		if _, err := g.TryNext("Café"); err != nil {
+			t.Fatalf("Expected length to count characters, not bytes, got %v", err)
+		}
+		_, err := g.TryNext("Closed")
+		if !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("Expected ErrInvalidName for a 6-character name, got %v", err)
+		}
+		if want := `name "Clos..." is 6 characters long, over the limit of 4`; !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got %q", want, err)
+		}
+		if g.Len() != 2 {
+			t.Errorf("Expected 2 entries, got %d", g.Len())
+		}
+	})
+
+	t.Run("Oversized", func(t *testing.T) {
+		g := NewGenerator[int](WithMaxNameLength[int](64))
+		_, err := g.TryNext(strings.Repeat("x", 2048))
+		if !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("Expected ErrInvalidName, got %v", err)
+		}
+		if len(err.Error()) > 200 {
+			t.Errorf("Expected the name to be truncated in the error, got %d bytes", len(err.Error()))
+		}
+	})
+
+	t.Run("Panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a non-positive limit")
+			}
+		}()
+		WithMaxNameLength[int](0)
+	})
+}
+
+func TestWithNameCharset(t *testing.T) {
+	ident := regexp.MustCompile(`[A-Za-z][A-Za-z0-9_]*`)
+
+	t.Run("FullMatch", func(t *testing.T) {
+		g := NewGenerator[int](WithNameCharset[int](ident))
+		if _, err := g.TryNext("In_Progress2"); err != nil {
+			t.Fatalf("Expected a matching name to be accepted, got %v", err)
+		}
+		for _, name := range []string{"in progress", "2nd", "Done!", ""} {
+			_, err := g.TryNext(name)
+			if !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected ErrInvalidName for %q, got %v", name, err)
+				continue
+			}
+			if want := "does not match " + ident.String(); !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %q", want, err)
+			}
+		}
+	})
+
+	t.Run("Alternation", func(t *testing.T) {
+		g := NewGenerator[int](WithNameCharset[int](regexp.MustCompile(`a|ab`)))
+		if _, err := g.TryNext("ab"); err != nil {
+			t.Errorf("Expected the pattern to be matched as a whole, got %v", err)
+		}
+	})
+
+	t.Run("Panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for a nil regexp")
+			}
+		}()
+		WithNameCharset[int](nil)
+	})
+}
+
+func TestNameLimits_Paths(t *testing.T) {
+	opts := func() []Option[int] {
+		return []Option[int]{WithMaxNameLength[int](6), WithNameCharset[int](regexp.MustCompile(`[A-Z][a-z]*`))}
+	}
+	newGen := func() *Generator[int] {
+		g := NewGenerator[int](opts()...)
+		g.Next("Open")
+		return g
+	}
+	rejected := []string{"Archived", "open"} // Too long, wrong charset.
+
+	t.Run("Next", func(t *testing.T) {
+		for _, name := range rejected {
+			g := newGen()
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected Next(%q) to panic", name)
+					}
+				}()
+				g.Next(name)
+			}()
+		}
+	})
+
+	t.Run("NextCtx", func(t *testing.T) {
+		called := false
+		g := NewGenerator[int](append(opts(), WithValidator(func(context.Context, string, int) error {
+			called = true
+			return nil
+		}))...)
+		for _, name := range rejected {
+			if _, err := g.NextCtx(context.Background(), name); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected ErrInvalidName for %q, got %v", name, err)
+			}
+		}
+		if called {
+			t.Error("Expected the validator not to run for rejected names")
+		}
+	})
+
+	t.Run("GetOrAdd", func(t *testing.T) {
+		g := newGen()
+		for _, name := range rejected {
+			if _, err := g.GetOrAdd(name); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected ErrInvalidName for %q, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("Basic", func(t *testing.T) {
+		r := NewBasicRegistry(opts()...)
+		for _, name := range rejected {
+			if _, err := r.TryAdd(name); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected TryAdd(%q) to fail with ErrInvalidName, got %v", name, err)
+			}
+			if _, err := r.TryFromValue(NewValue(9, name)); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected TryFromValue(%q) to fail with ErrInvalidName, got %v", name, err)
+			}
+		}
+		if n := len(r.Names()); n != 0 {
+			t.Errorf("Expected no entries, got %d", n)
+		}
+	})
+
+	t.Run("AliasAndRename", func(t *testing.T) {
+		g := newGen()
+		for _, name := range rejected {
+			if err := g.TryAddAlias("Open", name); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected TryAddAlias(%q) to fail with ErrInvalidName, got %v", name, err)
+			}
+			if err := g.Rename("Open", name); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected Rename(%q) to fail with ErrInvalidName, got %v", name, err)
+			}
+		}
+		if got := g.Names(); len(got) != 1 || got[0] != "Open" {
+			t.Errorf("Expected [Open], got %v", got)
+		}
+	})
+
+	t.Run("NewMapped", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Open": 1, "Archived": 2, "open": 3}, append(opts(), WithErrorMode[int]())...)
+		if got := g.Names(); len(got) != 1 || got[0] != "Open" {
+			t.Errorf("Expected [Open], got %v", got)
+		}
+		if !errors.Is(g.Err(), ErrInvalidName) {
+			t.Errorf("Expected recorded ErrInvalidName, got %v", g.Err())
+		}
+
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected NewMapped to panic outside error mode")
+			}
+		}()
+		NewMapped(map[string]int{"Archived": 2}, opts()...)
+	})
+
+	t.Run("PopulateFrom", func(t *testing.T) {
+		g := newGen()
+		err := g.PopulateFrom(context.Background(), func(context.Context) (map[string]int, error) {
+			return map[string]int{"Held": 5, "Archived": 6}, nil
+		})
+		if !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		if g.Len() != 1 {
+			t.Errorf("Expected the Generator to be unchanged, got %d entries", g.Len())
+		}
+	})
+
+	t.Run("Deserialization", func(t *testing.T) {
+		for _, data := range []string{
+			`{"1":"Open","2":"Archived"}`,
+			`[{"value":1,"name":"Open"},{"value":2,"name":"open"}]`,
+		} {
+			g := newGen()
+			if err := g.UnmarshalJSON([]byte(data)); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("UnmarshalJSON(%s): expected ErrInvalidName, got %v", data, err)
+			}
+			if err := g.UnmarshalJSONMerge([]byte(data), MergeAllowAny); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("UnmarshalJSONMerge(%s): expected ErrInvalidName, got %v", data, err)
+			}
+			if got := g.Names(); len(got) != 1 || got[0] != "Open" {
+				t.Errorf("Expected the Generator to be unchanged, got %v", got)
+			}
+			if _, err := LoadJSON(strings.NewReader(data), opts()...); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("LoadJSON(%s): expected ErrInvalidName, got %v", data, err)
+			}
+		}
+		if _, err := LoadJSON(strings.NewReader(`{"1":"Open","2":"Held"}`), opts()...); err != nil {
+			t.Errorf("Expected valid input to load, got %v", err)
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		c := newGen().Clone()
+		if _, err := c.TryNext("Archived"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected the clone to keep the limits, got %v", err)
+		}
+	})
+}
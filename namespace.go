@@ -0,0 +1,187 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultSeparator separates the namespace from the name in qualified names
+// such as "payments.pending".
+const DefaultSeparator = "."
+
+// Namespaced manages a family of Generators keyed by namespace, for values that are
+// only unique within their namespace ("payments.pending" vs "orders.pending").
+// Generators are created on demand with a shared set of options. It is thread-safe.
+//
+// Example:
+//
+//	ns := NewNamespaced[int]("", WithStart(1))
+//	ns.In("payments").Next("pending") // 1
+//	ns.In("orders").Next("pending")   // 1
+//	v, err := ns.ParseQualified("orders.pending")
+type Namespaced[T TypesValue] struct {
+	mu     sync.RWMutex
+	spaces map[string]*Generator[T]
+	sep    string
+	opts   []Option[T]
+}
+
+// NewNamespaced creates an empty Namespaced whose Generators are created with opts.
+// Qualified names are split on sep, or on DefaultSeparator if sep is empty.
+func NewNamespaced[T TypesValue](sep string, opts ...Option[T]) *Namespaced[T] {
+	if sep == "" {
+		sep = DefaultSeparator
+	}
+	return &Namespaced[T]{spaces: make(map[string]*Generator[T]), sep: sep, opts: opts}
+}
+
+// In returns the Generator for namespace ns, creating it with the shared options
+// if it does not exist yet.
+//
+// Panics if ns is empty or contains the separator, since qualified names could not
+// be split unambiguously. Use TryIn to receive this condition as an error.
+func (n *Namespaced[T]) In(ns string) *Generator[T] {
+	g, err := n.TryIn(ns)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// TryIn is like In but returns an error instead of panicking.
+func (n *Namespaced[T]) TryIn(ns string) (*Generator[T], error) {
+	n.mu.RLock()
+	g, ok := n.spaces[ns]
+	n.mu.RUnlock()
+	if ok {
+		return g, nil
+	}
+	if ns == "" || strings.Contains(ns, n.sep) {
+		return nil, fmt.Errorf("enum: invalid namespace %q", ns)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if g, ok := n.spaces[ns]; ok {
+		return g, nil
+	}
+	g = NewGenerator(n.opts...)
+	n.spaces[ns] = g
+	return g, nil
+}
+
+// Lookup returns the Generator for namespace ns without creating it.
+func (n *Namespaced[T]) Lookup(ns string) (*Generator[T], bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	g, ok := n.spaces[ns]
+	return g, ok
+}
+
+// Namespaces returns the names of all namespaces, sorted alphabetically.
+func (n *Namespaced[T]) Namespaces() []string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	names := make([]string, 0, len(n.spaces))
+	for ns := range n.spaces {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseQualified splits s on the first separator into a namespace and a name or
+// value literal, and parses the latter with that namespace's Generator.
+//
+// Returns an error if s has no separator or the namespace does not exist.
+//
+// Example:
+//
+//	v, err := ns.ParseQualified("payments.pending")
+func (n *Namespaced[T]) ParseQualified(s string) (Value[T], error) {
+	ns, name, ok := strings.Cut(s, n.sep)
+	if !ok {
+		return Value[T]{}, fmt.Errorf("enum: %q is not qualified with a namespace", s)
+	}
+	g, ok := n.Lookup(ns)
+	if !ok {
+		return Value[T]{}, fmt.Errorf("enum: unknown namespace %q", ns)
+	}
+	return g.Parse(name)
+}
+
+// Qualify joins ns and name with the separator, the inverse of ParseQualified.
+func (n *Namespaced[T]) Qualify(ns, name string) string {
+	return ns + n.sep + name
+}
+
+// AllNamespaces calls fn for each namespace and its Generator, in alphabetical order,
+// stopping early if fn returns false. fn may use the Generators freely, including
+// calling In to create new namespaces; these are not visited.
+//
+// Example:
+//
+//	ns.AllNamespaces(func(name string, g *Generator[int]) bool {
+//	    fmt.Println(name, g.Names())
+//	    return true
+//	})
+func (n *Namespaced[T]) AllNamespaces(fn func(ns string, g *Generator[T]) bool) {
+	for _, ns := range n.Namespaces() {
+		g, _ := n.Lookup(ns)
+		if !fn(ns, g) {
+			return
+		}
+	}
+}
+
+// MarshalJSON implements json.Marshaler, serializing every namespace as a nested object
+// keyed by namespace name, e.g. {"orders":{"1":"pending"},"payments":{"1":"pending"}}.
+func (n *Namespaced[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	var err error
+	n.AllNamespaces(func(ns string, g *Generator[T]) bool {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(ns)
+		var data []byte
+		if data, err = g.MarshalJSON(); err != nil {
+			return false
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, loading each nested object written by
+// MarshalJSON into the Generator for its namespace (see Generator.UnmarshalJSON).
+// Namespaces missing from the JSON are left unchanged.
+func (n *Namespaced[T]) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for ns := range raw {
+		if ns == "" || strings.Contains(ns, n.sep) {
+			return fmt.Errorf("enum: invalid namespace %q", ns)
+		}
+	}
+	for ns, sub := range raw {
+		if err := n.In(ns).UnmarshalJSON(sub); err != nil {
+			return fmt.Errorf("enum: namespace %q: %w", ns, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNamespaced(t *testing.T) {
+	newFixture := func() *Namespaced[int] {
+		ns := NewNamespaced[int]("", WithStart(1))
+		ns.In("payments").Next("pending")
+		ns.In("payments").Next("settled")
+		ns.In("orders").Next("pending")
+		return ns
+	}
+
+	t.Run("In", func(t *testing.T) {
+		ns := newFixture()
+		if ns.In("orders") != ns.In("orders") {
+			t.Error("Expected In to return the same Generator")
+		}
+		if got, want := ns.Namespaces(), []string{"orders", "payments"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if _, ok := ns.Lookup("shipping"); ok {
+			t.Error("Expected Lookup not to create namespaces")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for namespace containing the separator")
+			}
+		}()
+		ns.In("a.b")
+	})
+
+	t.Run("ParseQualified", func(t *testing.T) {
+		ns := newFixture()
+		v, err := ns.ParseQualified("payments.settled")
+		if err != nil || v.Get() != 2 || v.String() != "settled" {
+			t.Errorf("Expected settled (2), got %v, err: %v", v, err)
+		}
+		if v, err := ns.ParseQualified("orders.1"); err != nil || v.String() != "pending" {
+			t.Errorf("Expected value literal to resolve, got %v, err: %v", v, err)
+		}
+		for _, s := range []string{"pending", "shipping.pending", "orders.settled"} {
+			if _, err := ns.ParseQualified(s); err == nil {
+				t.Errorf("Expected error for %q", s)
+			}
+		}
+		colon := NewNamespaced[int]("::")
+		colon.In("a").Next("x.y")
+		if v, err := colon.ParseQualified(colon.Qualify("a", "x.y")); err != nil || v.String() != "x.y" {
+			t.Errorf("Expected custom separator to work, got %v, err: %v", v, err)
+		}
+	})
+
+	t.Run("AllNamespaces", func(t *testing.T) {
+		ns := newFixture()
+		var visited []string
+		ns.AllNamespaces(func(name string, g *Generator[int]) bool {
+			visited = append(visited, name)
+			return g.Len() < 2
+		})
+		if want := []string{"orders", "payments"}; !reflect.DeepEqual(visited, want) {
+			t.Errorf("Expected %v, got %v", want, visited)
+		}
+		visited = nil
+		ns.AllNamespaces(func(name string, _ *Generator[int]) bool {
+			visited = append(visited, name)
+			return false
+		})
+		if len(visited) != 1 {
+			t.Errorf("Expected early stop, got %v", visited)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(newFixture())
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"orders":{"1":"pending"},"payments":{"1":"pending","2":"settled"}}`
+		if string(data) != want {
+			t.Errorf("Expected %s, got %s", want, data)
+		}
+		restored := NewNamespaced[int]("")
+		if err := json.Unmarshal(data, restored); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := restored.ParseQualified("payments.settled"); err != nil || v.Get() != 2 {
+			t.Errorf("Expected round trip, got %v, err: %v", v, err)
+		}
+		if err := json.Unmarshal([]byte(`{"a.b":{}}`), restored); err == nil {
+			t.Error("Expected error for invalid namespace")
+		}
+	})
+}
@@ -0,0 +1,7 @@
+//go:build noreflect
+
+package enum
+
+// reflectBuild reports whether the package is built with reflect, which converts
+// defined types of the same kind in ValidatorFunc.
+const reflectBuild = false
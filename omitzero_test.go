@@ -0,0 +1,39 @@
+//go:build go1.24
+
+package enum
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The omitzero tag option, which consults IsZero, needs Go 1.24.
+
+func TestValue_OmitZero(t *testing.T) {
+	zero := NewMapped(map[string]int{"Zero": 0}).MustParse("Zero")
+	type order struct {
+		ID     int        `json:"id"`
+		Status Value[int] `json:"status,omitzero"`
+	}
+	if data, _ := json.Marshal(order{ID: 1}); string(data) != `{"id":1}` {
+		t.Errorf("Expected unset field to be omitted, got %s", data)
+	}
+	if data, _ := json.Marshal(order{ID: 1, Status: zero}); string(data) != `{"id":1,"status":0}` {
+		t.Errorf("Expected set zero field to be kept, got %s", data)
+	}
+}
+
+func TestBasic_OmitZero(t *testing.T) {
+	status := NewBasicRegistry()
+	pending := status.Add("Pending") // value: 0
+	type order struct {
+		Status   Basic `json:"status"`
+		Previous Basic `json:"previous,omitzero"`
+	}
+	if data, _ := json.Marshal(order{Status: status.Empty()}); string(data) != `{"status":null}` {
+		t.Errorf("Expected the unset omitzero field omitted, got %s", data)
+	}
+	if data, _ := json.Marshal(order{Status: pending, Previous: pending}); string(data) != `{"status":0,"previous":0}` {
+		t.Errorf("Expected set zero values to be kept, got %s", data)
+	}
+}
@@ -0,0 +1,179 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+)
+
+// WithSortedEntries makes NewMapped store its entries ordered by value, then by name,
+// instead of in map iteration order, so that Values, Names, CanonicalBytes, the
+// exporters, and the canonical name of a shared value (the first name in that order)
+// are the same in every run. Other constructors ignore it.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Large": 100, "Small": 1}, WithSortedEntries[int]())
+//	g.Names() // [Small Large]
+func WithSortedEntries[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.sortMapped = true
+	}
+}
+
+// SortByValue reorders the Generator's entries by ascending value, affecting every
+// order-sensitive accessor (Values, Names, CanonicalBytes, verbose JSON) from then on.
+// Numeric values are ordered numerically and strings lexicographically. The sort is
+// stable, so entries sharing a value keep their relative order. Lookup maps are untouched.
+// It is thread-safe, using a write lock to protect state modifications.
+func (g *Generator[T]) SortByValue() {
+	g.lock()
+	defer g.unlock()
+	sort.SliceStable(g.values, func(i, j int) bool {
+		return g.values[i].value < g.values[j].value
+	})
+	g.queueJournalLocked(journalLine{Op: "sort", By: "value"})
+	g.bump()
+}
+
+// SortByName reorders the Generator's entries lexicographically by name, affecting
+// every order-sensitive accessor from then on. The sort is stable and lookup maps are
+// untouched. It is thread-safe, using a write lock to protect state modifications.
+func (g *Generator[T]) SortByName() {
+	g.lock()
+	defer g.unlock()
+	sort.SliceStable(g.values, func(i, j int) bool {
+		return g.values[i].name < g.values[j].name
+	})
+	g.queueJournalLocked(journalLine{Op: "sort", By: "name"})
+	g.bump()
+}
+
+// ValuesAtLeast returns the entries whose value is at or above lo, sorted by ascending
+// value regardless of entry order. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Debug": 0, "Info": 1, "Warn": 2, "Error": 3})
+//	g.ValuesAtLeast(2) // [{2 Warn} {3 Error}]
+func (g *Generator[T]) ValuesAtLeast(lo T) []Value[T] {
+	return g.valuesWhere(func(v T) bool { return v >= lo })
+}
+
+// ValuesAtMost returns the entries whose value is at or below hi, sorted by ascending
+// value. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ValuesAtMost(hi T) []Value[T] {
+	return g.valuesWhere(func(v T) bool { return v <= hi })
+}
+
+// ValuesBetween returns the entries whose value lies in the inclusive range [lo, hi],
+// sorted by ascending value. It returns an empty slice if lo > hi.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ValuesBetween(lo, hi T) []Value[T] {
+	return g.valuesWhere(func(v T) bool { return v >= lo && v <= hi })
+}
+
+// AtLeast reports whether value belongs to the enum set and is at or above threshold.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	if levels.AtLeast(msgLevel, warn) {
+//		alert(msg)
+//	}
+func (g *Generator[T]) AtLeast(value, threshold T) bool {
+	return value >= threshold && g.Contains(value)
+}
+
+// valuesWhere returns the entries whose value satisfies keep, stably sorted by value.
+func (g *Generator[T]) valuesWhere(keep func(T) bool) []Value[T] {
+	var out []Value[T]
+	if g.parent != nil {
+		// Filter the merged entries of an overlay in place, as Values returns a copy.
+		entries := g.Values()
+		out = entries[:0]
+		for _, entry := range entries {
+			if keep(entry.value) {
+				out = append(out, entry)
+			}
+		}
+	} else {
+		g.rlock()
+		out = make([]Value[T], 0, len(g.values))
+		for _, entry := range g.values {
+			if keep(entry.value) {
+				out = append(out, entry)
+			}
+		}
+		g.runlock()
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].value < out[j].value
+	})
+	return out
+}
+
+// Clamp returns value limited to the inclusive range [lo, hi]: lo if value is below it,
+// hi if value is above it, and value unchanged otherwise. It is meant for ordered enums
+// such as log levels, e.g. "one level more verbose than X, but not past Debug".
+// It is thread-safe, using a read lock for access.
+//
+// Panics if lo or hi is not registered or lo > hi, as clamping to bounds outside the
+// enum is a programming error; under WithErrorMode, Clamp records the error and returns
+// value unchanged.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Debug": 0, "Info": 1, "Warn": 2, "Error": 3})
+//	g.Clamp(7, 1, 2) // 2
+func (g *Generator[T]) Clamp(value, lo, hi T) T {
+	g.rlock()
+	_, hasLo := g.valueMap[lo]
+	_, hasHi := g.valueMap[hi]
+	g.runlock()
+	switch {
+	case !hasLo:
+		g.raise(fmt.Errorf("enum: Clamp bound %s is not registered", g.formatValue(lo)))
+		return value
+	case !hasHi:
+		g.raise(fmt.Errorf("enum: Clamp bound %s is not registered", g.formatValue(hi)))
+		return value
+	case lo > hi:
+		g.raise(fmt.Errorf("enum: Clamp bounds out of order: %s > %s", g.formatValue(lo), g.formatValue(hi)))
+		return value
+	case value < lo:
+		return lo
+	case value > hi:
+		return hi
+	}
+	return value
+}
+
+// Offset returns the entry n positions away from value in ascending value order,
+// regardless of entry order: Offset(v, 1) is the next larger value and Offset(v, -1)
+// the next smaller one. Values with several names count once and are returned under
+// their canonical name. It is thread-safe, using a read lock for access.
+//
+// Returns false if value is not registered or the offset moves past either end.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Debug": 0, "Info": 10, "Warn": 20, "Error": 30})
+//	v, ok := g.Offset(20, -1) // {10 Info}, true
+//	_, ok = g.Offset(0, -1)   // ok == false
+func (g *Generator[T]) Offset(value T, n int) (Value[T], bool) {
+	g.rlock()
+	defer g.runlock()
+	if _, ok := g.valueMap[value]; !ok {
+		return Value[T]{}, false
+	}
+	sorted := make([]T, 0, len(g.valueMap))
+	for v := range g.valueMap {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := sort.Search(len(sorted), func(i int) bool { return sorted[i] >= value }) + n
+	if i < 0 || i >= len(sorted) {
+		return Value[T]{}, false
+	}
+	return g.entry(sorted[i], g.valueMap[sorted[i]]), true
+}
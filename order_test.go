@@ -0,0 +1,182 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_Sort(t *testing.T) {
+	t.Run("Numeric", func(t *testing.T) {
+		g := NewGenerator[int](WithStart(3), WithIncrementer(func(i int) int { return i - 1 }))
+		g.Next("Charlie")
+		g.Next("Bravo")
+		g.Next("Alpha")
+		g.Next("Delta") // 0
+
+		g.SortByValue()
+		if !reflect.DeepEqual(g.Names(), []string{"Delta", "Alpha", "Bravo", "Charlie"}) {
+			t.Errorf("Unexpected order after SortByValue: %v", g.Names())
+		}
+		g.SortByName()
+		if !reflect.DeepEqual(g.Names(), []string{"Alpha", "Bravo", "Charlie", "Delta"}) {
+			t.Errorf("Unexpected order after SortByName: %v", g.Names())
+		}
+		if name, _ := g.Name(3); name != "Charlie" {
+			t.Errorf("Expected lookups to be unaffected, got %q", name)
+		}
+	})
+
+	t.Run("Stable with shared values", func(t *testing.T) {
+		g := NewGenerator[int](WithIncrementer(func(i int) int { return 0 }))
+		g.Next("Z")
+		g.Next("Y")
+		g.Next("X")
+		g.SortByValue()
+		if !reflect.DeepEqual(g.Names(), []string{"Z", "Y", "X"}) {
+			t.Errorf("Expected stable order for equal values, got %v", g.Names())
+		}
+	})
+
+	t.Run("Strings lexicographic", func(t *testing.T) {
+		g := NewMapped(map[string]string{"One": "b", "Two": "a", "Three": "c"})
+		g.SortByName()
+		if !reflect.DeepEqual(g.Names(), []string{"One", "Three", "Two"}) {
+			t.Errorf("Unexpected order after SortByName: %v", g.Names())
+		}
+		g.SortByValue()
+		vals := g.Values()
+		if vals[0].Get() != "a" || vals[2].Get() != "c" {
+			t.Errorf("Unexpected order after SortByValue: %v", vals)
+		}
+	})
+}
+
+func TestGenerator_Ranges(t *testing.T) {
+	// Insertion order differs from value order on purpose.
+	g := NewMapped(map[string]int{"Error": 3, "Debug": 0, "Warn": 2, "Info": 1})
+	g.SortByName()
+	names := func(vals []Value[int]) []string {
+		out := make([]string, len(vals))
+		for i, v := range vals {
+			out[i] = v.String()
+		}
+		return out
+	}
+
+	if got := names(g.ValuesAtLeast(2)); !reflect.DeepEqual(got, []string{"Warn", "Error"}) {
+		t.Errorf("ValuesAtLeast: got %v", got)
+	}
+	if got := names(g.ValuesAtMost(1)); !reflect.DeepEqual(got, []string{"Debug", "Info"}) {
+		t.Errorf("ValuesAtMost: got %v", got)
+	}
+	if got := names(g.ValuesBetween(1, 2)); !reflect.DeepEqual(got, []string{"Info", "Warn"}) {
+		t.Errorf("ValuesBetween: got %v", got)
+	}
+	if got := g.ValuesBetween(3, 1); len(got) != 0 {
+		t.Errorf("Expected empty range, got %v", got)
+	}
+	if !g.AtLeast(3, 2) || g.AtLeast(1, 2) || g.AtLeast(9, 2) {
+		t.Error("AtLeast returned unexpected results")
+	}
+
+	t.Run("Basic", func(t *testing.T) {
+		b := NewBasicRegistry()
+		low, high := b.Add("Low"), b.Add("High")
+		if !high.AtLeast(low) || low.AtLeast(high) || !low.AtLeast(low) {
+			t.Error("Basic.AtLeast returned unexpected results")
+		}
+		other := NewBasicRegistry().Add("Other")
+		if other.AtLeast(low) {
+			t.Error("Expected values from different registries to be incomparable")
+		}
+	})
+}
+
+func TestGenerator_Clamp(t *testing.T) {
+	// Inserted out of value order, with gaps between values.
+	g := mustFromValues(t, NewValue(30, "Error"), NewValue(0, "Debug"), NewValue(20, "Warn"), NewValue(10, "Info"))
+
+	tests := []struct{ value, lo, hi, want int }{
+		{5, 10, 20, 10},
+		{25, 10, 20, 20},
+		{15, 10, 20, 15},
+		{10, 10, 20, 10},
+		{-1, 0, 30, 0},
+		{20, 20, 20, 20},
+	}
+	for _, tt := range tests {
+		if got := g.Clamp(tt.value, tt.lo, tt.hi); got != tt.want {
+			t.Errorf("Clamp(%d, %d, %d): expected %d, got %d", tt.value, tt.lo, tt.hi, tt.want, got)
+		}
+	}
+
+	t.Run("InvalidBounds", func(t *testing.T) {
+		for _, bounds := range [][2]int{{5, 20}, {10, 25}, {20, 10}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected panic for bounds %v", bounds)
+					}
+				}()
+				g.Clamp(15, bounds[0], bounds[1])
+			}()
+		}
+	})
+
+	t.Run("ErrorMode", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Debug": 0, "Error": 30}, WithErrorMode[int]())
+		if got := g.Clamp(40, 0, 25); got != 40 {
+			t.Errorf("Expected value unchanged, got %d", got)
+		}
+		if g.Err() == nil {
+			t.Error("Expected the error to be recorded")
+		}
+	})
+}
+
+func TestGenerator_Offset(t *testing.T) {
+	// Entry order Error, Debug, Warn, Info differs from value order 0, 10, 20, 30.
+	g := mustFromValues(t, NewValue(30, "Error"), NewValue(0, "Debug"), NewValue(20, "Warn"), NewValue(10, "Info"))
+
+	tests := []struct {
+		value, n int
+		want     string
+		ok       bool
+	}{
+		{20, -1, "Info", true},
+		{20, 1, "Error", true},
+		{0, 3, "Error", true},
+		{30, -3, "Debug", true},
+		{10, 0, "Info", true},
+		{0, -1, "", false},
+		{30, 1, "", false},
+		{10, 5, "", false},
+		{15, 1, "", false}, // Not registered.
+	}
+	for _, tt := range tests {
+		v, ok := g.Offset(tt.value, tt.n)
+		if ok != tt.ok || v.name != tt.want {
+			t.Errorf("Offset(%d, %d): expected (%q, %t), got (%q, %t)", tt.value, tt.n, tt.want, tt.ok, v.name, ok)
+		}
+	}
+
+	t.Run("SharedValues", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Low": 0, "High": 1, "Upper": 1}, WithSortedEntries[int]())
+		if v, ok := g.Offset(0, 1); !ok || v.name != "High" {
+			t.Errorf("Expected the canonical name High, got %v, %t", v, ok)
+		}
+		if _, ok := g.Offset(0, 2); ok {
+			t.Error("Expected a shared value to count once")
+		}
+	})
+}
+
+// mustFromValues builds a Generator keeping the given entry order.
+func mustFromValues(t *testing.T, values ...Value[int]) *Generator[int] {
+	t.Helper()
+	g, err := NewFromValues(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
@@ -0,0 +1,56 @@
+package enum
+
+// OverflowPolicy controls how a Generator behaves when its incrementer overflows
+// the underlying integer type.
+type OverflowPolicy int
+
+const (
+	// OverflowWrap lets the incrementer wrap around silently (e.g., int8 127 -> -128).
+	// This is the default.
+	OverflowWrap OverflowPolicy = iota
+	// OverflowSaturate stops the sequence at its last representable value, so
+	// subsequent calls to Next reuse that value: every name added after saturation
+	// shares it with the entry that reached it (see NamesOfValue). It therefore
+	// requires a set that allows shared values; under WithBijective, Next fails once
+	// the sequence has saturated. Use OverflowError to stop adding entries instead.
+	OverflowSaturate
+	// OverflowError makes Next panic and TryNext return ErrExhausted once the
+	// sequence overflows or would produce a value that is already used.
+	OverflowError
+)
+
+// WithOverflowPolicy sets how the Generator handles integer overflow in its sequence.
+// Overflow is detected for integer types when the incremented value is not greater
+// than the current one, which covers the default incrementer and NewBitFlagGenerator
+// at every integer width. Custom incrementers that intentionally decrease or cycle
+// should keep the default OverflowWrap policy.
+//
+// Example:
+//
+//	g := NewGenerator[int8](WithStart[int8](127), WithOverflowPolicy[int8](OverflowError))
+//	g.Next("Max")            // Value[int8]{value: 127, name: "Max"}
+//	_, err := g.TryNext("X") // err wraps ErrExhausted
+func WithOverflowPolicy[T TypesValue](p OverflowPolicy) Option[T] {
+	return func(g *Generator[T]) {
+		g.overflow = p
+	}
+}
+
+// advance moves current to the next value in the sequence, applying the overflow policy.
+// The caller must hold the write lock.
+func (g *Generator[T]) advance() {
+	next := g.incrementer(g.current)
+	if g.overflow == OverflowWrap || !isInteger[T]() || next > g.current {
+		g.current = next
+		return
+	}
+	if g.overflow == OverflowError {
+		g.exhausted = true
+	}
+	// Under OverflowSaturate, current stays at its last representable value.
+}
+
+// isInteger reports whether T has a signed or unsigned integer kind.
+func isInteger[T TypesValue]() bool {
+	return kindOf[T]().IsInteger()
+}
@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestOverflowPolicy(t *testing.T) {
+	t.Run("Wrap int8 by default", func(t *testing.T) {
+		g := NewGenerator[int8](WithStart[int8](math.MaxInt8))
+		g.Next("Max")
+		if v := g.Next("Wrapped"); v.Get() != math.MinInt8 {
+			t.Errorf("Expected wrap to %d, got %d", math.MinInt8, v.Get())
+		}
+	})
+
+	t.Run("Error int8", func(t *testing.T) {
+		g := NewGenerator[int8](WithStart[int8](math.MaxInt8-1), WithOverflowPolicy[int8](OverflowError))
+		g.Next("A")
+		if v, err := g.TryNext("Max"); err != nil || v.Get() != math.MaxInt8 {
+			t.Fatalf("Expected %d, got %d, err: %v", math.MaxInt8, v.Get(), err)
+		}
+		if _, err := g.TryNext("Over"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted, got %v", err)
+		}
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !errors.Is(err, ErrExhausted) {
+				t.Errorf("Expected Next to panic with ErrExhausted, got %v", r)
+			}
+		}()
+		g.Next("Over")
+	})
+
+	t.Run("Error uint8", func(t *testing.T) {
+		g := NewGenerator[uint8](WithStart[uint8](math.MaxUint8), WithOverflowPolicy[uint8](OverflowError))
+		if _, err := g.TryNext("Max"); err != nil {
+			t.Fatalf("Expected max value to succeed, got %v", err)
+		}
+		if _, err := g.TryNext("Over"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted, got %v", err)
+		}
+	})
+
+	t.Run("Error int64", func(t *testing.T) {
+		g := NewGenerator[int64](WithStart[int64](math.MaxInt64), WithOverflowPolicy[int64](OverflowError))
+		if v, err := g.TryNext("Max"); err != nil || v.Get() != math.MaxInt64 {
+			t.Fatalf("Expected max value, got %d, err: %v", v.Get(), err)
+		}
+		if _, err := g.TryNext("Over"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted, got %v", err)
+		}
+	})
+
+	t.Run("Saturate uint8", func(t *testing.T) {
+		g := NewGenerator[uint8](WithStart[uint8](math.MaxUint8-1), WithOverflowPolicy[uint8](OverflowSaturate))
+		g.Next("A")
+		g.Next("Max")
+		if v := g.Next("Again"); v.Get() != math.MaxUint8 {
+			t.Errorf("Expected saturation at %d, got %d", math.MaxUint8, v.Get())
+		}
+		if names := g.NamesOfValue(math.MaxUint8); !reflect.DeepEqual(names, []string{"Max", "Again"}) {
+			t.Errorf("Expected saturated names to share the value, got %v", names)
+		}
+		if name, _ := g.Name(math.MaxUint8); name != "Max" {
+			t.Errorf("Expected the first name to stay canonical, got %q", name)
+		}
+	})
+
+	t.Run("Saturate bijective", func(t *testing.T) {
+		g := NewGenerator[uint8](WithStart[uint8](math.MaxUint8), WithOverflowPolicy[uint8](OverflowSaturate), WithBijective[uint8]())
+		g.Next("Max")
+		if _, err := g.TryNext("Again"); !errors.Is(err, ErrNotBijective) {
+			t.Errorf("Expected ErrNotBijective once saturated, got %v", err)
+		}
+		if g.ContainsName("Again") {
+			t.Error("Expected the rejected name not to be added")
+		}
+	})
+
+	t.Run("Error bit flags", func(t *testing.T) {
+		g := NewBitFlagGenerator[int8](1)
+		WithOverflowPolicy[int8](OverflowError)(g)
+		for i := 0; i < 7; i++ {
+			if _, err := g.TryNext(string(rune('A' + i))); err != nil {
+				t.Fatalf("Flag %d failed: %v", i, err)
+			}
+		}
+		if _, err := g.TryNext("Over"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted after 7 int8 flags, got %v", err)
+		}
+	})
+
+	t.Run("Error on reused value", func(t *testing.T) {
+		g := NewCyclic(2)
+		WithOverflowPolicy[int](OverflowError)(g)
+		g.Next("Zero")
+		g.Next("One")
+		if _, err := g.TryNext("Two"); !errors.Is(err, ErrExhausted) {
+			t.Errorf("Expected ErrExhausted, got %v", err)
+		}
+	})
+
+	t.Run("Strings are unaffected", func(t *testing.T) {
+		g := NewGenerator[string](WithStart("Z"), WithOverflowPolicy[string](OverflowError))
+		g.Next("Z")
+		if v, err := g.TryNext("AA"); err != nil || v.Get() != "AA" {
+			t.Errorf("Expected AA, got %q, err: %v", v.Get(), err)
+		}
+	})
+
+	t.Run("TryNext errors", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 1})
+		if _, err := g.TryNext("B"); err == nil {
+			t.Error("Expected error on mapped generator")
+		}
+		n := NewGenerator[int]()
+		n.Next("A")
+		if _, err := n.TryNext("A"); err == nil {
+			t.Error("Expected error on duplicate name")
+		}
+	})
+}
@@ -0,0 +1,253 @@
+package enum
+
+import (
+	"container/list"
+	"fmt"
+)
+
+// WithOverlayStart sets the first value Next allocates in overlays of the Generator
+// (see Overlay), which must lie beyond any value the Generator itself will reach.
+// Without it, overlays start at a default far from the usual sequences: 1<<(bits-2) for
+// signed integers (e.g., 1<<62 for int, 64 for int8), 1<<(bits-1) for unsigned integers,
+// 1<<22 for float32, 1<<51 for float64, and "AAAAAAAA" for strings.
+//
+// Example:
+//
+//	base := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithOverlayStart(1000))
+//	v := base.Overlay().Next("OnHold") // Value[int]{value: 1000, name: "OnHold"}
+func WithOverlayStart[T TypesValue](start T) Option[T] {
+	return func(g *Generator[T]) {
+		g.overlayStart = start
+		g.hasOverlay = true
+	}
+}
+
+// Overlay returns a child Generator that starts empty and answers lookups from its own
+// entries first, falling through to g for everything else, so many variants of one base
+// enum (e.g., one per tenant) share the base entries instead of each holding a Clone.
+// Next on the overlay allocates values from the range set by WithOverlayStart, using
+// g's incrementer (the default one if g was created with NewMapped), so they do not
+// collide with values g adds later. Names, aliases, and values g already has are rejected
+// on every path that adds a name to the overlay (Next, Rename, TryAddAlias, PopulateFrom,
+// Reload, ...).
+//
+// The base is meant to stay fixed apart from additions: entries g gains later show
+// through every overlay, except where an overlay has an entry of the same name, which
+// wins. Name, NamesOfValue, Get, GetValue, Contains, ContainsName, ContainsAll,
+// ContainsAnyName, Validate, ValidateName, Parse, NearestValue, Values, ValuesBetween
+// (and its variants), ValidValues, ValueMap, NameMap, Names, and Len see the merged
+// view; Values and Names list the base entries in g's order, then the overlay's own. Len
+// is computed from the merged entries, so it is not constant-time on an overlay. Remove and Rename only reach the overlay's own
+// entries, and every other method (display names, MarshalJSON, Snapshot, exports, ...)
+// sees them alone, which is what a tenant needs to persist its delta. TryAddAlias and
+// Aliases accept names of g, so an overlay can add its own aliases for base entries.
+//
+// The overlay copies g's incrementer, overflow policy, name checks and matcher,
+// validators, version comparator, formatting, JSON encoding options, OmitUnknown, label,
+// and logger. Options with per-set state start fresh: under WithErrorMode it records its
+// own errors, under WithStats it counts its own uses, under WithNameArena it stores its
+// own names, and under WithEviction it evicts its own GetOrAdd entries, with g's bound
+// and callback. Under WithProvenance it gets its own ID, and entries of g it returns are
+// tagged with it. WithFastContains is not copied, since the overlay's filter would only
+// know its own values. Overlays of overlays are not supported.
+// It is thread-safe, using a read lock for access.
+//
+// Panics if g is itself an overlay.
+//
+// Example:
+//
+//	base := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int](), WithOverlayStart(1000))
+//	tenant := base.Overlay()
+//	tenant.Next("OnHold")           // Value[int]{value: 1000, name: "OnHold"}
+//	v, _ := tenant.Parse("Active")  // Value[int]{value: 2, name: "Active"}, from base
+//	fmt.Println(tenant.Names())     // Output: [Pending Active OnHold]
+func (g *Generator[T]) Overlay() *Generator[T] {
+	if g.parent != nil {
+		panic("enum: Overlay of an overlay is not supported; create overlays of the base Generator")
+	}
+	g.rlock()
+	defer g.runlock()
+
+	start := g.overlayStart
+	if !g.hasOverlay {
+		start = defaultOverlayStart[T]()
+	}
+	c := &Generator[T]{
+		parent:       g,
+		current:      start,
+		start:        start,
+		incrementer:  g.incrementer,
+		incKind:      g.incKind,
+		valueMap:     make(map[T]string),
+		nameMap:      make(map[string]T),
+		overflow:     g.overflow,
+		bijective:    g.bijective,
+		sqlNames:     g.sqlNames,
+		emptyName:    g.emptyName,
+		literalCheck: g.literalCheck,
+		validators:   g.validators,
+		nameLimits:   g.nameLimits,
+		matcher:      g.matcher,
+		omitUnknown:  g.omitUnknown,
+		versionCmp:   g.versionCmp,
+		label:        g.label,
+		logger:       g.logger,
+		runes:        g.runes,
+		formatter:    g.formatter,
+		marshal:      g.marshal,
+		kind:         g.kind,
+	}
+	if c.incrementer == nil {
+		c.incrementer = defaultIncrementer[T]
+		c.incKind = IncrementerNumeric
+		if isString[T]() {
+			c.incKind = IncrementerAlpha
+		}
+	}
+	if g.origin != 0 {
+		c.origin = nextProvenanceID()
+	}
+	if g.matcher != nil {
+		c.normalized = make(map[string]string)
+	}
+	if g.errs != nil {
+		c.errs = &errorLog{}
+	}
+	if g.stats != nil {
+		c.stats = &stats[T]{}
+	}
+	if g.arena != nil {
+		c.arena = &nameArena{}
+	}
+	if g.evict != nil {
+		c.evict = &evictor[T]{max: g.evict.max, onEvict: g.evict.onEvict, order: list.New(), elems: make(map[string]*list.Element)}
+	}
+	c.self.Store(c)
+	return c
+}
+
+// Parent returns the Generator an overlay falls through to, or nil if g is not an
+// overlay. It is lock-free and thread-safe.
+func (g *Generator[T]) Parent() *Generator[T] {
+	return g.parent
+}
+
+// defaultOverlayStart returns the first value of overlay sequences without
+// WithOverlayStart, as documented there.
+func defaultOverlayStart[T TypesValue]() T {
+	bits := bitsOf[T]()
+	switch kindOf[T]().Class {
+	case ClassString:
+		return fromString[T]("AAAAAAAA")
+	case ClassInt:
+		start, _ := fromInt[T](1 << (bits - 2))
+		return start
+	case ClassUint:
+		start, _ := fromUint[T](1 << (bits - 1))
+		return start
+	default:
+		if bits == 32 {
+			return fromFloat[T](1 << 22) // Leaves room below 1<<24, where float32 stops counting by one.
+		}
+		return fromFloat[T](1 << 51)
+	}
+}
+
+// checkParent fails if the parent of an overlay already has name or value, which a new
+// entry of the overlay would shadow or duplicate (see checkParentName). It takes the
+// parent's read lock once; the caller may hold the overlay's lock.
+func (g *Generator[T]) checkParent(name string, value T) error {
+	if g.parent == nil {
+		return nil
+	}
+	p := g.parent
+	p.rlock()
+	defer p.runlock()
+	if err := g.checkParentNameLocked(name); err != nil {
+		return err
+	}
+	if existing, ok := p.valueMap[value]; ok {
+		return fmt.Errorf("enum: value %s already used for %q in the parent; use WithOverlayStart beyond the parent's values", g.formatValue(value), existing)
+	}
+	return nil
+}
+
+// checkParentName fails if name is a name or alias of the parent of an overlay, or
+// matches one under the name matcher, so that a new name or alias of the overlay would
+// shadow it. The caller may hold the overlay's lock.
+func (g *Generator[T]) checkParentName(name string) error {
+	if g.parent == nil {
+		return nil
+	}
+	g.parent.rlock()
+	defer g.parent.runlock()
+	return g.checkParentNameLocked(name)
+}
+
+// checkParentNameLocked implements checkParentName. The caller must hold the parent's
+// read lock.
+func (g *Generator[T]) checkParentNameLocked(name string) error {
+	p := g.parent
+	if existing, ok := p.nameMap[name]; ok {
+		return fmt.Errorf("enum: name %q already exists in the parent with value %s", name, g.formatValue(existing))
+	}
+	if existing, ok := p.aliases[name]; ok {
+		return fmt.Errorf("enum: name %q is already an alias of %q in the parent", name, p.valueMap[existing])
+	}
+	if g.matcher != nil && p.matcher != nil {
+		if other, ok := p.normalized[p.matcher.Normalize(name)]; ok {
+			return fmt.Errorf("%w: name %q matches %q in the parent after normalization", ErrInvalidName, name, other)
+		}
+	}
+	return nil
+}
+
+// parentName resolves s as a registered name of the parent of an overlay, the common
+// case of Parse and Get on an overlay, under a single acquisition of the parent's lock
+// and without building the errors of a full parse. If touch is set, it marks the entry
+// as used under the parent's WithEviction, as Get on the parent does.
+func (g *Generator[T]) parentName(s string, touch bool) (Value[T], bool) {
+	p := g.parent
+	p.rlock()
+	defer p.runlock()
+	val, ok := p.nameMap[s]
+	if ok && touch && p.evict != nil {
+		p.evict.touch(s)
+	}
+	return g.entry(val, s), ok
+}
+
+// parentVersion returns the Version of the parent of an overlay, and 0 for other
+// Generators.
+func (g *Generator[T]) parentVersion() uint64 {
+	if g.parent == nil {
+		return 0
+	}
+	return g.parent.Version()
+}
+
+// parentCanonical returns the canonical name of value in the parent of an overlay, for
+// aliases of the overlay that point at the parent's entries. The caller may hold the
+// overlay's lock.
+func (g *Generator[T]) parentCanonical(value T) string {
+	if g.parent == nil {
+		return ""
+	}
+	name, _ := g.parent.Name(value)
+	return name
+}
+
+// overlayValues returns the merged entries of an overlay: the parent's, minus those
+// shadowed by a name of the overlay, followed by the overlay's own.
+func (g *Generator[T]) overlayValues() []Value[T] {
+	inherited := g.parent.Values()
+	g.rlock()
+	defer g.runlock()
+	merged := inherited[:0]
+	for _, v := range inherited {
+		if _, shadowed := g.nameMap[v.name]; !shadowed {
+			merged = append(merged, v)
+		}
+	}
+	return append(g.adopt(merged), g.values...)
+}
@@ -0,0 +1,347 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Overlay(t *testing.T) {
+	newBase := func() *Generator[int] {
+		return NewMapped(map[string]int{"Pending": 1, "Active": 2, "Done": 3}, WithOverlayStart(1000))
+	}
+
+	t.Run("FallsThrough", func(t *testing.T) {
+		base := newBase()
+		tenant := base.Overlay()
+		if v := tenant.Next("OnHold"); v.Get() != 1000 {
+			t.Errorf("Expected the overlay range to start at 1000, got %d", v.Get())
+		}
+		if v := tenant.Next("Escalated"); v.Get() != 1001 {
+			t.Errorf("Expected 1001, got %d", v.Get())
+		}
+		if name, ok := tenant.Name(2); !ok || name != "Active" {
+			t.Errorf("Expected Active from the base, got %q, %v", name, ok)
+		}
+		if v, ok := tenant.Get("OnHold"); !ok || v != 1000 {
+			t.Errorf("Expected OnHold from the overlay, got %d, %v", v, ok)
+		}
+		for _, s := range []string{"Active", "2", "OnHold", "1000"} {
+			if _, err := tenant.Parse(s); err != nil {
+				t.Errorf("Parse(%q): %v", s, err)
+			}
+		}
+		if !tenant.Contains(3) || !tenant.ContainsName("Done") || tenant.Validate(1) != nil || tenant.ValidateName("Pending") != nil {
+			t.Error("Expected base entries to be visible")
+		}
+		if base.Contains(1000) || base.ContainsName("OnHold") {
+			t.Error("Expected the base not to see overlay entries")
+		}
+		if tenant.Contains(7) || tenant.Validate(7) == nil {
+			t.Error("Expected unknown values to be rejected")
+		}
+		if tenant.Parent() != base || base.Parent() != nil {
+			t.Error("Unexpected Parent")
+		}
+	})
+
+	t.Run("MergedViews", func(t *testing.T) {
+		base := NewGenerator[int](WithOverlayStart(100))
+		base.Next("A")
+		base.Next("B")
+		tenant := base.Overlay()
+		tenant.Next("X")
+		base.Next("C") // Added after the overlay was made, and still visible.
+
+		if got, want := tenant.Names(), []string{"A", "B", "C", "X"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Names = %v, want %v", got, want)
+		}
+		want := []Value[int]{NewValue(0, "A"), NewValue(1, "B"), NewValue(2, "C"), NewValue(100, "X")}
+		for i := 0; i < 3; i++ { // Deterministic across calls.
+			if got := tenant.Values(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Values = %v, want %v", got, want)
+			}
+		}
+		if tenant.Len() != 4 || base.Len() != 3 {
+			t.Errorf("Expected lengths 4 and 3, got %d and %d", tenant.Len(), base.Len())
+		}
+	})
+
+	t.Run("Shadowing", func(t *testing.T) {
+		base := NewGenerator[int](WithOverlayStart(100))
+		base.Next("A")
+		tenant := base.Overlay()
+		tenant.Next("Custom")
+		base.Next("Custom") // The base later adds a name the tenant already has.
+
+		if v, _ := tenant.Get("Custom"); v != 100 {
+			t.Errorf("Expected the overlay entry to win, got %d", v)
+		}
+		if got, want := tenant.Names(), []string{"A", "Custom"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Names = %v, want %v", got, want)
+		}
+		if name, _ := tenant.Name(1); name != "Custom" {
+			t.Errorf("Expected the base value to stay reachable, got %q", name)
+		}
+	})
+
+	t.Run("Collisions", func(t *testing.T) {
+		base := newBase()
+		tenant := base.Overlay()
+		if _, err := tenant.TryNext("Active"); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("Expected a base name to be rejected, got %v", err)
+		}
+		low := NewMapped(map[string]int{"A": 5}, WithOverlayStart(5)).Overlay()
+		if _, err := low.TryNext("B"); err == nil || !strings.Contains(err.Error(), "WithOverlayStart") {
+			t.Errorf("Expected a base value to be rejected, got %v", err)
+		}
+		if err := tenant.Remove("Active"); err == nil {
+			t.Error("Expected base entries not to be removable through the overlay")
+		}
+		if !base.ContainsName("Active") {
+			t.Error("Expected the base to be unchanged")
+		}
+	})
+
+	t.Run("EveryPathChecksTheParent", func(t *testing.T) {
+		tenant := newBase().Overlay()
+		tenant.Next("OnHold")
+		if err := tenant.Rename("OnHold", "Active"); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("Rename: expected a base name to be rejected, got %v", err)
+		}
+		if err := tenant.TryAddAlias("OnHold", "Done"); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("TryAddAlias: expected a base name to be rejected, got %v", err)
+		}
+		source := func(context.Context) (map[string]int, error) { return map[string]int{"Pending": 2000}, nil }
+		if err := tenant.PopulateFrom(context.Background(), source); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("PopulateFrom: expected a base name to be rejected, got %v", err)
+		}
+		source = func(context.Context) (map[string]int, error) { return map[string]int{"Late": 3}, nil }
+		if err := tenant.PopulateFrom(context.Background(), source); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("PopulateFrom: expected a base value to be rejected, got %v", err)
+		}
+		if _, err := tenant.Reload([]byte(`{"2000": "Done"}`)); err == nil || !strings.Contains(err.Error(), "in the parent") {
+			t.Errorf("Reload: expected a base name to be rejected, got %v", err)
+		}
+		if !tenant.ContainsName("OnHold") || tenant.ContainsName("Late") || tenant.Len() != 4 {
+			t.Error("Expected the overlay to be unchanged")
+		}
+	})
+
+	t.Run("AliasesOfBaseNames", func(t *testing.T) {
+		base := newBase()
+		tenant := base.Overlay()
+		if err := tenant.TryAddAlias("Active", "Live"); err != nil {
+			t.Fatalf("TryAddAlias: %v", err)
+		}
+		if v, err := tenant.Parse("Live"); err != nil || v.Get() != 2 || v.String() != "Active" {
+			t.Errorf("Expected Live to resolve to the base entry Active, got %v, %v", v, err)
+		}
+		if got := tenant.Aliases("Active"); !reflect.DeepEqual(got, []string{"Live"}) {
+			t.Errorf("Aliases = %v, want [Live]", got)
+		}
+		if _, err := base.Parse("Live"); err == nil {
+			t.Error("Expected the base not to see aliases of the overlay")
+		}
+	})
+
+	t.Run("GetValueOfBaseNames", func(t *testing.T) {
+		base := NewMapped(map[string]int{"Pending": 1}, WithOverlayStart(1000), WithEviction[int](10, nil))
+		tenant := base.Overlay()
+		if v, ok := tenant.GetValue("Pending"); !ok || v.Get() != 1 || v.String() != "Pending" {
+			t.Errorf("GetValue: expected the base entry, got %v, %v", v, ok)
+		}
+		if v, ok := tenant.Get("Pending"); !ok || v != 1 {
+			t.Errorf("Get: expected the base entry, got %d, %v", v, ok)
+		}
+		if _, ok := tenant.GetValue("Missing"); ok {
+			t.Error("Expected unknown names to be missing")
+		}
+	})
+
+	t.Run("Lookups", func(t *testing.T) {
+		base := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithOverlayStart(1000))
+		tenant := base.Overlay()
+		tenant.Next("OnHold")
+		if !tenant.ContainsAll(1, 2, 1000) || tenant.ContainsAll(1, 7) {
+			t.Error("ContainsAll: expected base and overlay values to be found")
+		}
+		if !tenant.ContainsAnyName("Missing", "Active") || tenant.ContainsAnyName("Missing") {
+			t.Error("ContainsAnyName: expected base names to be found")
+		}
+		values := tenant.ValidValues()
+		sort.Ints(values)
+		if want := []int{1, 2, 1000}; !reflect.DeepEqual(values, want) {
+			t.Errorf("ValidValues = %v, want %v", values, want)
+		}
+		if got, want := tenant.ValueMap(), map[int]string{1: "Pending", 2: "Active", 1000: "OnHold"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("ValueMap = %v, want %v", got, want)
+		}
+		if got, want := tenant.NameMap(), map[string]int{"Pending": 1, "Active": 2, "OnHold": 1000}; !reflect.DeepEqual(got, want) {
+			t.Errorf("NameMap = %v, want %v", got, want)
+		}
+		if got := tenant.NamesOfValue(2); !reflect.DeepEqual(got, []string{"Active"}) {
+			t.Errorf("NamesOfValue(2) = %v, want [Active]", got)
+		}
+		if v, d, ok := tenant.NearestValue(2); !ok || d != 0 || v.String() != "Active" {
+			t.Errorf("NearestValue(2) = %v, %v, %v", v, d, ok)
+		}
+		if got := tenant.ValuesAtLeast(2); len(got) != 2 || got[0].Get() != 2 || got[1].Get() != 1000 {
+			t.Errorf("ValuesAtLeast(2) = %v", got)
+		}
+		if len(base.ValueMap()) != 2 || base.ContainsAll(1000) {
+			t.Error("Expected the base not to see overlay entries")
+		}
+
+		rates := NewMapped(map[string]float64{"Reduced": 0.075}, WithOverlayStart(100.0)).Overlay()
+		rates.Next("Custom")
+		if v, _, ok := rates.NearestValue(0.07); !ok || v.String() != "Reduced" {
+			t.Errorf("NearestValue(0.07) = %v, %v; want Reduced from the base", v, ok)
+		}
+		if v, _, ok := rates.NearestValue(99); !ok || v.String() != "Custom" {
+			t.Errorf("NearestValue(99) = %v, %v; want Custom", v, ok)
+		}
+	})
+
+	t.Run("PerSetOptions", func(t *testing.T) {
+		base := NewMapped(map[string]int{"Pending": 1}, WithOverlayStart(1000),
+			WithErrorMode[int](), WithStats[int](), WithNameMatcher[int](ASCIIFoldMatcher{}))
+		base.Next("Pending") // Recorded in the base's log only.
+		tenant := base.Overlay()
+		if tenant.Err() != nil {
+			t.Errorf("Expected the overlay to start with no errors, got %v", tenant.Err())
+		}
+		tenant.Next("OnHold")
+		tenant.Next("OnHold")
+		if tenant.Err() == nil {
+			t.Error("Expected the overlay to record its own errors instead of panicking")
+		}
+		if v, err := tenant.Parse("onhold"); err != nil || v.Get() != 1000 {
+			t.Errorf("Expected the matcher to apply to the overlay's names, got %v, %v", v, err)
+		}
+		if v, err := tenant.Parse("PENDING"); err != nil || v.Get() != 1 {
+			t.Errorf("Expected the matcher to apply to the base's names, got %v, %v", v, err)
+		}
+		if _, err := tenant.TryNext("pending"); err == nil || !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected a name matching a base name to be rejected, got %v", err)
+		}
+		if tenant.stats == nil || tenant.stats == base.stats {
+			t.Error("Expected the overlay to count its own uses")
+		}
+	})
+
+	t.Run("SortedExample", func(t *testing.T) {
+		base := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int](), WithOverlayStart(1000))
+		tenant := base.Overlay()
+		tenant.Next("OnHold")
+		if got, want := tenant.Names(), []string{"Pending", "Active", "OnHold"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Names = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Isolation", func(t *testing.T) {
+		base := newBase()
+		a, b := base.Overlay(), base.Overlay()
+		a.Next("OnHold")
+		b.Next("Blocked")
+		if a.ContainsName("Blocked") || b.ContainsName("OnHold") {
+			t.Error("Expected sibling overlays not to share entries")
+		}
+		if c := a.Clone(); c.Parent() != base || !c.ContainsName("Active") || !c.ContainsName("OnHold") {
+			t.Error("Expected the clone of an overlay to stay an overlay")
+		}
+	})
+
+	t.Run("DefaultStart", func(t *testing.T) {
+		if v := NewGenerator[int]().Overlay().Next("X"); v.Get() != 1<<62 {
+			t.Errorf("int: got %d", v.Get())
+		}
+		if v := NewGenerator[int8]().Overlay().Next("X"); v.Get() != 64 {
+			t.Errorf("int8: got %d", v.Get())
+		}
+		if v := NewGenerator[uint16]().Overlay().Next("X"); v.Get() != 1<<15 {
+			t.Errorf("uint16: got %d", v.Get())
+		}
+		if v := NewGenerator[float32]().Overlay().Next("X"); v.Get() != 1<<22 {
+			t.Errorf("float32: got %v", v.Get())
+		}
+		if v := NewMapped(map[string]string{"A": "a"}).Overlay().Next("X"); v.Get() != "AAAAAAAA" {
+			t.Errorf("string: got %q", v.Get())
+		}
+	})
+
+	t.Run("NestedPanics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic")
+			}
+		}()
+		newBase().Overlay().Overlay()
+	})
+}
+
+// benchmarkTenants builds n tenants with two custom entries each, using fork to derive
+// a tenant from the base, and reports the heap they retain.
+func benchmarkTenants(b *testing.B, fork func(*Generator[int]) *Generator[int]) {
+	const n = 10_000
+	base := NewGenerator[int](WithOverlayStart(1 << 20))
+	for i := 0; i < 50; i++ {
+		base.Next("Status" + strconv.Itoa(i))
+	}
+	var before, after runtime.MemStats
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		b.StartTimer()
+		tenants := make([]*Generator[int], n)
+		for j := range tenants {
+			tenants[j] = fork(base)
+			tenants[j].Next("Custom1")
+			tenants[j].Next("Custom2")
+		}
+		b.StopTimer()
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		runtime.KeepAlive(tenants)
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/n, "live-B/tenant")
+}
+
+// benchmarkTenantParse parses a base name and a custom name in a tenant made by fork.
+func benchmarkTenantParse(b *testing.B, fork func(*Generator[int]) *Generator[int]) {
+	base := NewGenerator[int](WithOverlayStart(1 << 20))
+	for i := 0; i < 50; i++ {
+		base.Next("Status" + strconv.Itoa(i))
+	}
+	tenant := fork(base)
+	tenant.Next("Custom1")
+	var v Value[int]
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := tenant.ParseInto("Status25", &v); err != nil {
+			b.Fatal(err)
+		}
+		if err := tenant.ParseInto("Custom1", &v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerator_Overlay10k(b *testing.B) {
+	overlay := func(g *Generator[int]) *Generator[int] { return g.Overlay() }
+	clone := func(g *Generator[int]) *Generator[int] { return g.Clone() }
+	b.Run("Memory/Overlay", func(b *testing.B) { benchmarkTenants(b, overlay) })
+	b.Run("Memory/Clone", func(b *testing.B) { benchmarkTenants(b, clone) })
+	b.Run("Parse/Overlay", func(b *testing.B) { benchmarkTenantParse(b, overlay) })
+	b.Run("Parse/Clone", func(b *testing.B) { benchmarkTenantParse(b, clone) })
+}
@@ -0,0 +1,169 @@
+package enum
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Pair is a value/name pair, the ordered counterpart of a ValueMap or NameMap entry.
+// Unlike Value[T], its fields are exported, so it can be built, compared, and encoded freely.
+type Pair[T TypesValue] struct {
+	Value T      `json:"value"`
+	Name  string `json:"name"`
+}
+
+// pairJSON is the JSON form of a Pair, with the value encoded by marshalValue.
+type pairJSON struct {
+	Value json.RawMessage `json:"value"`
+	Name  string          `json:"name"`
+}
+
+// MarshalJSON implements json.Marshaler, writing {"value":...,"name":...}. A Pair does not
+// carry the options of its Generator, so the value is always in its JSON type; the
+// Generator's own formats (MarshalOrderedJSON, State) honor WithInt64AsString.
+func (p Pair[T]) MarshalJSON() ([]byte, error) {
+	return p.marshal(0)
+}
+
+// marshal encodes the pair with the value encoded under flags.
+func (p Pair[T]) marshal(flags marshalFlags) ([]byte, error) {
+	value, err := marshalValue(p.Value, flags)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(pairJSON{Value: value, Name: p.Name})
+}
+
+// marshalPairs encodes pairs as a JSON array, with values encoded under flags.
+func marshalPairs[T TypesValue](pairs []Pair[T], flags marshalFlags) (json.RawMessage, error) {
+	out := make([]json.RawMessage, len(pairs))
+	for i, p := range pairs {
+		data, err := p.marshal(flags)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = data
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the value as a JSON number or
+// string, like Value.UnmarshalJSON.
+func (p *Pair[T]) UnmarshalJSON(data []byte) error {
+	var raw pairJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	value, err := unmarshalValue[T](raw.Value)
+	if err != nil {
+		return err
+	}
+	p.Value, p.Name = value, raw.Name
+	return nil
+}
+
+// Pairs returns every entry as a Pair, in entry (insertion) order. Values with several
+// names appear once per name. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithStart(1))
+//	g.Next("Pending")
+//	g.Next("Active")
+//	g.Pairs() // [{1 Pending} {2 Active}]
+func (g *Generator[T]) Pairs() []Pair[T] {
+	g.rlock()
+	defer g.runlock()
+	return g.pairsLocked(false)
+}
+
+// PairsSorted is like Pairs but orders the pairs by value, then by name.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) PairsSorted() []Pair[T] {
+	pairs := g.Pairs()
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Value != pairs[j].Value {
+			return pairs[i].Value < pairs[j].Value
+		}
+		return pairs[i].Name < pairs[j].Name
+	})
+	return pairs
+}
+
+// NamePairs is the ordered counterpart of NameMap: every entry as a Pair, ordered by name.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) NamePairs() []Pair[T] {
+	pairs := g.Pairs()
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Name < pairs[j].Name
+	})
+	return pairs
+}
+
+// FormatValueMap formats ValueMap deterministically, as "value=name" entries sorted by
+// value and separated by ", ". Unlike printing the map with %v, the output does not
+// depend on map iteration order, so it is safe in test assertions and debug logs.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Active": 1, "Pending": 0})
+//	fmt.Println(g.FormatValueMap()) // Output: 0=Pending, 1=Active
+func (g *Generator[T]) FormatValueMap() string {
+	g.rlock()
+	pairs := g.pairsLocked(true)
+	g.runlock()
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Value < pairs[j].Value })
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = g.formatText(p.Value) + "=" + p.Name
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatNameMap formats NameMap deterministically, as "name=value" entries sorted by
+// name and separated by ", ". It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Active": 1, "Pending": 0})
+//	fmt.Println(g.FormatNameMap()) // Output: Active=1, Pending=0
+func (g *Generator[T]) FormatNameMap() string {
+	pairs := g.NamePairs()
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p.Name + "=" + g.formatText(p.Value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// MarshalOrderedJSON serializes the Generator as an array of value/name objects in entry
+// order, e.g. [{"value":1,"name":"Pending"},{"value":2,"name":"Active"}]. Unlike the object
+// form of MarshalJSON, it preserves order for any consumer and keeps values in their JSON
+// type. A value with several names is written once, under its canonical name.
+//
+// Like every serialization method (MarshalJSON, MarshalVerboseJSON, State, CanonicalBytes,
+// Catalog, and the exporters), it reads the Generator under a single lock acquisition,
+// so the output reflects one consistent state even while other goroutines call Next.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) MarshalOrderedJSON() ([]byte, error) {
+	g.rlock()
+	pairs := g.pairsLocked(true)
+	g.runlock()
+	return marshalPairs(pairs, g.marshal)
+}
+
+// pairsLocked returns the entries as pairs in entry order. If canonical is set, only
+// the canonical name of each value is included, as in the ordered serialized forms.
+// The caller must hold the read lock.
+func (g *Generator[T]) pairsLocked(canonical bool) []Pair[T] {
+	pairs := make([]Pair[T], 0, len(g.values))
+	for _, entry := range g.values {
+		if canonical && g.valueMap[entry.value] != entry.name {
+			continue // Not the canonical name of a shared value.
+		}
+		pairs = append(pairs, Pair[T]{Value: entry.value, Name: entry.name})
+	}
+	return pairs
+}
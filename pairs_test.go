@@ -0,0 +1,170 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestGenerator_Pairs(t *testing.T) {
+	g := NewGenerator[int](WithStart(3), WithIncrementer(func(v int) int { return v - 1 }))
+	g.Next("Charlie")           // 3
+	g.Next("Bravo")             // 2
+	g.Next("Alpha")             // 1
+	g.AddAlias("Alpha", "Able") // Aliases are not entries.
+
+	t.Run("Insertion order", func(t *testing.T) {
+		want := []Pair[int]{{3, "Charlie"}, {2, "Bravo"}, {1, "Alpha"}}
+		if got := g.Pairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Sorted", func(t *testing.T) {
+		want := []Pair[int]{{1, "Alpha"}, {2, "Bravo"}, {3, "Charlie"}}
+		if got := g.PairsSorted(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if got := g.NamePairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Shared values", func(t *testing.T) {
+		m := NewGenerator[int](WithStart(302), WithIncrementer(func(v int) int { return v }))
+		m.Next("Found")
+		m.Next("Moved")
+		want := []Pair[int]{{302, "Found"}, {302, "Moved"}}
+		if got := m.Pairs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected every name, got %v", got)
+		}
+		if data, _ := m.MarshalJSON(); string(data) != `{"302":"Found"}` {
+			t.Errorf("Expected canonical names only in JSON, got %s", data)
+		}
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		if got, want := g.FormatValueMap(), "1=Alpha, 2=Bravo, 3=Charlie"; got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+		if got, want := g.FormatNameMap(), "Alpha=1, Bravo=2, Charlie=3"; got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+		m := NewMapped(map[string]string{"Zulu": "z", "Also": "z", "Alpha": "a"}, WithSortedEntries[string]())
+		if got, want := m.FormatValueMap(), "a=Alpha, z=Also"; got != want {
+			t.Errorf("Expected canonical names by value %q, got %q", want, got)
+		}
+		if got, want := m.FormatNameMap(), "Alpha=a, Also=z, Zulu=z"; got != want {
+			t.Errorf("Expected every name %q, got %q", want, got)
+		}
+		if got := NewGenerator[int]().FormatValueMap(); got != "" {
+			t.Errorf("Expected empty string, got %q", got)
+		}
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		pairs := g.Pairs()
+		pairs[0].Name = "Mutated"
+		if name, _ := g.Name(3); name != "Charlie" {
+			t.Error("Expected Pairs to return a copy")
+		}
+	})
+}
+
+func TestGenerator_MarshalSnapshotConsistency(t *testing.T) {
+	g := NewGenerator[int]()
+	const writers, perWriter = 4, 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				g.Next(fmt.Sprintf("W%d_%d", w, i))
+			}
+		}(w)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	// checkPrefix verifies a snapshot holds the values 0..n-1 in order under unique names,
+	// which is what any prefix of the concurrent Next calls looks like.
+	checkPrefix := func(t *testing.T, pairs []Pair[int]) {
+		t.Helper()
+		seen := make(map[string]bool, len(pairs))
+		for i, p := range pairs {
+			if p.Value != i || seen[p.Name] {
+				t.Fatalf("Torn snapshot at %d: %+v", i, p)
+			}
+			seen[p.Name] = true
+		}
+	}
+
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+
+		data, err := g.MarshalOrderedJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var pairs []Pair[int]
+		if err := json.Unmarshal(data, &pairs); err != nil {
+			t.Fatalf("Invalid ordered JSON %s: %v", data, err)
+		}
+		checkPrefix(t, pairs)
+
+		data, err = g.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var object map[string]string
+		if err := json.Unmarshal(data, &object); err != nil {
+			t.Fatalf("Invalid JSON %s: %v", data, err)
+		}
+		for key := range object {
+			if n, err := strconv.Atoi(key); err != nil || n >= len(object) {
+				t.Fatalf("Torn snapshot: key %q among %d entries", key, len(object))
+			}
+		}
+
+		state := g.State()
+		checkPrefix(t, state.Entries)
+		bin, _ := state.MarshalBinary()
+		var decoded State[int]
+		if err := decoded.UnmarshalBinary(bin); err != nil || len(decoded.Entries) != len(state.Entries) {
+			t.Fatalf("Binary state mismatch: %v", err)
+		}
+
+		catalog := g.Catalog()
+		for i, ce := range catalog {
+			if ce.ID != i {
+				t.Fatalf("Torn catalog at %d: %+v", i, ce)
+			}
+		}
+
+		data, err = catalogJSON(g) // The Handler's catalog, also one read.
+		if err != nil {
+			t.Fatal(err)
+		}
+		pairs = nil
+		if err := json.Unmarshal(data, &pairs); err != nil {
+			t.Fatalf("Invalid catalog %s: %v", data, err)
+		}
+		checkPrefix(t, pairs)
+	}
+
+	if got := g.Len(); got != writers*perWriter {
+		t.Errorf("Expected %d entries, got %d", writers*perWriter, got)
+	}
+}
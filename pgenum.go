@@ -0,0 +1,294 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// PGEnum is a label of a PostgreSQL ENUM type bound to the registry it is declared
+// from. Postgres sends native enum columns over the wire as their label text, whatever
+// the enum's underlying Go type, so PGEnum scans and stores labels, checking them
+// against the registry's names, and takes its ordinal (the label's position, which is
+// its sort order in Postgres) from the registry's definition order. ExportSQL writes
+// the matching CREATE TYPE statement, so the database type and the Go registry come
+// from the same source. Create one with NewPGEnum; copies share the binding.
+//
+// Labels are entry names, as in Generator.ExportSQL, so any Registry works: a
+// Generator[string] whose names are the labels, a Generator of another type, a
+// BasicRegistry, or a Maker.
+//
+// Example:
+//
+//	var statuses = NewMapped(map[string]string{"Pending": "p", "Active": "a"})
+//	var orderStatus = NewPGEnum("order_status", statuses)
+//
+//	s := orderStatus
+//	err := row.Scan(&s) // []byte("Active") -> s.String() == "Active"
+//	if rank, _ := s.Ordinal(); rank > 0 { ... }
+type PGEnum struct {
+	label string
+	set   bool
+	t     *pgType
+}
+
+// pgType is the binding shared by the copies of a PGEnum.
+type pgType struct {
+	name  string
+	reg   Registry
+	index atomic.Pointer[pgIndex] // Labels of reg, rebuilt when its version changes.
+}
+
+// pgIndex is the label index of a registry at a version.
+type pgIndex struct {
+	version pgVersion
+	labels  []string
+	pos     map[string]int
+}
+
+// pgVersion identifies the state of a registry: its own Version and, for an overlay,
+// its parent's, since the overlay's Names include the parent's.
+type pgVersion struct {
+	own, parent uint64
+}
+
+// versioner is implemented by registries that report changes with a version counter,
+// such as *Generator[T], *BasicRegistry, and *Maker[T, E].
+type versioner interface {
+	Version() uint64
+}
+
+// overlaid is implemented by *Generator[T], whose overlays also change with their
+// parent.
+type overlaid interface {
+	parentVersion() uint64
+}
+
+// describer is implemented by registries that keep descriptions, which PGEnum.ExportSQL
+// writes as comments.
+type describer interface {
+	describeName(name string) string
+}
+
+// NewPGEnum returns an empty PGEnum bound to reg, for the PostgreSQL enum type named
+// typeName. Labels are looked up in an index of reg's names, rebuilt when reg's Version
+// changes (or, for an overlay, its parent's); registries without a Version method are
+// re-read on every lookup.
+//
+// Panics if reg is nil or typeName is empty.
+func NewPGEnum(typeName string, reg Registry) PGEnum {
+	if reg == nil {
+		panic("enum: NewPGEnum: nil registry")
+	}
+	if typeName == "" {
+		panic("enum: NewPGEnum: empty type name")
+	}
+	return PGEnum{t: &pgType{name: typeName, reg: reg}}
+}
+
+// String returns the label, or "" if none is set.
+func (p PGEnum) String() string {
+	return p.label
+}
+
+// IsSet reports whether the PGEnum holds a label, as opposed to being empty or NULL.
+func (p PGEnum) IsSet() bool {
+	return p.set
+}
+
+// TypeName returns the name of the PostgreSQL enum type, or "" for the zero PGEnum.
+func (p PGEnum) TypeName() string {
+	if p.t == nil {
+		return ""
+	}
+	return p.t.name
+}
+
+// Registry returns the registry the PGEnum is bound to, or nil for the zero PGEnum.
+func (p PGEnum) Registry() Registry {
+	if p.t == nil {
+		return nil
+	}
+	return p.t.reg
+}
+
+// Labels returns the labels of the enum type in order: the registry's names.
+// Returns nil for the zero PGEnum.
+func (p PGEnum) Labels() []string {
+	if p.t == nil {
+		return nil
+	}
+	return append([]string(nil), p.t.current().labels...)
+}
+
+// Ordinal returns the 0-based position of the label in the enum type, which orders
+// labels as Postgres does, and false if no label is set or it is no longer registered.
+//
+// Example:
+//
+//	a, _ := x.Ordinal()
+//	b, _ := y.Ordinal()
+//	if a < b { ... } // x sorts before y, as in ORDER BY
+func (p PGEnum) Ordinal() (int, bool) {
+	if !p.set || p.t == nil {
+		return 0, false
+	}
+	return p.OrdinalOf(p.label)
+}
+
+// OrdinalOf returns the 0-based position of label in the enum type, and false if it
+// is not registered.
+func (p PGEnum) OrdinalOf(label string) (int, bool) {
+	if p.t == nil {
+		return 0, false
+	}
+	return p.t.ordinal(label)
+}
+
+// Set sets the label after checking that it is registered, leaving the PGEnum
+// unchanged otherwise. Returns an error wrapping ErrUnknownValue if it is not, or
+// ErrNilRegistry if the PGEnum is not bound to a registry.
+func (p *PGEnum) Set(label string) error {
+	if p.t == nil {
+		return ErrNilRegistry
+	}
+	if _, ok := p.t.ordinal(label); !ok {
+		return fmt.Errorf("%w: %q is not a label of %s", ErrUnknownValue, label, p.t.name)
+	}
+	p.label, p.set = label, true
+	return nil
+}
+
+// Value implements driver.Valuer, storing the label as text, or NULL if none is set.
+func (p PGEnum) Value() (driver.Value, error) {
+	if !p.set {
+		return nil, nil
+	}
+	return p.label, nil
+}
+
+// Scan implements sql.Scanner for the label text of an enum column, as delivered by
+// lib/pq ([]byte) and pgx (string). The label must match a registered name exactly:
+// unlike Member.Scan, aliases and value literals are not accepted, since Postgres only
+// returns labels. A NULL empties the PGEnum, keeping its binding.
+//
+// Errors are returned as *ScanError, wrapping ErrUnknownValue if the label is not
+// registered or ErrNilRegistry if the PGEnum is not bound to a registry.
+func (p *PGEnum) Scan(src any) error {
+	fail := func(err error) error {
+		return &ScanError{Source: fmt.Sprintf("%T", src), Target: "enum.PGEnum", Value: src, Err: err}
+	}
+	if p.t == nil {
+		return fail(ErrNilRegistry)
+	}
+	var label string
+	switch v := src.(type) {
+	case nil:
+		p.label, p.set = "", false
+		return nil
+	case string:
+		label = v
+	case []byte:
+		label = string(v)
+	default:
+		return fail(errors.New("unsupported source type"))
+	}
+	if _, ok := p.t.ordinal(label); !ok {
+		return fail(fmt.Errorf("%w: %q is not a label of %s", ErrUnknownValue, label, p.t.name))
+	}
+	p.label, p.set = label, true
+	return nil
+}
+
+// ExportSQL writes the CREATE TYPE statement of the enum type, as Generator.ExportSQL
+// does, with the labels PGEnum accepts in the order of their ordinals. Under
+// IncludeComments, descriptions of Generators and BasicRegistries become comments.
+// Returns ErrNilRegistry for the zero PGEnum.
+//
+// Example:
+//
+//	err := orderStatus.ExportSQL(os.Stdout)
+//	// CREATE TYPE order_status AS ENUM (
+//	//     'Pending',
+//	//     'Active'
+//	// );
+func (p PGEnum) ExportSQL(w io.Writer, opts ...ExportOption) error {
+	if p.t == nil {
+		return ErrNilRegistry
+	}
+	var cfg exportConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	labels := p.t.current().labels
+	descs := make([]string, len(labels))
+	if d, ok := p.t.reg.(describer); ok && cfg.comments {
+		for i, label := range labels {
+			descs[i] = d.describeName(label)
+		}
+	}
+	return writeSQLEnum(w, p.t.name, labels, descs)
+}
+
+// ordinal returns the position of label in the registry's names.
+func (t *pgType) ordinal(label string) (int, bool) {
+	i, ok := t.current().pos[label]
+	return i, ok
+}
+
+// current returns the label index of the registry, rebuilding it if the registry
+// changed or cannot report changes.
+func (t *pgType) current() *pgIndex {
+	version, versioned := t.version()
+	if versioned {
+		if idx := t.index.Load(); idx != nil && idx.version == version {
+			return idx
+		}
+	}
+	idx := &pgIndex{}
+	if versioned {
+		idx.version = version // Read before Names, so a concurrent change forces a rebuild.
+	}
+	idx.labels = t.reg.Names()
+	idx.pos = make(map[string]int, len(idx.labels))
+	for i, label := range idx.labels {
+		idx.pos[label] = i
+	}
+	if versioned {
+		t.index.Store(idx)
+	}
+	return idx
+}
+
+// version returns the state of the registry, and false if it cannot report changes.
+func (t *pgType) version() (pgVersion, bool) {
+	v, ok := t.reg.(versioner)
+	if !ok {
+		return pgVersion{}, false
+	}
+	var version pgVersion
+	if o, ok := t.reg.(overlaid); ok {
+		version.parent = o.parentVersion()
+	}
+	version.own = v.Version()
+	return version, true
+}
+
+// describeName returns the description of the value registered under name, for
+// PGEnum.ExportSQL. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) describeName(name string) string {
+	g.rlock()
+	defer g.runlock()
+	value, ok := g.nameMap[name]
+	if !ok {
+		return ""
+	}
+	return g.descriptions[value]
+}
+
+// describeName is like Generator.describeName.
+func (r *BasicRegistry) describeName(name string) string {
+	return r.meta.describeName(name)
+}
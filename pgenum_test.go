@@ -0,0 +1,168 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The PGEnum type must work as a database/sql column and parameter.
+var (
+	_ sql.Scanner   = (*PGEnum)(nil)
+	_ driver.Valuer = PGEnum{}
+)
+
+func TestPGEnum(t *testing.T) {
+	statuses := NewMapped(map[string]string{"pending": "p", "active": "a", "done": "d"})
+	statuses.SortByName() // Deterministic declaration order: active, done, pending.
+	orderStatus := NewPGEnum("order_status", statuses)
+
+	t.Run("ScanText", func(t *testing.T) {
+		for _, src := range []any{"done", []byte("done")} { // pgx and lib/pq.
+			s := orderStatus
+			if err := s.Scan(src); err != nil || !s.IsSet() || s.String() != "done" {
+				t.Errorf("Scan(%#v): got %q, err: %v", src, s.String(), err)
+			}
+			if rank, ok := s.Ordinal(); !ok || rank != 1 {
+				t.Errorf("Expected ordinal 1, got %d, %v", rank, ok)
+			}
+		}
+	})
+
+	t.Run("ScanNull", func(t *testing.T) {
+		s := orderStatus
+		s.Scan("active")
+		if err := s.Scan(nil); err != nil || s.IsSet() || s.TypeName() != "order_status" {
+			t.Errorf("Expected NULL to empty the label and keep the binding, got %v", err)
+		}
+		if v, err := s.Value(); v != nil || err != nil {
+			t.Errorf("Expected NULL, got %v, %v", v, err)
+		}
+		if _, ok := s.Ordinal(); ok {
+			t.Error("Expected no ordinal for NULL")
+		}
+	})
+
+	t.Run("ScanRejects", func(t *testing.T) {
+		s := orderStatus
+		s.Scan("active")
+		var se *ScanError
+		for _, src := range []any{"archived", []byte("Active"), "a"} { // Unknown, wrong case, value.
+			if err := s.Scan(src); !errors.As(err, &se) || !errors.Is(err, ErrUnknownValue) {
+				t.Errorf("Scan(%#v): expected a ScanError wrapping ErrUnknownValue, got %v", src, err)
+			}
+		}
+		if s.String() != "active" {
+			t.Errorf("Expected a failed scan to leave the label, got %q", s.String())
+		}
+		if err := s.Scan(int64(1)); err == nil {
+			t.Error("Expected numbers to be rejected")
+		}
+		var unbound PGEnum
+		if err := unbound.Scan("active"); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("ValueAndSet", func(t *testing.T) {
+		s := orderStatus
+		if err := s.Set("pending"); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := s.Value(); v != "pending" || err != nil {
+			t.Errorf("Expected the label text, got %v, %v", v, err)
+		}
+		if err := s.Set("bogus"); !errors.Is(err, ErrUnknownValue) || s.String() != "pending" {
+			t.Errorf("Expected ErrUnknownValue and no change, got %v", err)
+		}
+	})
+
+	t.Run("RegistryChanges", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("low")
+		e := NewPGEnum("priority", g)
+		if err := e.Set("high"); err == nil {
+			t.Fatal("Expected high to be unknown")
+		}
+		g.Next("high")
+		if err := e.Set("high"); err != nil {
+			t.Errorf("Expected the index to follow the registry, got %v", err)
+		}
+		if rank, _ := e.Ordinal(); rank != 1 {
+			t.Errorf("Expected ordinal 1, got %d", rank)
+		}
+		if got := e.Labels(); !reflect.DeepEqual(got, []string{"low", "high"}) {
+			t.Errorf("Labels = %v", got)
+		}
+	})
+
+	t.Run("BasicRegistry", func(t *testing.T) {
+		levels := NewBasicRegistry()
+		levels.Add("low")
+		levels.Add("high")
+		e := NewPGEnum("level", levels)
+		if err := e.Scan([]byte("high")); err != nil || e.Registry() != Registry(levels) {
+			t.Errorf("Expected high, got %v", err)
+		}
+		if e.t.index.Load() == nil {
+			t.Error("Expected the index of a BasicRegistry to be cached")
+		}
+		levels.Add("max")
+		if err := e.Set("max"); err != nil {
+			t.Errorf("Expected the index to follow the registry, got %v", err)
+		}
+	})
+
+	t.Run("Overlay", func(t *testing.T) {
+		base := NewGenerator[int](WithOverlayStart(100))
+		base.Next("low")
+		tenant := base.Overlay()
+		tenant.Next("custom")
+		e := NewPGEnum("priority", tenant)
+		if err := e.Set("high"); err == nil {
+			t.Fatal("Expected high to be unknown")
+		}
+		base.Next("high")
+		if err := e.Set("high"); err != nil {
+			t.Errorf("Expected the index to follow the parent, got %v", err)
+		}
+	})
+
+	t.Run("ExportSQL", func(t *testing.T) {
+		g := NewMapped(map[string]int{"it's": 1, "ok": 2})
+		g.SortByValue()
+		g.SetDescription(2, "All good")
+		var buf bytes.Buffer
+		if err := NewPGEnum("mood", g).ExportSQL(&buf, IncludeComments(true)); err != nil {
+			t.Fatal(err)
+		}
+		var direct bytes.Buffer
+		g.ExportSQL(&direct, "mood", IncludeComments(true))
+		if buf.String() != direct.String() || !strings.Contains(buf.String(), "    -- All good\n    'ok'\n") {
+			t.Errorf("Unexpected statement:\n%s", buf.String())
+		}
+		if err := (PGEnum{}).ExportSQL(&buf); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("InvalidBinding", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			reg  Registry
+		}{{"", statuses}, {"t", nil}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Expected NewPGEnum(%q, %v) to panic", tt.name, tt.reg)
+					}
+				}()
+				NewPGEnum(tt.name, tt.reg)
+			}()
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// PopulateFrom fetches name-to-value maps from sources concurrently and adds all of their
+// entries to the Generator in one atomic commit, for enums hydrated from several
+// services at startup. Each source receives a context that is canceled as soon as
+// another source fails, as with errgroup. It is PopulateFromMerge under
+// MergeRequireSuperset: a name may appear in several sources, or already exist in the
+// Generator, only with the same value.
+//
+// Example:
+//
+//	err := statuses.PopulateFrom(ctx,
+//	    func(ctx context.Context) (map[string]int, error) { return payments.Statuses(ctx) },
+//	    func(ctx context.Context) (map[string]int, error) { return orders.Statuses(ctx) },
+//	)
+func (g *Generator[T]) PopulateFrom(ctx context.Context, sources ...func(ctx context.Context) (map[string]T, error)) error {
+	return g.PopulateFromMerge(ctx, MergeRequireSuperset, sources...)
+}
+
+// PopulateFromMerge is like PopulateFrom but checks the fetched entries against the
+// current ones according to policy. Sources add to the Generator rather than replace it,
+// so current entries absent from every source are kept under any policy:
+//
+//   - MergeAllowAny adds new names and moves existing names to the values the sources
+//     give them; a moved entry is removed, as with Remove, and added again.
+//   - MergeRequireSuperset adds new names; existing names must keep their values.
+//   - MergeRequireEqual only verifies: existing names must keep their values and no new
+//     name may appear.
+//
+// Entries are added in source order, each source's entries ordered by value and then by
+// name, as NewMapped orders them. A name may appear in several sources only with the same
+// value. The Generator's own rules apply, including its validators (which receive ctx):
+// under WithBijective names must not share a value, and under WithLiteralNameCheck names
+// must not shadow value literals. On a Generator that supports Next, the sequence then
+// continues past the largest value added. Names added here are pinned: under
+// WithEviction, an existing entry from GetOrAdd that a source defines is no longer
+// evictable.
+//
+// Returns the first fetch error, wrapped with the index of its source, an error wrapping
+// ErrMergeRejected that lists every entry the policy rejects, or an error listing every
+// other conflicting entry. On any error the Generator is left unchanged.
+// It is thread-safe, using a write lock only for the final commit.
+//
+// Example:
+//
+//	// Fail startup if a service renumbered a status.
+//	err := statuses.PopulateFromMerge(ctx, MergeRequireSuperset, fetchPayments, fetchOrders)
+func (g *Generator[T]) PopulateFromMerge(ctx context.Context, policy MergePolicy, sources ...func(ctx context.Context) (map[string]T, error)) error {
+	if policy < MergeAllowAny || policy > MergeRequireEqual {
+		return fmt.Errorf("enum: unknown merge policy %v", policy)
+	}
+	results, err := fetchAll(ctx, sources)
+	if err != nil {
+		return err
+	}
+
+	// Order and deduplicate the incoming entries before taking the lock.
+	var incoming []Value[T]
+	seen := make(map[string]T)
+	var errs []error
+	for i, m := range results {
+		entries := make([]Value[T], 0, len(m))
+		for name, value := range m {
+			if isNaN(value) {
+				errs = append(errs, fmt.Errorf("source %d: NaN value for %q cannot be used as an enum key", i, name))
+				continue
+			}
+			entries = append(entries, g.entry(value, name))
+		}
+		sortByValue(entries)
+		for _, entry := range entries {
+			if prev, ok := seen[entry.name]; ok {
+				if prev != entry.value {
+					errs = append(errs, fmt.Errorf("source %d: %q has value %s, but an earlier source gave %s", i, entry.name, g.formatValue(entry.value), g.formatValue(prev)))
+				}
+				continue
+			}
+			seen[entry.name] = entry.value
+			incoming = append(incoming, entry)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("enum: cannot populate: %w", errors.Join(errs...))
+	}
+
+	err = g.lockValidated(ctx, func() []Pair[T] {
+		var pairs []Pair[T]
+		for _, entry := range incoming {
+			if existing, ok := g.nameMap[entry.name]; !ok || existing != entry.value {
+				pairs = append(pairs, Pair[T]{Name: entry.name, Value: entry.value})
+			}
+		}
+		return pairs
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && err == ctxErr {
+			return fmt.Errorf("enum: cannot populate: %w", err)
+		}
+		return err
+	}
+	defer g.unlock()
+
+	var violations []error
+	var added []Value[T]
+	var pinned []string
+	moved := make(map[string]bool)
+	for _, entry := range incoming {
+		existing, ok := g.nameMap[entry.name]
+		switch {
+		case ok && existing == entry.value:
+			pinned = append(pinned, entry.name)
+			continue
+		case ok && policy != MergeAllowAny:
+			violations = append(violations, fmt.Errorf("%q has value %s, but it is already defined as %s", entry.name, g.formatValue(entry.value), g.formatValue(existing)))
+			continue
+		case ok:
+			moved[entry.name] = true
+		case policy == MergeRequireEqual:
+			violations = append(violations, fmt.Errorf("%q is new", entry.name))
+			continue
+		}
+		added = append(added, entry)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("%w under %v: %w", ErrMergeRejected, policy, errors.Join(violations...))
+	}
+
+	owners := make(map[T]string)
+	matched := make(map[string]string)
+	for _, entry := range added {
+		except := ""
+		if moved[entry.name] {
+			except = entry.name
+		}
+		if g.bijective {
+			existing, ok := g.valueMap[entry.value]
+			if !ok || moved[existing] {
+				existing, ok = owners[entry.value]
+			}
+			if ok {
+				errs = append(errs, sharedValueError(existing, entry.name, entry.value))
+				continue
+			}
+			owners[entry.value] = entry.name
+		}
+		if err := g.checkName(entry.name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := g.checkParent(entry.name, entry.value); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := g.checkLiteralLocked(entry.name, entry.value); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := g.checkMatchLocked(entry.name, except, matched); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("enum: cannot populate: %w", errors.Join(errs...))
+	}
+
+	if g.evict != nil {
+		for _, name := range pinned {
+			g.evict.drop(name)
+		}
+	}
+	for name := range moved {
+		_ = g.removeLocked(name, "") // The name exists: it was found above.
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	// Continue the sequence past the imported values, so Next does not collide. This
+	// happens first so that the journaled adds record where the sequence resumes.
+	largest := added[0].value
+	for _, entry := range added[1:] {
+		if entry.value > largest {
+			largest = entry.value
+		}
+	}
+	if g.incrementer != nil && !g.exhausted && largest >= g.current {
+		g.current = largest
+		g.advance()
+	}
+	for _, entry := range added {
+		if g.arena != nil {
+			entry.name = g.arena.intern(entry.name)
+		}
+		g.appendValue(entry)
+		g.addName(entry.value, entry.name)
+		g.nameMap[entry.name] = entry.value
+		g.record(ChangeAdd, entry.name, "", entry.value, "")
+	}
+	g.bump()
+	return nil
+}
+
+// fetchAll calls every source concurrently and returns their results in source order,
+// or the first error, canceling the context of the others when one fails.
+func fetchAll[T TypesValue](ctx context.Context, sources []func(ctx context.Context) (map[string]T, error)) ([]map[string]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]map[string]T, len(sources))
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i, source := range sources {
+		wg.Add(1)
+		go func(i int, source func(ctx context.Context) (map[string]T, error)) {
+			defer wg.Done()
+			m, err := source(ctx)
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("enum: source %d: %w", i, err)
+					cancel()
+				})
+				return
+			}
+			results[i] = m
+		}(i, source)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("enum: cannot populate: %w", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,207 @@
+package enum
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerator_PopulateFrom(t *testing.T) {
+	static := func(m map[string]int) func(context.Context) (map[string]int, error) {
+		return func(context.Context) (map[string]int, error) { return m, nil }
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Unknown": 0})
+		err := g.PopulateFrom(context.Background(),
+			static(map[string]int{"Paid": 2, "Pending": 1}),
+			static(map[string]int{"Shipped": 10, "Pending": 1}), // Same name and value is fine.
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []string{"Unknown", "Pending", "Paid", "Shipped"}
+		if got := g.Names(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		started := make(chan struct{}, 2)
+		wait := func(m map[string]int) func(context.Context) (map[string]int, error) {
+			return func(ctx context.Context) (map[string]int, error) {
+				started <- struct{}{}
+				for len(started) < 2 { // Completes only if both sources run at once.
+					time.Sleep(time.Millisecond)
+				}
+				return m, nil
+			}
+		}
+		g := NewMapped(map[string]int{})
+		if err := g.PopulateFrom(context.Background(), wait(map[string]int{"A": 1}), wait(map[string]int{"B": 2})); err != nil {
+			t.Fatal(err)
+		}
+		if g.Len() != 2 {
+			t.Errorf("Expected 2 entries, got %d", g.Len())
+		}
+	})
+
+	t.Run("One failure", func(t *testing.T) {
+		errDown := errors.New("service down")
+		canceled := make(chan bool, 1)
+		g := NewMapped(map[string]int{"Unknown": 0})
+		version := g.Version()
+		err := g.PopulateFrom(context.Background(),
+			func(ctx context.Context) (map[string]int, error) {
+				<-ctx.Done()
+				canceled <- true
+				return map[string]int{"Paid": 2}, nil
+			},
+			func(context.Context) (map[string]int, error) { return nil, errDown },
+		)
+		if !errors.Is(err, errDown) || !strings.Contains(err.Error(), "source 1") {
+			t.Errorf("Expected the failing source's error, got %v", err)
+		}
+		if !<-canceled {
+			t.Error("Expected the other source to be canceled")
+		}
+		if g.Len() != 1 || g.Version() != version {
+			t.Errorf("Expected the Generator to be unchanged, got %v", g.Names())
+		}
+	})
+
+	t.Run("Duplicate names", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Unknown": 0})
+		err := g.PopulateFrom(context.Background(),
+			static(map[string]int{"Pending": 1, "Paid": 2}),
+			static(map[string]int{"Pending": 5}),
+			static(map[string]int{"Unknown": 9}),
+		)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		for _, want := range []string{`source 1: "Pending" has value 5, but an earlier source gave 1`} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %v", want, err)
+			}
+		}
+		if g.Len() != 1 {
+			t.Errorf("Expected no partial population, got %v", g.Names())
+		}
+
+		err = g.PopulateFrom(context.Background(), static(map[string]int{"Paid": 2, "Unknown": 9}))
+		if err == nil || !strings.Contains(err.Error(), `"Unknown" has value 9, but it is already defined as 0`) {
+			t.Errorf("Expected a conflict with the existing entry, got %v", err)
+		}
+		if g.Len() != 1 {
+			t.Errorf("Expected no partial population, got %v", g.Names())
+		}
+	})
+
+	t.Run("Bijective", func(t *testing.T) {
+		g := NewMapped(map[string]int{}, WithBijective[int]())
+		err := g.PopulateFrom(context.Background(), static(map[string]int{"A": 1}), static(map[string]int{"B": 1}))
+		var be *BijectionError
+		if !errors.As(err, &be) || g.Len() != 0 {
+			t.Errorf("Expected BijectionError and no entries, got %v", err)
+		}
+	})
+
+	t.Run("History", func(t *testing.T) {
+		g := NewMapped(map[string]int{}, WithHistory[int](10))
+		if err := g.PopulateFrom(context.Background(), static(map[string]int{"A": 1})); err != nil {
+			t.Fatal(err)
+		}
+		if h := g.History(); len(h) != 1 || h[0].Kind != ChangeAdd || h[0].Name != "A" {
+			t.Errorf("Expected one add record, got %v", h)
+		}
+	})
+
+	t.Run("Advances the sequence", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		if err := g.PopulateFrom(context.Background(), static(map[string]int{"B": 5, "C": 3})); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := g.TryNext("D"); err != nil || v.Get() != 6 {
+			t.Errorf("Expected Next to continue at 6, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Validators", func(t *testing.T) {
+		errReserved := errors.New("reserved")
+		g := NewMapped(map[string]int{}, WithValidator(func(_ context.Context, name string, _ int) error {
+			if name == "default" {
+				return errReserved
+			}
+			return nil
+		}))
+		err := g.PopulateFrom(context.Background(), static(map[string]int{"A": 1, "default": 2}))
+		if !errors.Is(err, errReserved) || g.Len() != 0 {
+			t.Errorf("Expected the validator error and no entries, got %v", err)
+		}
+	})
+
+	t.Run("Pins evictable entries", func(t *testing.T) {
+		var evicted []string
+		g := NewGenerator[int](WithEviction[int](1, func(v Value[int]) { evicted = append(evicted, v.String()) }))
+		g.GetOrAdd("A")
+		if err := g.PopulateFrom(context.Background(), static(map[string]int{"A": 0})); err != nil {
+			t.Fatal(err)
+		}
+		g.GetOrAdd("B")
+		g.GetOrAdd("C")
+		if !g.ContainsName("A") || !reflect.DeepEqual(evicted, []string{"B"}) {
+			t.Errorf("Expected A to be pinned and B evicted, got %v, evicted %v", g.Names(), evicted)
+		}
+	})
+}
+
+func TestGenerator_PopulateFromMerge(t *testing.T) {
+	static := func(m map[string]int) func(context.Context) (map[string]int, error) {
+		return func(context.Context) (map[string]int, error) { return m, nil }
+	}
+	tests := []struct {
+		name   string
+		policy MergePolicy
+		source map[string]int
+		want   map[string]int // nil if rejected.
+	}{
+		{"AllowAny moves", MergeAllowAny, map[string]int{"Active": 5, "Closed": 3}, map[string]int{"Pending": 1, "Active": 5, "Closed": 3}},
+		{"Superset adds", MergeRequireSuperset, map[string]int{"Closed": 3}, map[string]int{"Pending": 1, "Active": 2, "Closed": 3}},
+		{"Superset moved", MergeRequireSuperset, map[string]int{"Active": 5}, nil},
+		{"Equal same", MergeRequireEqual, map[string]int{"Active": 2}, map[string]int{"Pending": 1, "Active": 2}},
+		{"Equal adds", MergeRequireEqual, map[string]int{"Closed": 3}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+			err := g.PopulateFromMerge(context.Background(), tt.policy, static(tt.source))
+			if tt.want == nil {
+				if !errors.Is(err, ErrMergeRejected) || g.Len() != 2 {
+					t.Errorf("Expected ErrMergeRejected and no change, got %v (%v)", err, g.Names())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]int)
+			for _, v := range g.Values() {
+				got[v.String()] = v.Get()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("Unknown policy", func(t *testing.T) {
+		if err := NewMapped(map[string]int{}).PopulateFromMerge(context.Background(), MergePolicy(9)); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// prefixIndex is the sorted index of names and aliases, folded to lower case, shared
+// by ParsePrefix and Complete.
+type prefixIndex[T TypesValue] struct {
+	version uint64
+	keys    []prefixKey[T] // Sorted by folded, then by key.
+}
+
+// prefixKey is a name or alias in a prefixIndex.
+type prefixKey[T TypesValue] struct {
+	folded string
+	key    string
+	value  T
+}
+
+// prefixIndex returns the index for the current version, rebuilding it if the
+// Generator has changed since it was last built. It is thread-safe, using a read lock
+// for access.
+func (g *Generator[T]) prefixIndex() *prefixIndex[T] {
+	if idx := g.prefixes.Load(); idx != nil && idx.version == g.version.Load() {
+		return idx
+	}
+	g.rlock()
+	idx := &prefixIndex[T]{version: g.version.Load()}
+	idx.keys = make([]prefixKey[T], 0, len(g.nameMap)+len(g.aliases))
+	for name, value := range g.nameMap {
+		idx.keys = append(idx.keys, prefixKey[T]{strings.ToLower(name), name, value})
+	}
+	for alias, value := range g.aliases {
+		idx.keys = append(idx.keys, prefixKey[T]{strings.ToLower(alias), alias, value})
+	}
+	g.runlock()
+	sort.Slice(idx.keys, func(i, j int) bool {
+		a, b := idx.keys[i], idx.keys[j]
+		if a.folded != b.folded {
+			return a.folded < b.folded
+		}
+		return a.key < b.key
+	})
+	g.prefixes.Store(idx)
+	return idx
+}
+
+// match returns the keys starting with prefix, case-insensitively.
+func (idx *prefixIndex[T]) match(prefix string) []prefixKey[T] {
+	prefix = strings.ToLower(prefix)
+	lo := sort.Search(len(idx.keys), func(i int) bool { return idx.keys[i].folded >= prefix })
+	hi := lo
+	for hi < len(idx.keys) && strings.HasPrefix(idx.keys[hi].folded, prefix) {
+		hi++
+	}
+	return idx.keys[lo:hi]
+}
+
+// ParsePrefix resolves s to the single entry whose name or alias starts with s,
+// ignoring case, for command-line style abbreviations. An exact name or alias wins over
+// longer ones ("pending" resolves to Pending even if PendingReview exists), and
+// names and aliases of the same entry count once.
+// It is thread-safe, using a read lock for access.
+//
+// Returns an error wrapping ErrAmbiguous that lists the candidates if several entries
+// match, or wrapping ErrUnknownValue if none does.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Pending": 1, "PendingReview": 2, "Active": 3})
+//	v, _ := g.ParsePrefix("act")    // Value[int]{value: 3, name: "Active"}
+//	_, err := g.ParsePrefix("pend") // err: ...: "pend" matches Pending, PendingReview
+func (g *Generator[T]) ParsePrefix(s string) (Value[T], error) {
+	matches := g.prefixIndex().match(s)
+	folded := strings.ToLower(s)
+	var exact, candidates []T
+	seen := make(map[T]bool, len(matches))
+	for _, m := range matches {
+		if seen[m.value] {
+			continue
+		}
+		seen[m.value] = true
+		candidates = append(candidates, m.value)
+		if m.folded == folded {
+			exact = append(exact, m.value)
+		}
+	}
+	if len(exact) == 1 {
+		candidates = exact
+	}
+
+	g.rlock()
+	defer g.runlock()
+	switch len(candidates) {
+	case 0:
+		return Value[T]{}, fmt.Errorf("%w: no name starts with %q", ErrUnknownValue, s)
+	case 1:
+		if name, ok := g.valueMap[candidates[0]]; ok {
+			return g.entry(candidates[0], name), nil
+		}
+		// Removed since the index was built.
+		return Value[T]{}, fmt.Errorf("%w: no name starts with %q", ErrUnknownValue, s)
+	}
+	names := make([]string, 0, len(candidates))
+	for _, value := range candidates {
+		if name, ok := g.valueMap[value]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return Value[T]{}, fmt.Errorf("%w: %q matches %s", ErrAmbiguous, s, strings.Join(names, ", "))
+}
+
+// Complete returns the names and aliases starting with prefix, ignoring case, sorted
+// case-insensitively, for shell or prompt completion. An empty prefix returns them all.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g.Complete("pe") // ["Pending", "PendingReview"]
+func (g *Generator[T]) Complete(prefix string) []string {
+	matches := g.prefixIndex().match(prefix)
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.key
+	}
+	return out
+}
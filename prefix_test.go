@@ -0,0 +1,83 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_ParsePrefix(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "PendingReview": 2, "Active": 3, "Archived": 4})
+	g.AddAlias("Active", "Live")
+
+	testCases := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{"act", "Active", nil},
+		{"ACT", "Active", nil},
+		{"pendingr", "PendingReview", nil},
+		{"pending", "Pending", nil},
+		{"li", "Active", nil},
+		{"a", "", ErrAmbiguous},
+		{"pend", "", ErrAmbiguous},
+		{"x", "", ErrUnknownValue},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := g.ParsePrefix(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil || v.String() != tc.want {
+				t.Errorf("Expected %s, got %v (%v)", tc.want, v, err)
+			}
+		})
+	}
+
+	t.Run("Ambiguity lists candidates", func(t *testing.T) {
+		_, err := g.ParsePrefix("pend")
+		if err == nil || !strings.Contains(err.Error(), `"pend" matches Pending, PendingReview`) {
+			t.Errorf("Expected candidates in error, got %v", err)
+		}
+	})
+
+	t.Run("Name and alias of one entry", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Active": 1, "Passive": 2})
+		g.AddAlias("Active", "Activated")
+		if v, err := g.ParsePrefix("activ"); err != nil || v.Get() != 1 {
+			t.Errorf("Expected Active, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Index follows mutations", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("Alpha")
+		if _, err := g.ParsePrefix("b"); err == nil {
+			t.Error("Expected no match before Beta is added")
+		}
+		g.Next("Beta")
+		if v, err := g.ParsePrefix("b"); err != nil || v.String() != "Beta" {
+			t.Errorf("Expected Beta, got %v (%v)", v, err)
+		}
+	})
+}
+
+func TestGenerator_Complete(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "PendingReview": 2, "Active": 3})
+	g.AddAlias("Pending", "pause")
+	if got := g.Complete("P"); !reflect.DeepEqual(got, []string{"pause", "Pending", "PendingReview"}) {
+		t.Errorf("Expected [pause Pending PendingReview], got %v", got)
+	}
+	if got := g.Complete("zz"); len(got) != 0 {
+		t.Errorf("Expected no completions, got %v", got)
+	}
+	if got := g.Complete(""); len(got) != 4 {
+		t.Errorf("Expected every name and alias, got %v", got)
+	}
+}
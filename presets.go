@@ -0,0 +1,57 @@
+package enum
+
+// weekdayNames lists the English day names starting from Sunday, matching time.Weekday.
+var weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// monthNames lists the English month names, matching time.Month minus one.
+var monthNames = []string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+// Weekdays returns a Generator with the days of the week, Sunday (0) through
+// Saturday (6), matching the values of time.Weekday. Each day also accepts its
+// three-letter abbreviation (e.g., "Mon") as an alias in Parse.
+//
+// The result is an ordinary Generator, so lookups, parsing, and serialization apply.
+//
+// Example:
+//
+//	days := Weekdays()
+//	v, _ := days.Parse("Mon") // Value[int]{value: 1, name: "Monday"}
+func Weekdays() *Generator[int] {
+	return newPreset(weekdayNames, 0)
+}
+
+// WeekdaysMondayFirst returns a Generator with the days of the week in ISO 8601
+// order, Monday (1) through Sunday (7). Like Weekdays, each day accepts its
+// three-letter abbreviation as an alias.
+func WeekdaysMondayFirst() *Generator[int] {
+	names := append(append([]string{}, weekdayNames[1:]...), weekdayNames[0])
+	return newPreset(names, 1)
+}
+
+// Months returns a Generator with the months of the year, January (1) through
+// December (12), matching the values of time.Month. Each month also accepts its
+// three-letter abbreviation (e.g., "Jan") as an alias in Parse.
+//
+// Example:
+//
+//	months := Months()
+//	v, _ := months.Parse("Sep") // Value[int]{value: 9, name: "September"}
+func Months() *Generator[int] {
+	return newPreset(monthNames, 1)
+}
+
+// newPreset builds a sequential Generator from names starting at start, registering
+// each name's three-letter abbreviation as an alias unless it is the name itself (e.g., "May").
+func newPreset(names []string, start int) *Generator[int] {
+	g := NewNumeric(start)
+	for _, name := range names {
+		g.Next(name)
+		if abbr := name[:3]; abbr != name {
+			g.AddAlias(name, abbr)
+		}
+	}
+	return g
+}
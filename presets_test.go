@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWeekdays(t *testing.T) {
+	days := Weekdays()
+	if days.Len() != 7 {
+		t.Fatalf("Expected 7 days, got %d", days.Len())
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if name, ok := days.Name(int(d)); !ok || name != d.String() {
+			t.Errorf("Expected %s for %d, got %q", d, d, name)
+		}
+	}
+	v, err := days.Parse("Mon")
+	if err != nil || v.Get() != 1 || v.String() != "Monday" {
+		t.Errorf("Expected 1/Monday, got %d/%q, err: %v", v.Get(), v.String(), err)
+	}
+	if !reflect.DeepEqual(days.Aliases("Saturday"), []string{"Sat"}) {
+		t.Errorf("Expected alias Sat, got %v", days.Aliases("Saturday"))
+	}
+	if days.ContainsName("Mon") {
+		t.Error("Expected aliases not to count as names")
+	}
+}
+
+func TestWeekdaysMondayFirst(t *testing.T) {
+	days := WeekdaysMondayFirst()
+	if names := days.Names(); names[0] != "Monday" || names[6] != "Sunday" {
+		t.Errorf("Expected Monday..Sunday, got %v", names)
+	}
+	if v, err := days.Parse("Sun"); err != nil || v.Get() != 7 {
+		t.Errorf("Expected Sun to be 7, got %d, err: %v", v.Get(), err)
+	}
+	if v, _ := days.Get("Monday"); v != 1 {
+		t.Errorf("Expected Monday to be 1, got %d", v)
+	}
+}
+
+func TestMonths(t *testing.T) {
+	months := Months()
+	for m := time.January; m <= time.December; m++ {
+		if name, ok := months.Name(int(m)); !ok || name != m.String() {
+			t.Errorf("Expected %s for %d, got %q", m, m, name)
+		}
+	}
+	v, err := months.Parse("Sep")
+	if err != nil || v.Get() != 9 || v.String() != "September" {
+		t.Errorf("Expected 9/September, got %d/%q, err: %v", v.Get(), v.String(), err)
+	}
+	if v, err := months.Parse("12"); err != nil || v.String() != "December" {
+		t.Errorf("Expected December, got %q, err: %v", v.String(), err)
+	}
+}
+
+func TestGenerator_AddAlias(t *testing.T) {
+	g := NewMapped(map[string]int{"Found": 302, "OK": 200})
+	g.AddAlias("Found", "Moved Temporarily")
+
+	if v, err := g.Parse("Moved Temporarily"); err != nil || v.String() != "Found" {
+		t.Errorf("Expected Found, got %q, err: %v", v.String(), err)
+	}
+
+	for _, tc := range []struct {
+		name, alias string
+	}{
+		{"Missing", "X"},
+		{"OK", "Found"},
+		{"OK", "Moved Temporarily"},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for AddAlias(%q, %q)", tc.name, tc.alias)
+				}
+			}()
+			g.AddAlias(tc.name, tc.alias)
+		}()
+	}
+
+	if err := g.Remove("Found"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Parse("Moved Temporarily"); err == nil {
+		t.Error("Expected alias to be removed with its entry")
+	}
+}
@@ -0,0 +1,55 @@
+package enum
+
+import "sync/atomic"
+
+// provenanceIDs hands out the IDs assigned by WithProvenance.
+var provenanceIDs atomic.Uint32
+
+// nextProvenanceID returns a new provenance ID, never 0.
+func nextProvenanceID() uint32 {
+	for {
+		if id := provenanceIDs.Add(1); id != 0 {
+			return id
+		}
+	}
+}
+
+// WithProvenance tags every entry the Generator hands out (from Next, Parse, Values,
+// and the other lookups) with an ID unique to the Generator. ValidateEntry and
+// Member.Set then reject entries tagged by another Generator, even when their values
+// happen to be registered here too, so a payment status passed where an order status
+// is expected fails instead of silently matching. Values built with NewValue, decoded
+// from JSON, or scanned from SQL are untagged and checked by value alone.
+//
+// Tagged entries compare unequal (==) to untagged ones with the same value and name.
+//
+// Example:
+//
+//	orders := NewGenerator[int](WithProvenance[int]())
+//	payments := NewGenerator[int](WithProvenance[int]())
+//	orders.Next("Pending")                      // 0
+//	refund := payments.Next("Refunded")         // 0
+//	err := orders.ValidateEntry(refund)         // errors.Is(err, ErrForeignEntry)
+//	err = orders.ValidateEntry(NewValue(0, "")) // nil: untagged, checked by value
+func WithProvenance[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		if g.origin == 0 {
+			g.origin = nextProvenanceID()
+		}
+	}
+}
+
+// entry returns a set Value tagged with the Generator's provenance ID, if any, and its
+// JSON encoding options.
+func (g *Generator[T]) entry(value T, name string) Value[T] {
+	return Value[T]{value: value, name: name, set: true, origin: g.origin, flags: g.marshal}
+}
+
+// adopt tags values in place with the Generator's provenance ID and JSON encoding
+// options, for entries built by another Generator and moved into this one. It returns values.
+func (g *Generator[T]) adopt(values []Value[T]) []Value[T] {
+	for i := range values {
+		values[i].origin, values[i].flags = g.origin, g.marshal
+	}
+	return values
+}
@@ -0,0 +1,106 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestWithProvenance(t *testing.T) {
+	orders := NewGenerator[int](WithProvenance[int]())
+	orders.Next("Pending") // 0
+	orders.Next("Shipped") // 1
+	payments := NewGenerator[int](WithProvenance[int]())
+	payments.Next("Authorized")           // 0
+	refunded := payments.Next("Refunded") // 1
+
+	t.Run("ForeignRejected", func(t *testing.T) {
+		if !orders.Contains(refunded.Get()) {
+			t.Fatal("Expected the values to overlap")
+		}
+		if err := orders.ValidateEntry(refunded); !errors.Is(err, ErrForeignEntry) {
+			t.Errorf("Expected ErrForeignEntry, got %v", err)
+		}
+		if err := orders.Validate(refunded.Get()); err != nil {
+			t.Errorf("Expected Validate to check the plain value only, got %v", err)
+		}
+		plain := NewGenerator[int]()
+		plain.Next("Zero")
+		plain.Next("One")
+		if err := plain.ValidateEntry(refunded); !errors.Is(err, ErrForeignEntry) {
+			t.Errorf("Expected a Generator without provenance to reject tagged entries, got %v", err)
+		}
+	})
+
+	t.Run("OwnAccepted", func(t *testing.T) {
+		v, err := orders.Parse("Shipped")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := orders.GetValue("Pending")
+		entries := append(orders.Values(), v, got)
+		for _, e := range entries {
+			if err := orders.ValidateEntry(e); err != nil {
+				t.Errorf("ValidateEntry(%v) = %v", e, err)
+			}
+			if err := payments.ValidateEntry(e); !errors.Is(err, ErrForeignEntry) {
+				t.Errorf("Expected %v to be foreign to payments, got %v", e, err)
+			}
+		}
+	})
+
+	t.Run("UntaggedByValue", func(t *testing.T) {
+		if err := orders.ValidateEntry(NewValue(1, "Shipped")); err != nil {
+			t.Errorf("Expected untagged values to be checked by value, got %v", err)
+		}
+		var decoded Value[int]
+		decoded = refunded
+		if err := json.Unmarshal([]byte(`1`), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if err := orders.ValidateEntry(decoded); err != nil {
+			t.Errorf("Expected decoding to clear the tag, got %v", err)
+		}
+	})
+
+	t.Run("Member", func(t *testing.T) {
+		m := orders.Member()
+		if err := m.Set(refunded); !errors.Is(err, ErrForeignEntry) {
+			t.Errorf("Expected ErrForeignEntry, got %v", err)
+		}
+		if m.String() != "" {
+			t.Errorf("Expected the member unchanged, got %q", m.String())
+		}
+		if err := m.Set(NewValue(1, "")); err != nil || m.String() != "Shipped" {
+			t.Errorf("Expected Shipped, got %q, %v", m.String(), err)
+		}
+		var unbound Member[int]
+		if err := unbound.Set(refunded); !errors.Is(err, ErrNilRegistry) {
+			t.Errorf("Expected ErrNilRegistry, got %v", err)
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		c := orders.Clone()
+		shipped, _ := orders.GetValue("Shipped")
+		if err := c.ValidateEntry(shipped); !errors.Is(err, ErrForeignEntry) {
+			t.Errorf("Expected the clone to be a new enum set, got %v", err)
+		}
+		for _, e := range c.Values() {
+			if err := c.ValidateEntry(e); err != nil {
+				t.Errorf("Expected the clone's entries to be its own, got %v", err)
+			}
+		}
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		g := NewMapped(map[string]int{"A": 1}, WithProvenance[int]())
+		before := g.Values()[0]
+		if changed, err := g.Reload([]byte(`{"1":"A"}`)); err != nil || changed {
+			t.Fatalf("Expected no change, got %v, %v", changed, err)
+		}
+		if err := g.ValidateEntry(before); err != nil {
+			t.Errorf("Expected entries to stay valid across reloads, got %v", err)
+		}
+	})
+}
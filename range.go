@@ -0,0 +1,51 @@
+package enum
+
+// Range calls fn for each entry in order, stopping early if fn returns false.
+// It iterates without copying, holding the read lock for the duration, so fn must
+// not call mutating methods on the Generator. It is thread-safe.
+//
+// Example:
+//
+//	g.Range(func(value int, name string) bool {
+//	    fmt.Println(value, name)
+//	    return true
+//	})
+func (g *Generator[T]) Range(fn func(value T, name string) bool) {
+	g.rlock()
+	defer g.runlock()
+	for _, entry := range g.values {
+		if !fn(entry.value, entry.name) {
+			return
+		}
+	}
+}
+
+// ValuesRef returns the Generator's internal entry slice without copying.
+//
+// Contract: the caller must not modify the returned slice. Its contents may change
+// if the Generator is mutated afterwards (Next, Remove, Rename, sorting), and reading
+// it concurrently with such mutations is a data race. Use it only for generators that
+// are fully populated before being shared; prefer Values or Range otherwise.
+func (g *Generator[T]) ValuesRef() []Value[T] {
+	g.rlock()
+	defer g.runlock()
+	return g.values
+}
+
+// UnsafeValueMap returns the Generator's internal value-to-name map without copying.
+// The same contract as ValuesRef applies: do not modify the map, and do not read it
+// concurrently with mutations of the Generator.
+func (g *Generator[T]) UnsafeValueMap() map[T]string {
+	g.rlock()
+	defer g.runlock()
+	return g.valueMap
+}
+
+// UnsafeNameMap returns the Generator's internal name-to-value map without copying.
+// The same contract as ValuesRef applies: do not modify the map, and do not read it
+// concurrently with mutations of the Generator.
+func (g *Generator[T]) UnsafeNameMap() map[string]T {
+	g.rlock()
+	defer g.runlock()
+	return g.nameMap
+}
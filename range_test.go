@@ -0,0 +1,62 @@
+package enum
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_Range(t *testing.T) {
+	g := NewGenerator[int]()
+	g.Next("A")
+	g.Next("B")
+	g.Next("C")
+
+	var names []string
+	g.Range(func(value int, name string) bool {
+		names = append(names, name)
+		return value < 1
+	})
+	if !reflect.DeepEqual(names, []string{"A", "B"}) {
+		t.Errorf("Expected early stop after B, got %v", names)
+	}
+}
+
+func TestGenerator_Refs(t *testing.T) {
+	g := NewMapped(map[string]int{"A": 1, "B": 2})
+	if !reflect.DeepEqual(g.ValuesRef(), g.Values()) {
+		t.Error("Expected ValuesRef to match Values")
+	}
+	if !reflect.DeepEqual(g.UnsafeValueMap(), g.ValueMap()) || !reflect.DeepEqual(g.UnsafeNameMap(), g.NameMap()) {
+		t.Error("Expected unsafe maps to match copies")
+	}
+	if allocs := testing.AllocsPerRun(10, func() { _ = g.UnsafeValueMap() }); allocs != 0 {
+		t.Errorf("Expected zero allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkGenerator_ValueMap(b *testing.B) {
+	g := NewGenerator[int]()
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		g.Next(name)
+	}
+	b.Run("Copy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for range g.ValueMap() {
+			}
+		}
+	})
+	b.Run("Unsafe", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for range g.UnsafeValueMap() {
+			}
+		}
+	})
+	b.Run("Range", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			g.Range(func(int, string) bool { return true })
+		}
+	})
+}
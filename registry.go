@@ -0,0 +1,174 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry is a type-erased view of an enum set, allowing generic tooling
+// (debug endpoints, template helpers, exporters) to work across enums with
+// different underlying types without reflection.
+//
+// It is implemented by *Generator[T], *BasicRegistry, and *Maker[T, E].
+type Registry interface {
+	// Names returns all names in the enum set, in definition order.
+	Names() []string
+	// NameOfAny returns the name for a value of the enum's underlying type,
+	// or an entry wrapping such a value.
+	NameOfAny(value any) (string, bool)
+	// ParseAny parses a name or value literal and returns the matching entry.
+	ParseAny(s string) (any, error)
+	// Kind returns the kind of the enum's underlying type.
+	Kind() ValueKind
+}
+
+// nilable is implemented by the pointer types of this package that implement Registry,
+// so that Register can reject nil pointers of those types without reflection.
+type nilable interface {
+	isNil() bool
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Registry)
+)
+
+// Register adds an enum set to the package-level registry under name so that it
+// can be discovered from other packages with Lookup. The enum set must implement
+// Registry (e.g., *Generator[T], *BasicRegistry, or *Maker[T, E]).
+//
+// Returns an error if name is empty, already registered, or e does not implement Registry,
+// and an error wrapping ErrNilRegistry if e is a nil pointer such as (*Generator[T])(nil).
+//
+// Example:
+//
+//	var Statuses = enum.NewMapped(map[string]int{"Pending": 1, "Active": 2})
+//
+//	func init() {
+//	    if err := enum.Register("status", Statuses); err != nil {
+//	        panic(err)
+//	    }
+//	}
+func Register(name string, e any) error {
+	if name == "" {
+		return errors.New("enum: registry name must not be empty")
+	}
+	r, ok := e.(Registry)
+	if !ok || r == nil {
+		return fmt.Errorf("enum: cannot register %q: %T does not implement Registry", name, e)
+	}
+	if n, ok := r.(nilable); ok && n.isNil() {
+		return fmt.Errorf("enum: cannot register %q: nil %T: %w", name, e, ErrNilRegistry)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, exists := registry[name]; exists {
+		return fmt.Errorf("enum: %q is already registered", name)
+	}
+	registry[name] = r
+	return nil
+}
+
+// Lookup returns the enum set registered under name.
+func Lookup(name string) (Registry, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	r, ok := registry[name]
+	return r, ok
+}
+
+// Registered returns the names of all registered enum sets, sorted alphabetically.
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NameOfAny implements Registry, returning the name for a value of type T or
+// an Entry[T]. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) NameOfAny(value any) (string, bool) {
+	v, ok := underlyingOf[T](value)
+	if !ok {
+		return "", false
+	}
+	return g.Name(v)
+}
+
+// ParseAny implements Registry, returning the Value[T] produced by Parse.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ParseAny(s string) (any, error) {
+	return g.Parse(s)
+}
+
+// isNil implements nilable.
+func (g *Generator[T]) isNil() bool { return g == nil }
+
+// isNil implements nilable.
+func (r *BasicRegistry) isNil() bool { return r == nil }
+
+// isNil implements nilable.
+func (e *Basic) isNil() bool { return e == nil }
+
+// isNil implements nilable.
+func (l *LazyGenerator[T]) isNil() bool { return l == nil }
+
+// Names returns a slice of all enum names in the registry.
+//
+// Example:
+//
+//	b := NewBasicRegistry()
+//	b.Add("Pending")
+//	b.Add("Active")
+//	names := b.Names() // Returns ["Pending", "Active"]
+func (r *BasicRegistry) Names() []string {
+	return r.meta.Names()
+}
+
+// Version is like Generator.Version, counting the mutations of the registry's values.
+func (r *BasicRegistry) Version() uint64 {
+	return r.meta.Version()
+}
+
+// NameOfAny implements Registry, returning the name for an int, Value[int], or Basic.
+func (r *BasicRegistry) NameOfAny(value any) (string, bool) {
+	return r.meta.NameOfAny(value)
+}
+
+// ParseAny implements Registry, parsing a name or integer literal and returning
+// the matching Basic value.
+func (r *BasicRegistry) ParseAny(s string) (any, error) {
+	v, err := r.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Names is like BasicRegistry.Names.
+//
+// Deprecated: Call Names on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) Names() []string {
+	return e.registry("Names").Names()
+}
+
+// NameOfAny is like BasicRegistry.NameOfAny.
+//
+// Deprecated: Call NameOfAny on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) NameOfAny(value any) (string, bool) {
+	return e.registry("NameOfAny").NameOfAny(value)
+}
+
+// ParseAny is like BasicRegistry.ParseAny.
+//
+// Deprecated: Call ParseAny on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) ParseAny(s string) (any, error) {
+	return e.registry("ParseAny").ParseAny(s)
+}
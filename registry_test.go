@@ -0,0 +1,95 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// resetRegistry clears the package-level registry for test isolation.
+func resetRegistry(t *testing.T) {
+	t.Helper()
+	registryMu.Lock()
+	registry = make(map[string]Registry)
+	registryMu.Unlock()
+}
+
+func TestRegistry(t *testing.T) {
+	resetRegistry(t)
+	defer resetRegistry(t)
+
+	g := NewMapped(map[string]int{"Low": 1, "High": 10})
+	b := NewBasicRegistry()
+	b.Add("Pending")
+	b.Add("Active")
+
+	for name, e := range map[string]any{"level": g, "status": b} {
+		if err := Register(name, e); err != nil {
+			t.Fatalf("Register(%q) failed: %v", name, err)
+		}
+	}
+
+	t.Run("Double registration", func(t *testing.T) {
+		if err := Register("level", g); err == nil {
+			t.Error("Expected error for duplicate registration")
+		}
+	})
+
+	t.Run("Invalid registrations", func(t *testing.T) {
+		if err := Register("", g); err == nil {
+			t.Error("Expected error for empty name")
+		}
+		if err := Register("number", 42); err == nil {
+			t.Error("Expected error for non-Registry value")
+		}
+		for _, e := range []any{(*Generator[int])(nil), (*BasicRegistry)(nil), (*Basic)(nil), (*LazyGenerator[int])(nil)} {
+			if err := Register("nil", e); !errors.Is(err, ErrNilRegistry) {
+				t.Errorf("Register(%T(nil)) = %v, want ErrNilRegistry", e, err)
+			}
+		}
+		if _, ok := Lookup("nil"); ok {
+			t.Error("Expected nil registries not to be registered")
+		}
+	})
+
+	t.Run("Registered", func(t *testing.T) {
+		want := []string{"level", "status"}
+		if got := Registered(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Lookup Generator", func(t *testing.T) {
+		r, ok := Lookup("level")
+		if !ok {
+			t.Fatal("Expected level to be registered")
+		}
+		if name, ok := r.NameOfAny(10); !ok || name != "High" {
+			t.Errorf("Expected High for 10, got %q", name)
+		}
+		v, err := r.ParseAny("Low")
+		if err != nil || v.(Value[int]).Get() != 1 {
+			t.Errorf("Expected Low to parse to 1, got %v, err: %v", v, err)
+		}
+	})
+
+	t.Run("Lookup Basic", func(t *testing.T) {
+		r, _ := Lookup("status")
+		if !reflect.DeepEqual(r.Names(), []string{"Pending", "Active"}) {
+			t.Errorf("Unexpected names %v", r.Names())
+		}
+		v, err := r.ParseAny("1")
+		if err != nil || v.(Basic).String() != "Active" {
+			t.Errorf("Expected Active, got %v, err: %v", v, err)
+		}
+		if _, err := r.ParseAny("Closed"); err == nil {
+			t.Error("Expected error for unknown name")
+		}
+	})
+
+	t.Run("Lookup missing", func(t *testing.T) {
+		if _, ok := Lookup("missing"); ok {
+			t.Error("Expected Lookup to fail for unregistered name")
+		}
+	})
+}
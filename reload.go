@@ -0,0 +1,133 @@
+package enum
+
+// Reload replaces the Generator's entries with those in data, in any form UnmarshalJSON
+// accepts, and reports whether they changed. The payload is decoded and validated in
+// full before the Generator is locked, so a bad payload leaves it untouched, and the new
+// entries are swapped in at once under the write lock: a single call never observes a
+// mix of old and new entries. A change bumps Version, so dependent caches and Snapshot
+// know to refresh; reloading identical entries leaves the version as it is.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Separate calls can still straddle a reload (Get from the old set, then Name from the
+// new one). Readers whose lookups must agree should take a Snapshot and use it for all
+// of them.
+//
+// Example:
+//
+//	changed, err := g.Reload(payload)
+//	if err != nil {
+//		return err // g is unchanged.
+//	}
+//	if changed {
+//		log.Printf("enum reloaded at version %d", g.Version())
+//	}
+func (g *Generator[T]) Reload(data []byte) (changed bool, err error) {
+	values, valueMap, nameMap, err := g.decodeJSONEntries(data)
+	if err != nil {
+		return false, err
+	}
+	g.lock()
+	defer g.unlock()
+	if len(g.extraNames) == 0 && sameEntries(g.values, values) {
+		g.incrementer = nil // As after any reload, Next is unsupported.
+		return false, nil
+	}
+	g.replaceLocked(values, valueMap, nameMap)
+	return true, nil
+}
+
+// sameEntries reports whether a and b hold the same entries in the same order.
+func sameEntries[T TypesValue](a, b []Value[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Snapshot is an immutable copy of a Generator's entries at one version, so that a
+// series of lookups sees a single state even while the Generator is modified or
+// reloaded. It is safe for concurrent use.
+type Snapshot[T TypesValue] struct {
+	version  uint64
+	values   []Value[T]
+	valueMap map[T]string
+	nameMap  map[string]T
+}
+
+// Snapshot returns the entries at the current version. Snapshots are cached and shared
+// until the Generator changes, so taking one per request is cheap. It is thread-safe,
+// using a read lock for access when the cached snapshot is stale.
+//
+// Example:
+//
+//	s := g.Snapshot()
+//	v, _ := s.Get("Active")
+//	name, _ := s.Name(v) // Same state as Get, even if g was reloaded in between.
+func (g *Generator[T]) Snapshot() *Snapshot[T] {
+	if s := g.snapshot.Load(); s != nil && s.version == g.version.Load() {
+		return s
+	}
+	g.rlock()
+	s := &Snapshot[T]{
+		version:  g.version.Load(),
+		values:   make([]Value[T], len(g.values)),
+		valueMap: make(map[T]string, len(g.valueMap)),
+		nameMap:  make(map[string]T, len(g.nameMap)),
+	}
+	copy(s.values, g.values)
+	for value, name := range g.valueMap {
+		s.valueMap[value] = name
+	}
+	for name, value := range g.nameMap {
+		s.nameMap[name] = value
+	}
+	g.runlock()
+	g.snapshot.Store(s)
+	return s
+}
+
+// Version returns the Generator version the snapshot was taken at.
+func (s *Snapshot[T]) Version() uint64 {
+	return s.version
+}
+
+// Values returns a copy of the entries.
+func (s *Snapshot[T]) Values() []Value[T] {
+	out := make([]Value[T], len(s.values))
+	copy(out, s.values)
+	return out
+}
+
+// Len returns the number of entries.
+func (s *Snapshot[T]) Len() int {
+	return len(s.values)
+}
+
+// Name returns the canonical name of value, like Generator.Name.
+func (s *Snapshot[T]) Name(value T) (string, bool) {
+	name, ok := s.valueMap[value]
+	return name, ok
+}
+
+// Get returns the value of name, like Generator.Get.
+func (s *Snapshot[T]) Get(name string) (T, bool) {
+	value, ok := s.nameMap[name]
+	return value, ok
+}
+
+// Contains reports whether value is one of the entries.
+func (s *Snapshot[T]) Contains(value T) bool {
+	_, ok := s.valueMap[value]
+	return ok
+}
+
+// ContainsName reports whether name is one of the entries' names.
+func (s *Snapshot[T]) ContainsName(name string) bool {
+	_, ok := s.nameMap[name]
+	return ok
+}
@@ -0,0 +1,143 @@
+package enum
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGenerator_Reload(t *testing.T) {
+	t.Run("Changed", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+		before := g.Version()
+		changed, err := g.Reload([]byte(`{"1":"Pending","2":"Active","3":"Done"}`))
+		if err != nil || !changed {
+			t.Fatalf("Expected a change, got %v, %v", changed, err)
+		}
+		if g.Version() == before {
+			t.Error("Expected the version to be bumped")
+		}
+		if name, ok := g.Name(3); !ok || name != "Done" {
+			t.Errorf("Expected Done for 3, got %q", name)
+		}
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+		before := g.Version()
+		changed, err := g.Reload([]byte(`[{"value":1,"name":"Pending"},{"value":2,"name":"Active"}]`))
+		if err != nil || changed {
+			t.Fatalf("Expected no change, got %v, %v", changed, err)
+		}
+		if g.Version() != before {
+			t.Error("Expected the version to stay the same")
+		}
+	})
+
+	t.Run("OrderIsAChange", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Pending": 1, "Active": 2}, WithSortedEntries[int]())
+		if changed, err := g.Reload([]byte(`[{"value":2,"name":"Active"},{"value":1,"name":"Pending"}]`)); err != nil || !changed {
+			t.Errorf("Expected reordering to count as a change, got %v, %v", changed, err)
+		}
+	})
+
+	t.Run("InvalidLeavesUnchanged", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Pending": 1})
+		before := g.Version()
+		for _, data := range []string{`{"1":"Pending","2":"Pending"}`, `{"x":"Bad"}`, `[`} {
+			if changed, err := g.Reload([]byte(data)); err == nil || changed {
+				t.Errorf("Reload(%s) = %v, %v; want an error", data, changed, err)
+			}
+		}
+		if g.Version() != before || !g.ContainsName("Pending") || g.Len() != 1 {
+			t.Errorf("Expected the Generator untouched, got %v at version %d", g.Names(), g.Version())
+		}
+	})
+
+	t.Run("UnmarshalJSONDelegates", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		if err := g.UnmarshalJSON([]byte(`{"0":"A"}`)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := g.TryNext("B"); err == nil {
+			t.Error("Expected Next to be unsupported after UnmarshalJSON, even without changes")
+		}
+	})
+}
+
+func TestGenerator_Snapshot(t *testing.T) {
+	g := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+	s := g.Snapshot()
+	if g.Snapshot() != s {
+		t.Error("Expected the snapshot to be reused while the Generator is unchanged")
+	}
+	if _, err := g.Reload([]byte(`{"1":"Open","2":"Closed"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := s.Name(1); name != "Pending" || !s.ContainsName("Active") || s.Len() != 2 {
+		t.Errorf("Expected the old snapshot to keep the old entries, got %v", s.Values())
+	}
+	s2 := g.Snapshot()
+	if s2 == s || s2.Version() != g.Version() {
+		t.Fatalf("Expected a new snapshot at version %d, got version %d", g.Version(), s2.Version())
+	}
+	if v, ok := s2.Get("Open"); !ok || v != 1 || !s2.Contains(2) {
+		t.Errorf("Expected the new entries, got %v", s2.Values())
+	}
+}
+
+func TestGenerator_ReloadConcurrent(t *testing.T) {
+	sets := [][]byte{
+		[]byte(`{"1":"OldA","2":"OldB","3":"OldC"}`),
+		[]byte(`{"1":"NewA","2":"NewB","3":"NewC","4":"NewD"}`),
+	}
+	g := NewMapped(map[string]int{})
+	if _, err := g.Reload(sets[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for r := 0; r < 8; r++ {
+		wg.Add(1)
+		go func(r int) {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if r%2 == 0 {
+					// A snapshot always holds one complete set.
+					s := g.Snapshot()
+					names := make(map[byte]bool)
+					for _, v := range s.Values() {
+						names[v.String()[0]] = true
+						if value, ok := s.Get(v.String()); !ok || value != v.Get() {
+							t.Errorf("Snapshot lookups disagree for %v", v)
+							return
+						}
+					}
+					if len(names) != 1 || (s.Len() != 3 && s.Len() != 4) {
+						t.Errorf("Snapshot mixes sets: %v", s.Values())
+						return
+					}
+				} else {
+					// Each call sees one set, even if consecutive calls do not.
+					for _, v := range g.Values() {
+						g.Name(v.Get())
+						g.Get(v.String())
+					}
+				}
+			}
+		}(r)
+	}
+	for i := 0; i < 200; i++ {
+		if _, err := g.Reload(sets[i%2]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
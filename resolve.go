@@ -0,0 +1,223 @@
+//go:build !noreflect
+
+package enum
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// UnresolvedField describes an enum field that ResolveStruct could not resolve.
+type UnresolvedField struct {
+	Path string // Path of the field, e.g., "Server.Status" or "Jobs[2].State".
+	Name string // Name the field holds, empty for fields of a named integer type.
+	Err  error  // Why it failed; wraps ErrUnknownValue if the name is not registered.
+}
+
+// ResolveError reports every field ResolveStruct could not resolve. It unwraps to the
+// errors of the fields, so errors.Is(err, ErrUnknownValue) holds if any name is unknown.
+type ResolveError struct {
+	Unresolved []UnresolvedField // Failed fields, in struct order.
+}
+
+// Error implements the error interface, listing every failed field.
+func (e *ResolveError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "enum: %d enum fields are unresolved:", len(e.Unresolved))
+	for i, f := range e.Unresolved {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		fmt.Fprintf(&b, " %s: %v", f.Path, f.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the failed fields.
+func (e *ResolveError) Unwrap() []error {
+	errs := make([]error, len(e.Unresolved))
+	for i, f := range e.Unresolved {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// nameResolver is implemented by the field types whose UnmarshalText records a name
+// for ResolveStruct to resolve: *Value[T] and *Basic.
+type nameResolver interface {
+	// pendingName returns the recorded name, reporting false if there is none to resolve.
+	pendingName() (string, bool)
+	// resolveEntry sets the field to entry, reporting false if entry has another type.
+	resolveEntry(entry any) bool
+}
+
+func (e *Value[T]) pendingName() (string, bool) {
+	return e.name, !e.set && e.name != ""
+}
+
+func (e *Value[T]) resolveEntry(entry any) bool {
+	v, ok := entry.(Value[T])
+	if ok {
+		*e = v
+	}
+	return ok
+}
+
+func (e *Basic) pendingName() (string, bool) {
+	return e.name, !e.set && e.name != ""
+}
+
+func (e *Basic) resolveEntry(entry any) bool {
+	v, ok := entry.(Basic)
+	if ok {
+		*e = v
+	}
+	return ok
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// ResolveStruct resolves the enum fields of the struct ptr points to after a text-first
+// config format (YAML, TOML, environment variables) filled them in with UnmarshalText.
+// It walks exported fields, nested and embedded structs, non-nil pointers, slices, and
+// arrays (not maps), and for each enum field asks lookup for the registry by path:
+//
+//   - Value[T] and Basic fields holding only a name are resolved with the registry's
+//     ParseAny, which must yield a Value[T] or Basic respectively. Names, aliases, and
+//     value literals are accepted. Set fields and empty ones are left alone.
+//   - Fields of a named integer type with an UnmarshalText method, such as those wired
+//     to a Typed registry by GenerateGo, already resolve themselves; if lookup returns
+//     a registry for them, their value is checked to be registered.
+//
+// Paths join Go field names with dots, with embedded structs promoted like encoding/json
+// does. lookup receives slice and array elements as "[]" (e.g., "Jobs[].State") so one
+// case covers them all, while errors name the index. A name-only field without a
+// registry is reported with an error wrapping ErrNilRegistry.
+//
+// Every failure is collected into a *ResolveError rather than stopping at the first.
+// Returns an error if ptr is not a non-nil pointer to a struct.
+//
+// Example:
+//
+//	type Config struct {
+//	    Status Value[int] // status: Active
+//	    Level  Basic      // level: High
+//	}
+//	var cfg Config
+//	_ = yaml.Unmarshal(data, &cfg)
+//	err := ResolveStruct(&cfg, func(path string) (Registry, bool) {
+//	    switch path {
+//	    case "Status":
+//	        return statuses, true
+//	    case "Level":
+//	        return levels, true
+//	    }
+//	    return nil, false
+//	})
+//	// err: enum: 1 enum fields are unresolved: Status: invalid enum value: "Actve"
+func ResolveStruct(ptr any, lookup func(fieldPath string) (Registry, bool)) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("enum: ResolveStruct needs a non-nil pointer to a struct, got %T", ptr)
+	}
+	w := structResolver{lookup: lookup}
+	w.walk(rv.Elem(), "", "")
+	if len(w.unresolved) > 0 {
+		return &ResolveError{Unresolved: w.unresolved}
+	}
+	return nil
+}
+
+// structResolver carries the state of one ResolveStruct call.
+type structResolver struct {
+	lookup     func(fieldPath string) (Registry, bool)
+	unresolved []UnresolvedField
+}
+
+// walk resolves v and the fields beneath it. path names v for errors, and pattern is
+// path with element indices replaced by "[]", for lookup.
+func (w *structResolver) walk(v reflect.Value, path, pattern string) {
+	if v.CanAddr() && v.CanInterface() {
+		if r, ok := v.Addr().Interface().(nameResolver); ok {
+			w.resolveName(r, path, pattern)
+			return
+		}
+	}
+	switch kind := v.Kind(); {
+	case kind == reflect.Pointer:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path, pattern)
+		}
+	case kind == reflect.Struct:
+		if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+			return // A leaf for text formats, like time.Time.
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && (f.IsExported() || f.Type.Kind() == reflect.Struct) {
+				w.walk(v.Field(i), path, pattern)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			w.walk(v.Field(i), joinFieldPath(path, f.Name), joinFieldPath(pattern, f.Name))
+		}
+	case kind == reflect.Slice || kind == reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]", pattern+"[]")
+		}
+	case kind >= reflect.Int && kind <= reflect.Uint64:
+		if v.CanAddr() && v.CanInterface() && v.Addr().Type().Implements(textUnmarshalerType) {
+			w.checkRegistered(v, path, pattern)
+		}
+	}
+}
+
+// resolveName resolves a Value or Basic field holding only a name.
+func (w *structResolver) resolveName(r nameResolver, path, pattern string) {
+	name, pending := r.pendingName()
+	if !pending {
+		return
+	}
+	fail := func(err error) {
+		w.unresolved = append(w.unresolved, UnresolvedField{Path: path, Name: name, Err: err})
+	}
+	reg, ok := w.lookup(pattern)
+	if !ok || reg == nil {
+		fail(fmt.Errorf("%w: no registry for %s", ErrNilRegistry, pattern))
+		return
+	}
+	entry, err := reg.ParseAny(name)
+	if err != nil {
+		fail(fmt.Errorf("%w: %q", ErrUnknownValue, name))
+		return
+	}
+	if !r.resolveEntry(entry) {
+		fail(fmt.Errorf("enum: the registry for %s yields %T, which does not fit the field", pattern, entry))
+	}
+}
+
+// checkRegistered checks that a field of a named integer type holds a registered value,
+// if lookup has a registry for it.
+func (w *structResolver) checkRegistered(v reflect.Value, path, pattern string) {
+	reg, ok := w.lookup(pattern)
+	if !ok || reg == nil {
+		return
+	}
+	if _, ok := reg.NameOfAny(v.Interface()); !ok {
+		w.unresolved = append(w.unresolved, UnresolvedField{Path: path, Err: fmt.Errorf("%w: %v", ErrUnknownValue, v.Interface())})
+	}
+}
+
+// joinFieldPath appends a field name to a path.
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,172 @@
+//go:build !noreflect
+
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// resolvePriority is a named type wired to a Typed registry, as GenerateGo wires it.
+type resolvePriority int
+
+var resolvePriorities = TypedOf(MustRegisterConstants(map[string]resolvePriority{"Low": 1, "High": 2}))
+
+func (p *resolvePriority) UnmarshalText(text []byte) error {
+	return resolvePriorities.UnmarshalText(text, p)
+}
+
+type resolveBase struct {
+	Level Basic
+}
+
+type resolveJob struct {
+	State Value[string]
+}
+
+type resolveConfig struct {
+	resolveBase
+	Server struct {
+		Status Value[int]
+	}
+	Jobs     []resolveJob
+	Backup   *resolveJob
+	Priority resolvePriority
+	Started  time.Time
+	hidden   Value[int]
+}
+
+func TestResolveStruct(t *testing.T) {
+	statuses := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+	states := NewMapped(map[string]string{"Queued": "q", "Running": "r"})
+	levels := NewBasicRegistry()
+	levels.Add("Low")
+	levels.Add("High")
+	lookup := func(path string) (Registry, bool) {
+		switch path {
+		case "Level":
+			return levels, true
+		case "Server.Status":
+			return statuses, true
+		case "Jobs[].State", "Backup.State":
+			return states, true
+		case "Priority":
+			return resolvePriorities.Registry(), true
+		}
+		return nil, false
+	}
+
+	// decode fills cfg the way a text config decoder would, through UnmarshalText.
+	decode := func(t *testing.T, fields map[string]string) *resolveConfig {
+		t.Helper()
+		cfg := &resolveConfig{Jobs: make([]resolveJob, 2), Backup: &resolveJob{}, Priority: 1}
+		targets := map[string]interface{ UnmarshalText([]byte) error }{
+			"Level":         &cfg.Level,
+			"Server.Status": &cfg.Server.Status,
+			"Jobs[0].State": &cfg.Jobs[0].State,
+			"Jobs[1].State": &cfg.Jobs[1].State,
+			"Backup.State":  &cfg.Backup.State,
+			"hidden":        &cfg.hidden,
+		}
+		for path, text := range fields {
+			if err := targets[path].UnmarshalText([]byte(text)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return cfg
+	}
+
+	t.Run("Resolved", func(t *testing.T) {
+		cfg := decode(t, map[string]string{
+			"Level":         "High",
+			"Server.Status": "Active",
+			"Jobs[0].State": "Queued",
+			"Jobs[1].State": "r",
+			"Backup.State":  "Running",
+			"hidden":        "Bogus",
+		})
+		if cfg.Server.Status.IsSet() || cfg.Server.Status.String() != "Active" {
+			t.Fatal("Expected UnmarshalText to record the name")
+		}
+		if err := ResolveStruct(cfg, lookup); err != nil {
+			t.Fatal(err)
+		}
+		if got := cfg.Level; got.Get() != 1 || got.String() != "High" || !got.IsSet() || got.Registry() == nil {
+			t.Errorf("Level = %v, want High (1) from the registry", got)
+		}
+		if got := cfg.Server.Status; got != NewValue(2, "Active") {
+			t.Errorf("Server.Status = %#v, want Active", got)
+		}
+		want := []resolveJob{{NewValue("q", "Queued")}, {NewValue("r", "Running")}}
+		if !reflect.DeepEqual(cfg.Jobs, want) || cfg.Backup.State.Get() != "r" {
+			t.Errorf("Jobs = %v, Backup = %v", cfg.Jobs, cfg.Backup)
+		}
+		if cfg.hidden.IsSet() {
+			t.Error("Expected unexported fields to be skipped")
+		}
+	})
+
+	t.Run("AllFailuresReported", func(t *testing.T) {
+		cfg := decode(t, map[string]string{
+			"Level":         "Medium",
+			"Server.Status": "Actve",
+			"Jobs[1].State": "Done",
+		})
+		cfg.Priority = 7
+		err := ResolveStruct(cfg, lookup)
+		var rerr *ResolveError
+		if !errors.As(err, &rerr) {
+			t.Fatalf("Expected *ResolveError, got %v", err)
+		}
+		var paths []string
+		for _, f := range rerr.Unresolved {
+			paths = append(paths, f.Path)
+		}
+		if want := []string{"Level", "Server.Status", "Jobs[1].State", "Priority"}; !reflect.DeepEqual(paths, want) {
+			t.Errorf("Unresolved paths = %v, want %v", paths, want)
+		}
+		if rerr.Unresolved[1].Name != "Actve" || !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected the name and ErrUnknownValue, got %+v", rerr.Unresolved[1])
+		}
+		if msg := err.Error(); !strings.HasPrefix(msg, `enum: 4 enum fields are unresolved: Level: invalid enum value: "Medium";`) {
+			t.Errorf("Unexpected message %q", msg)
+		}
+	})
+
+	t.Run("NoRegistry", func(t *testing.T) {
+		cfg := decode(t, map[string]string{"Server.Status": "Active"})
+		err := ResolveStruct(cfg, func(string) (Registry, bool) { return nil, false })
+		if !errors.Is(err, ErrNilRegistry) || !strings.Contains(err.Error(), "no registry for Server.Status") {
+			t.Errorf("Expected a missing registry error, got %v", err)
+		}
+	})
+
+	t.Run("WrongRegistry", func(t *testing.T) {
+		cfg := decode(t, map[string]string{"Server.Status": "Queued"})
+		err := ResolveStruct(cfg, func(string) (Registry, bool) { return states, true })
+		if err == nil || !strings.Contains(err.Error(), "yields enum.Value[string]") {
+			t.Errorf("Expected a type mismatch, got %v", err)
+		}
+	})
+
+	t.Run("BoundBasic", func(t *testing.T) {
+		b := levels.Empty()
+		if err := b.UnmarshalText([]byte("High")); err != nil || !b.IsSet() || b.Get() != 1 {
+			t.Errorf("Expected a Basic with a registry to resolve at once, got %v, %v", b, err)
+		}
+		if err := b.UnmarshalText([]byte("Medium")); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("InvalidTarget", func(t *testing.T) {
+		for _, ptr := range []any{resolveConfig{}, (*resolveConfig)(nil), new(int), nil} {
+			if err := ResolveStruct(ptr, lookup); err == nil {
+				t.Errorf("Expected an error for %T", ptr)
+			}
+		}
+	})
+}
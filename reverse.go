@@ -0,0 +1,57 @@
+package enum
+
+// ValuesReversed returns a copy of the entries in reverse insertion order, most
+// recently added first. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	g := NewGenerator[int]()
+//	g.Next("A")
+//	g.Next("B")
+//	fmt.Println(g.ValuesReversed()) // Output: [B A]
+func (g *Generator[T]) ValuesReversed() []Value[T] {
+	g.rlock()
+	defer g.runlock()
+	out := make([]Value[T], len(g.values))
+	for i, v := range g.values {
+		out[len(out)-1-i] = v
+	}
+	return out
+}
+
+// Backward returns an iterator over the entries in reverse insertion order, without
+// copying them. The result is an iter.Seq[Value[T]], usable with range over func
+// (Go 1.23+) or by calling it with a yield function.
+//
+// The read lock is held only while each entry is read, never while yield runs, so
+// the loop body may mutate the Generator. Entries added during iteration are not
+// visited; after a removal, iteration resumes at the nearest remaining position, so
+// an entry may be skipped but is never visited twice unless the body reorders entries.
+//
+// Example:
+//
+//	for v := range g.Backward() {
+//	    fmt.Println(v) // B, then A
+//	}
+func (g *Generator[T]) Backward() func(yield func(Value[T]) bool) {
+	return func(yield func(Value[T]) bool) {
+		g.rlock()
+		i := len(g.values) - 1
+		g.runlock()
+		for ; i >= 0; i-- {
+			g.rlock()
+			if i >= len(g.values) {
+				i = len(g.values) - 1
+			}
+			if i < 0 {
+				g.runlock()
+				return
+			}
+			v := g.values[i]
+			g.runlock()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
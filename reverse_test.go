@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	names := func(values []Value[int]) []string {
+		out := make([]string, len(values))
+		for i, v := range values {
+			out[i] = v.String()
+		}
+		return out
+	}
+	collect := func(seq func(func(Value[int]) bool)) []Value[int] {
+		var out []Value[int]
+		seq(func(v Value[int]) bool {
+			out = append(out, v)
+			return true
+		})
+		return out
+	}
+
+	g := NewGenerator[int]()
+	g.Next("A")
+	g.Next("B")
+	g.Next("C")
+
+	t.Run("ValuesReversed", func(t *testing.T) {
+		if got := names(g.ValuesReversed()); !reflect.DeepEqual(got, []string{"C", "B", "A"}) {
+			t.Errorf("Expected [C B A], got %v", got)
+		}
+		if got := NewGenerator[int]().ValuesReversed(); len(got) != 0 {
+			t.Errorf("Expected no entries, got %v", got)
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		if got := names(collect(g.Backward())); !reflect.DeepEqual(got, []string{"C", "B", "A"}) {
+			t.Errorf("Expected [C B A], got %v", got)
+		}
+	})
+
+	t.Run("Backward stops early", func(t *testing.T) {
+		var seen []string
+		g.Backward()(func(v Value[int]) bool {
+			seen = append(seen, v.String())
+			return len(seen) < 2
+		})
+		if !reflect.DeepEqual(seen, []string{"C", "B"}) {
+			t.Errorf("Expected [C B], got %v", seen)
+		}
+	})
+
+	t.Run("Mutation during iteration", func(t *testing.T) {
+		g := NewGenerator[int]()
+		for _, name := range []string{"A", "B", "C", "D"} {
+			g.Next(name)
+		}
+		var seen []string
+		g.Backward()(func(v Value[int]) bool {
+			seen = append(seen, v.String())
+			if v.String() == "D" {
+				_ = g.Remove("D") // Does not deadlock: no lock is held during yield.
+				g.Next("E")       // Not visited.
+			}
+			return true
+		})
+		if !reflect.DeepEqual(seen, []string{"D", "C", "B", "A"}) {
+			t.Errorf("Expected [D C B A], got %v", seen)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		g := NewGenerator[int]()
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				g.Next(formatKey(i))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				collect(g.Backward())
+			}
+		}()
+		wg.Wait()
+	})
+}
@@ -0,0 +1,112 @@
+package enum
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// WithRuneFormatting makes the Generator treat its values as characters (runes), for
+// enums such as token kinds defined as '+', '-', or 'i'. Error messages show values
+// as quoted characters ('+' rather than 43), MarshalJSON and the Handler catalog use the
+// character itself as the value, and Parse accepts a single character (or a quoted
+// one, like '+') as the value literal. Longer numeric literals are still accepted.
+//
+// Panics if T is not an integer type.
+//
+// Example:
+//
+//	tokens := NewMapped(map[string]rune{"Plus": '+', "Minus": '-'}, WithRuneFormatting[rune]())
+//	v, _ := tokens.Parse("+")    // Value[rune]{value: '+', name: "Plus"}
+//	err := tokens.Validate('*')  // err: invalid enum value: '*'
+//	b, _ := json.Marshal(tokens) // {"+":"Plus","-":"Minus"}
+func WithRuneFormatting[T TypesValue]() Option[T] {
+	if !isInteger[T]() {
+		var zero T
+		panic(fmt.Sprintf("enum: WithRuneFormatting requires an integer type, got %T", zero))
+	}
+	return func(g *Generator[T]) {
+		g.runes = true
+	}
+}
+
+// NewRuneGenerator creates a Generator[rune] with WithRuneFormatting and the given options.
+// Use WithStart to begin at a character; the default incrementer advances by code point.
+//
+// Example:
+//
+//	g := NewRuneGenerator(WithStart('a'))
+//	g.Next("First")  // 'a'
+//	g.Next("Second") // 'b'
+func NewRuneGenerator(opts ...Option[rune]) *Generator[rune] {
+	return NewGenerator[rune](append([]Option[rune]{WithRuneFormatting[rune]()}, opts...)...)
+}
+
+// FormatValue formats value for display: with the function given to WithValueFormatter
+// if any, as a quoted character (e.g., '+') if the Generator uses WithRuneFormatting,
+// and otherwise in decimal for numbers and as-is for strings.
+func (g *Generator[T]) FormatValue(value T) string {
+	return g.formatValue(value)
+}
+
+// formatValue implements FormatValue. The formatter and runes flag are only set by
+// options, so no lock is needed.
+func (g *Generator[T]) formatValue(value T) string {
+	if g.formatter != nil {
+		return g.formatter(value)
+	}
+	if g.runes {
+		return strconv.QuoteRune(valueRune(value))
+	}
+	return formatKey(value)
+}
+
+// jsonKey formats value as a JSON object key, as the character itself under WithRuneFormatting.
+func (g *Generator[T]) jsonKey(value T) string {
+	if g.runes {
+		return string(valueRune(value))
+	}
+	return formatKey(value)
+}
+
+// formatAny returns the character of an entry, for the Handler catalog. It reports
+// false unless the Generator uses WithRuneFormatting.
+func (g *Generator[T]) formatAny(entry any) (string, bool) {
+	v, ok := entry.(Value[T])
+	if !ok || !g.runes {
+		return "", false
+	}
+	return string(valueRune(v.value)), true
+}
+
+// parseRune interprets s as a character literal under WithRuneFormatting: either a
+// single character ("+") or a Go-quoted one ("'+'", "'\n'").
+func (g *Generator[T]) parseRune(s string) (T, bool) {
+	var zero T
+	if !g.runes {
+		return zero, false
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	if size != len(s) || r == utf8.RuneError {
+		if len(s) < 3 || s[0] != '\'' {
+			return zero, false
+		}
+		unquoted, err := strconv.Unquote(s)
+		if err != nil || utf8.RuneCountInString(unquoted) != 1 {
+			return zero, false
+		}
+		r, _ = utf8.DecodeRuneInString(unquoted)
+	}
+	if kindOf[T]().Class == ClassUint {
+		return fromUint[T](uint64(r)) // Runes are never negative here.
+	}
+	return fromInt[T](int64(r))
+}
+
+// valueRune converts an integer enum value to a rune.
+func valueRune[T TypesValue](value T) rune {
+	if kindOf[T]().Class == ClassUint {
+		return rune(uintOf(value))
+	}
+	return rune(intOf(value))
+}
@@ -0,0 +1,108 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_RuneFormatting(t *testing.T) {
+	tokens := NewMapped(map[string]rune{"Plus": '+', "Minus": '-', "Ident": 'i', "Lambda": 'λ', "Newline": '\n'},
+		WithRuneFormatting[rune](), WithSortedEntries[rune]())
+
+	t.Run("Parse", func(t *testing.T) {
+		tests := []struct {
+			input string
+			want  rune
+			name  string
+		}{
+			{"+", '+', "Plus"},
+			{"i", 'i', "Ident"},
+			{"λ", 'λ', "Lambda"},
+			{"'λ'", 'λ', "Lambda"},
+			{`'\n'`, '\n', "Newline"},
+			{"Minus", '-', "Minus"},
+			{"43", '+', "Plus"},
+		}
+		for _, tt := range tests {
+			v, err := tokens.Parse(tt.input)
+			if err != nil || v.Get() != tt.want || v.String() != tt.name {
+				t.Errorf("Parse(%q): got %q/%q, err: %v", tt.input, v.Get(), v.String(), err)
+			}
+		}
+		if _, err := tokens.Parse("*"); err == nil {
+			t.Error("Expected error for unregistered character")
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		err := tokens.Validate('*')
+		if !errors.Is(err, ErrUnknownValue) || !strings.Contains(err.Error(), "'*'") {
+			t.Errorf("Expected error showing '*', got %v", err)
+		}
+		if got := tokens.FormatValue('λ'); got != "'λ'" {
+			t.Errorf("Expected 'λ', got %s", got)
+		}
+		if got := NewGenerator[int32]().FormatValue('+'); got != "43" {
+			t.Errorf("Expected numeric formatting by default, got %s", got)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(tokens)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"\n":"Newline","+":"Plus","-":"Minus","i":"Ident","λ":"Lambda"}`
+		if string(data) != want {
+			t.Errorf("Expected %s, got %s", want, data)
+		}
+		restored := NewRuneGenerator()
+		if err := json.Unmarshal(data, restored); err != nil {
+			t.Fatal(err)
+		}
+		if name, _ := restored.Name('λ'); name != "Lambda" || restored.Len() != 5 {
+			t.Errorf("Expected round trip, got %v", restored.NameMap())
+		}
+	})
+
+	t.Run("Handler", func(t *testing.T) {
+		srv := httptest.NewServer(Handler(map[string]any{"tokens": tokens}))
+		defer srv.Close()
+		resp, err := http.Get(srv.URL + "/tokens")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var got []struct {
+			Value string `json:"value"`
+			Name  string `json:"name"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 5 || got[1].Value != "+" || got[1].Name != "Plus" {
+			t.Errorf("Expected characters in verbose form, got %+v", got)
+		}
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		g := NewRuneGenerator(WithStart('a'))
+		g.Next("First")
+		if v := g.Next("Second"); v.Get() != 'b' {
+			t.Errorf("Expected 'b', got %q", v.Get())
+		}
+	})
+
+	t.Run("Non-integer type", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic for string type")
+			}
+		}()
+		WithRuneFormatting[string]()
+	})
+}
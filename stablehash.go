@@ -0,0 +1,71 @@
+package enum
+
+import (
+	"encoding/binary"
+)
+
+// FNV-1a 64-bit parameters, as in hash/fnv.
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// StableHash returns a hash of value that is the same in every process, on every
+// architecture, and in every Go version, unlike maphash or map iteration order, so it
+// can pick a shard or partition that other processes agree on. The value need not be
+// registered.
+//
+// The hash is 64-bit FNV-1a over the kind tag and value encoding of CanonicalBytes:
+// 's' followed by the uvarint length and bytes of a string, 'i' or 'u' followed by the
+// 8-byte big-endian value of a signed or unsigned integer of any width, and 'f' followed
+// by the 8-byte big-endian IEEE-754 bits of a float widened to float64. Negative zero
+// hashes like zero, since they are the same map key. The algorithm is fixed; changing
+// it would reshard existing data.
+//
+// Example:
+//
+//	g := NewMapped(map[string]int{"Pending": 1, "Active": 2})
+//	shard := g.StableHash(2) % 16 // Same shard in every process.
+func (g *Generator[T]) StableHash(value T) uint64 {
+	kind := canonicalKind(g.kind)
+	if kind == canonicalString {
+		s := stringOf(value)
+		var head [1 + binary.MaxVarintLen64]byte
+		return fnv1aString(fnv1a(fnvOffset64, binary.AppendUvarint(append(head[:0], kind), uint64(len(s)))), s)
+	}
+	var buf [9]byte
+	if kind == canonicalFloat && floatOf(value) == 0 {
+		// Negative zero has its own bit pattern.
+		return fnv1a(fnvOffset64, appendCanonicalValue(append(buf[:0], kind), kind, 0.0))
+	}
+	return fnv1a(fnvOffset64, appendCanonicalValue(append(buf[:0], kind), kind, value))
+}
+
+// StableHashName returns the 64-bit FNV-1a hash of the UTF-8 bytes of name, which is
+// stable across processes like StableHash. The name is hashed as given: it need not be
+// registered, and an alias hashes differently from its canonical name.
+//
+// Example:
+//
+//	shard := g.StableHashName("Active") % 16
+func (g *Generator[T]) StableHashName(name string) uint64 {
+	return fnv1aString(fnvOffset64, name)
+}
+
+// fnv1a continues an FNV-1a hash h over data.
+func fnv1a(h uint64, data []byte) uint64 {
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// fnv1aString is like fnv1a but takes a string, avoiding a copy.
+func fnv1aString(h uint64, s string) uint64 {
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
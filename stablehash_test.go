@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+)
+
+// The expected hashes below are pinned: if one changes, every shard assignment based on
+// StableHash changes with it. Do not update them without a migration plan.
+func TestGenerator_StableHash(t *testing.T) {
+	t.Run("Pinned", func(t *testing.T) {
+		ints := NewGenerator[int]()
+		strs := NewGenerator[string]()
+		floats := NewGenerator[float64]()
+		uints := NewGenerator[uint64]()
+		tests := []struct {
+			name string
+			got  uint64
+			want uint64
+		}{
+			{"int 0", ints.StableHash(0), 0x5b37837bc82ba6a4},
+			{"int 1", ints.StableHash(1), 0x5b37847bc82ba857},
+			{"int -1", ints.StableHash(-1), 0xcd848774fe983d1c},
+			{"string Active", strs.StableHash("Active"), 0xc12b4f23caf6aa9e},
+			{"string empty", strs.StableHash(""), 0x08d90e07b578eac6},
+			{"float 0.075", floats.StableHash(0.075), 0x6a6767a01d480b7d},
+			{"uint 1<<63", uints.StableHash(1 << 63), 0x2b7d546ca2f26e70},
+			{"name Active", ints.StableHashName("Active"), 0xa45a194b58837e4f},
+			{"name empty", ints.StableHashName(""), 0xcbf29ce484222325},
+		}
+		for _, tt := range tests {
+			if tt.got != tt.want {
+				t.Errorf("%s: got %#x, want %#x", tt.name, tt.got, tt.want)
+			}
+		}
+	})
+
+	t.Run("MatchesHashFNV", func(t *testing.T) {
+		h := fnv.New64a()
+		h.Write([]byte{'i', 0, 0, 0, 0, 0, 0, 0x01, 0x2c}) // 300
+		if got, want := NewGenerator[int]().StableHash(300), h.Sum64(); got != want {
+			t.Errorf("StableHash(300) = %#x, hash/fnv gives %#x", got, want)
+		}
+		h.Reset()
+		h.Write([]byte("s\x02ok"))
+		if got, want := NewGenerator[string]().StableHash("ok"), h.Sum64(); got != want {
+			t.Errorf("StableHash(ok) = %#x, hash/fnv gives %#x", got, want)
+		}
+	})
+
+	t.Run("WidthIndependent", func(t *testing.T) {
+		want := NewGenerator[int64]().StableHash(-7)
+		if got := NewGenerator[int8]().StableHash(-7); got != want {
+			t.Errorf("int8 and int64 hash differently: %#x vs %#x", got, want)
+		}
+		if got := NewGenerator[int]().StableHash(-7); got != want {
+			t.Errorf("int and int64 hash differently: %#x vs %#x", got, want)
+		}
+		if got, want := NewGenerator[float32]().StableHash(0.5), NewGenerator[float64]().StableHash(0.5); got != want {
+			t.Errorf("float32 and float64 hash differently: %#x vs %#x", got, want)
+		}
+		if got := NewGenerator[uint64]().StableHash(7); got == NewGenerator[int64]().StableHash(7) {
+			t.Error("Expected signed and unsigned kinds to hash differently")
+		}
+	})
+
+	t.Run("NegativeZero", func(t *testing.T) {
+		g := NewGenerator[float64]()
+		if g.StableHash(math.Copysign(0, -1)) != g.StableHash(0) {
+			t.Error("Expected -0 and 0, the same map key, to hash alike")
+		}
+	})
+
+	t.Run("NamedTypes", func(t *testing.T) {
+		if got, want := NewGenerator[typedLevel]().StableHash(2), NewGenerator[int8]().StableHash(2); got != want {
+			t.Errorf("Expected named types to hash like their underlying type: %#x vs %#x", got, want)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package enum
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+)
+
+// statsMissCap bounds the number of distinct miss inputs tracked by WithStats,
+// so that arbitrary user input cannot grow the counters without limit.
+const statsMissCap = 1024
+
+// WithStats enables per-value usage counters on the Generator: Parse hits, Validate
+// calls, and Parse misses keyed by the raw input (up to 1024 distinct inputs; further
+// ones are only counted in aggregate). Counters are updated with atomic operations on a
+// side structure, so lookups never take the write lock. Use Stats to read a snapshot.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithStats[int]())
+//	g.Next("Pending")
+//	g.Parse("Pending")
+//	fmt.Println(g.Stats().Values[0].Parses) // Output: 1
+func WithStats[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.stats = &stats[T]{}
+	}
+}
+
+// ValueStats holds the usage counters of one enum value.
+type ValueStats[T TypesValue] struct {
+	Value       T      `json:"value"`
+	Name        string `json:"name"`
+	Parses      uint64 `json:"parses"`
+	Validations uint64 `json:"validations"`
+}
+
+// GeneratorStats is a point-in-time snapshot of a Generator's usage counters.
+// It is a plain value and safe to copy, retain, and marshal to JSON.
+type GeneratorStats[T TypesValue] struct {
+	// Values holds one entry per enum value in entry order, including values
+	// that were never used, which makes dead values easy to spot.
+	Values []ValueStats[T] `json:"values"`
+	// Misses counts Parse failures by raw input.
+	Misses map[string]uint64 `json:"misses"`
+	// MissesDropped counts Parse failures whose input was not tracked because
+	// the distinct-input cap was reached.
+	MissesDropped uint64 `json:"misses_dropped"`
+	// ValidateFailures counts Validate calls with an unknown value.
+	ValidateFailures uint64 `json:"validate_failures"`
+}
+
+// MarshalJSON implements json.Marshaler for scraping the snapshot.
+func (s GeneratorStats[T]) MarshalJSON() ([]byte, error) {
+	type plain GeneratorStats[T] // Avoids recursion into this method.
+	if s.Misses == nil {
+		s.Misses = map[string]uint64{}
+	}
+	if s.Values == nil {
+		s.Values = []ValueStats[T]{}
+	}
+	return json.Marshal(plain(s))
+}
+
+// Stats returns a snapshot of the usage counters. It returns the zero GeneratorStats
+// if the Generator was not created with WithStats. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Stats() GeneratorStats[T] {
+	if g.stats == nil {
+		return GeneratorStats[T]{}
+	}
+	g.rlock()
+	out := GeneratorStats[T]{Values: make([]ValueStats[T], len(g.values))}
+	for i, entry := range g.values {
+		out.Values[i] = ValueStats[T]{Value: entry.value, Name: entry.name}
+		if c, ok := g.stats.values.Load(entry.value); ok {
+			out.Values[i].Parses = c.(*valueCounter).parses.Load()
+			out.Values[i].Validations = c.(*valueCounter).validations.Load()
+		}
+	}
+	g.runlock()
+
+	out.Misses = make(map[string]uint64)
+	g.stats.misses.Range(func(k, v any) bool {
+		out.Misses[k.(string)] = v.(*atomic.Uint64).Load()
+		return true
+	})
+	out.MissesDropped = g.stats.missesDropped.Load()
+	out.ValidateFailures = g.stats.validateFailures.Load()
+	return out
+}
+
+// ResetStats sets all usage counters back to zero. It has no effect if the
+// Generator was not created with WithStats.
+func (g *Generator[T]) ResetStats() {
+	if g.stats != nil {
+		g.stats.reset()
+	}
+}
+
+// stats holds the counters behind WithStats. All fields are updated atomically
+// and none is guarded by the Generator's mutex.
+type stats[T TypesValue] struct {
+	values           sync.Map // T -> *valueCounter
+	misses           sync.Map // string -> *atomic.Uint64
+	missKeys         atomic.Int64
+	missesDropped    atomic.Uint64
+	validateFailures atomic.Uint64
+}
+
+// valueCounter holds the counters of a single value.
+type valueCounter struct {
+	parses      atomic.Uint64
+	validations atomic.Uint64
+}
+
+// counter returns the counters of value, creating them on first use.
+func (s *stats[T]) counter(value T) *valueCounter {
+	if c, ok := s.values.Load(value); ok {
+		return c.(*valueCounter)
+	}
+	c, _ := s.values.LoadOrStore(value, &valueCounter{})
+	return c.(*valueCounter)
+}
+
+// miss counts a Parse failure for input, respecting statsMissCap.
+func (s *stats[T]) miss(input string) {
+	if c, ok := s.misses.Load(input); ok {
+		c.(*atomic.Uint64).Add(1)
+		return
+	}
+	if s.missKeys.Load() >= statsMissCap {
+		s.missesDropped.Add(1)
+		return
+	}
+	c, loaded := s.misses.LoadOrStore(input, new(atomic.Uint64))
+	if !loaded {
+		s.missKeys.Add(1)
+	}
+	c.(*atomic.Uint64).Add(1)
+}
+
+// reset zeroes every counter. Increments racing with a reset may be kept or lost.
+func (s *stats[T]) reset() {
+	s.values.Range(func(k, _ any) bool {
+		s.values.Delete(k)
+		return true
+	})
+	s.misses.Range(func(k, _ any) bool {
+		s.misses.Delete(k)
+		return true
+	})
+	s.missKeys.Store(0)
+	s.missesDropped.Store(0)
+	s.validateFailures.Store(0)
+}
@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestGenerator_Stats(t *testing.T) {
+	g := NewGenerator[int](WithStats[int]())
+	g.Next("Pending")
+	g.Next("Active")
+	g.Next("Retired")
+
+	g.Parse("Pending")
+	g.Parse("1")
+	g.Parse("Active")
+	g.Parse("Bogus")
+	g.Parse("Bogus")
+	g.Validate(0)
+	g.Validate(42)
+
+	t.Run("Snapshot", func(t *testing.T) {
+		s := g.Stats()
+		if len(s.Values) != 3 {
+			t.Fatalf("Expected 3 values, got %d", len(s.Values))
+		}
+		if s.Values[0].Parses != 1 || s.Values[0].Validations != 1 {
+			t.Errorf("Unexpected Pending stats %+v", s.Values[0])
+		}
+		if s.Values[1].Parses != 2 {
+			t.Errorf("Expected 2 parses of Active, got %d", s.Values[1].Parses)
+		}
+		if s.Values[2].Parses != 0 || s.Values[2].Name != "Retired" {
+			t.Errorf("Expected unused Retired, got %+v", s.Values[2])
+		}
+		if s.Misses["Bogus"] != 2 || s.ValidateFailures != 1 {
+			t.Errorf("Unexpected misses %v / %d", s.Misses, s.ValidateFailures)
+		}
+	})
+
+	t.Run("Snapshot is a copy", func(t *testing.T) {
+		s := g.Stats()
+		g.Parse("Bogus")
+		if s.Misses["Bogus"] != 2 {
+			t.Errorf("Expected snapshot to be unaffected, got %d", s.Misses["Bogus"])
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		b, err := json.Marshal(g.Stats())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out struct {
+			Values []struct {
+				Name   string `json:"name"`
+				Parses uint64 `json:"parses"`
+			} `json:"values"`
+			Misses map[string]uint64 `json:"misses"`
+		}
+		if err := json.Unmarshal(b, &out); err != nil {
+			t.Fatalf("Invalid JSON %s: %v", b, err)
+		}
+		if len(out.Values) != 3 || out.Values[1].Parses != 2 || out.Misses["Bogus"] != 3 {
+			t.Errorf("Unexpected JSON %s", b)
+		}
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		g.ResetStats()
+		s := g.Stats()
+		if s.Values[1].Parses != 0 || len(s.Misses) != 0 || s.ValidateFailures != 0 {
+			t.Errorf("Expected zeroed stats, got %+v", s)
+		}
+	})
+
+	t.Run("Miss cap", func(t *testing.T) {
+		g.ResetStats()
+		for i := 0; i < statsMissCap+10; i++ {
+			g.Parse(fmt.Sprintf("x%d", i))
+		}
+		s := g.Stats()
+		if len(s.Misses) != statsMissCap || s.MissesDropped != 10 {
+			t.Errorf("Expected %d misses and 10 dropped, got %d and %d", statsMissCap, len(s.Misses), s.MissesDropped)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		g.ResetStats()
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					g.Parse("Active")
+				}
+			}()
+		}
+		wg.Wait()
+		if p := g.Stats().Values[1].Parses; p != 800 {
+			t.Errorf("Expected 800 parses, got %d", p)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		d := NewGenerator[int]()
+		d.Next("A")
+		d.Parse("A")
+		d.ResetStats()
+		if s := d.Stats(); s.Values != nil || s.Misses != nil {
+			t.Errorf("Expected zero stats, got %+v", s)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+// Package testenum provides test helpers for enum Generators.
+package testenum
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+// AssertSame reports a test error listing every difference between want and got, and
+// returns whether they are the same. Generators are the same if they have the same
+// entries in the same order, the same names for each value, and the same aliases,
+// descriptions, deprecation marks, and label. Differences in values and canonical names
+// are listed in the DiffText format of enum.EnumDiff, from want to got.
+//
+// Example:
+//
+//	func TestStatus(t *testing.T) {
+//	    want := enum.NewMapped(map[string]int{"Pending": 0, "Active": 1})
+//	    testenum.AssertSame(t, want, buildStatus())
+//	}
+func AssertSame[T enum.TypesValue](t testing.TB, want, got *enum.Generator[T]) bool {
+	t.Helper()
+	diffs := Differences(want, got)
+	if len(diffs) == 0 {
+		return true
+	}
+	t.Errorf("enum generators differ (want -> got):\n%s", strings.Join(diffs, "\n"))
+	return false
+}
+
+// Differences returns one line per difference between want and got, in the order
+// AssertSame reports them, or nil if they are the same.
+func Differences[T enum.TypesValue](want, got *enum.Generator[T]) []string {
+	var diffs []string
+	var b strings.Builder
+	_ = want.Diff(got).Format(&b, enum.DiffText)
+	if s := strings.TrimSuffix(b.String(), "\n"); s != "" {
+		diffs = append(diffs, strings.Split(s, "\n")...)
+	}
+
+	wantPairs, gotPairs := want.Pairs(), got.Pairs()
+	if len(diffs) == 0 && !reflect.DeepEqual(wantPairs, gotPairs) {
+		diffs = append(diffs, fmt.Sprintf("order: %s -> %s", formatPairs(wantPairs), formatPairs(gotPairs)))
+	}
+
+	seen := map[T]bool{}
+	for _, p := range wantPairs {
+		value := p.Value
+		if seen[value] || !got.Contains(value) {
+			continue
+		}
+		seen[value] = true
+		if w, g := want.NamesOfValue(value), got.NamesOfValue(value); !reflect.DeepEqual(w, g) {
+			diffs = append(diffs, fmt.Sprintf("names of %v: %v -> %v", value, w, g))
+		}
+		if w, g := describe(want, value), describe(got, value); w != g {
+			diffs = append(diffs, fmt.Sprintf("description of %v: %s -> %s", value, w, g))
+		}
+		if w, g := want.IsDeprecated(value), got.IsDeprecated(value); w != g {
+			diffs = append(diffs, fmt.Sprintf("deprecated %v: %t -> %t", value, w, g))
+		}
+	}
+
+	names := map[string]bool{}
+	for _, p := range append(wantPairs, gotPairs...) {
+		if names[p.Name] {
+			continue
+		}
+		names[p.Name] = true
+		if w, g := want.Aliases(p.Name), got.Aliases(p.Name); !reflect.DeepEqual(w, g) {
+			diffs = append(diffs, fmt.Sprintf("aliases of %s: %v -> %v", p.Name, w, g))
+		}
+	}
+
+	if w, g := want.Label(), got.Label(); w != g {
+		diffs = append(diffs, fmt.Sprintf("label: %q -> %q", w, g))
+	}
+	return diffs
+}
+
+// formatPairs formats pairs as "[name=value ...]" in their order.
+func formatPairs[T enum.TypesValue](pairs []enum.Pair[T]) string {
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = fmt.Sprintf("%s=%v", p.Name, p.Value)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// describe returns the quoted description of value, or "none".
+func describe[T enum.TypesValue](g *enum.Generator[T], value T) string {
+	if desc, ok := g.Description(value); ok {
+		return fmt.Sprintf("%q", desc)
+	}
+	return "none"
+}
@@ -0,0 +1,86 @@
+package testenum
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/enum"
+)
+
+// recorder is a testing.TB capturing errors instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func build() *enum.Generator[int] {
+	g := enum.NewGenerator[int](enum.WithLabel[int]("Status"))
+	g.Next("Pending")
+	g.Next("Active")
+	g.Next("Closed")
+	g.AddAlias("Active", "Live")
+	g.SetDescription(0, "Awaiting payment")
+	return g
+}
+
+func TestAssertSame(t *testing.T) {
+	t.Run("Same", func(t *testing.T) {
+		r := &recorder{TB: t}
+		if !AssertSame(r, build(), build()) || len(r.errors) != 0 {
+			t.Errorf("Expected no differences, got %v", r.errors)
+		}
+	})
+
+	t.Run("Entries", func(t *testing.T) {
+		got := build()
+		if err := got.Rename("Closed", "Done"); err != nil {
+			t.Fatal(err)
+		}
+		got.Next("Archived")
+		r := &recorder{TB: t}
+		if AssertSame(r, build(), got) {
+			t.Fatal("Expected a difference")
+		}
+		for _, want := range []string{"~ Renamed 2: Closed -> Done", "+ Added Archived=3"} {
+			if !strings.Contains(r.errors[0], want) {
+				t.Errorf("Expected report to contain %q, got:\n%s", want, r.errors[0])
+			}
+		}
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		got := enum.NewGenerator[int]()
+		got.Next("Pending")
+		got.Next("Active")
+		got.Next("Closed")
+		got.Deprecate(2)
+		want := []string{
+			`description of 0: "Awaiting payment" -> none`,
+			"deprecated 2: false -> true",
+			"aliases of Active: [Live] -> []",
+			`label: "Status" -> ""`,
+		}
+		if diffs := Differences(build(), got); !reflect.DeepEqual(diffs, want) {
+			t.Errorf("Expected %q, got %q", want, diffs)
+		}
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		want := enum.NewGenerator[int]()
+		want.Next("Beta")
+		want.Next("Alpha")
+		got := want.Clone()
+		got.SortByName()
+		if diffs := Differences(want, got); !reflect.DeepEqual(diffs, []string{"order: [Beta=0 Alpha=1] -> [Alpha=1 Beta=0]"}) {
+			t.Errorf("Expected an order difference, got %q", diffs)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package enum
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// textSeparator separates names in the text form of a Generator.
+const textSeparator = ","
+
+// MarshalCatalogText writes the canonical names in entry order separated by commas
+// (e.g., "Pending,Active,Closed"), a form that fits in an environment variable or a
+// config value. Values are not written; see UnmarshalCatalogText. It deliberately does
+// not implement encoding.TextMarshaler, which would make encoders such as encoding/json
+// write a Generator field as this string. It is thread-safe, using a read lock for access.
+//
+// Returns an error if a name contains a comma, which the text form cannot represent.
+func (g *Generator[T]) MarshalCatalogText() ([]byte, error) {
+	g.rlock()
+	defer g.runlock()
+	var buf bytes.Buffer
+	for i, pair := range g.pairsLocked(true) {
+		if strings.Contains(pair.Name, textSeparator) {
+			return nil, fmt.Errorf("enum: name %q contains %q and cannot be written as text", pair.Name, textSeparator)
+		}
+		if i > 0 {
+			buf.WriteString(textSeparator)
+		}
+		buf.WriteString(pair.Name)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalCatalogText replaces the Generator's entries with the comma-separated names
+// in text, numbered as a fresh NewGenerator[T] would number them (the default
+// incrementer from the zero value). Whitespace around names is ignored, and empty text
+// yields an empty Generator. Next continues the sequence afterwards. Aliases,
+// descriptions, display names, and other data attached to a value follow its name to
+// the new value, and are dropped with names that are not in text.
+// It is thread-safe, using a write lock for state modification.
+//
+// Returns an error wrapping ErrInvalidName, leaving the Generator unchanged, if a name is
+// empty (e.g., "A,,B") or repeated.
+//
+// Example:
+//
+//	var g Generator[int]
+//	err := g.UnmarshalCatalogText([]byte(os.Getenv("STATUSES"))) // "Pending, Active, Closed"
+//	fmt.Println(g.ValueMap())                                    // Output: map[0:Pending 1:Active 2:Closed]
+func (g *Generator[T]) UnmarshalCatalogText(text []byte) error {
+	fresh := NewGenerator[T]()
+	if s := strings.TrimSpace(string(text)); s != "" {
+		for i, name := range strings.Split(s, textSeparator) {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return fmt.Errorf("%w: element %d of %q is empty", ErrInvalidName, i, text)
+			}
+			if _, exists := fresh.nameMap[name]; exists {
+				return fmt.Errorf("%w: %q is repeated in %q", ErrInvalidName, name, text)
+			}
+			v, err := fresh.TryNext(name)
+			if err != nil {
+				return err
+			}
+			if err := g.checkParent(name, v.value); err != nil {
+				return err
+			}
+		}
+	}
+
+	g.lock()
+	defer g.unlock()
+	renumber := make(map[T]T, len(fresh.values))
+	for _, entry := range g.values {
+		if value, ok := fresh.nameMap[entry.name]; ok {
+			renumber[entry.value] = value
+		}
+	}
+	g.renumberLocked(renumber)
+	g.valueMap = fresh.valueMap
+	g.extraNames = nil
+	g.nameMap = fresh.nameMap
+	g.values = g.adopt(fresh.values)
+	g.current, g.start = fresh.current, fresh.start
+	g.incrementer, g.incKind = fresh.incrementer, fresh.incKind
+	g.modulus, g.prefix = fresh.modulus, fresh.prefix
+	g.exhausted = false
+	g.reindexLocked()
+	g.bump()
+	return nil
+}
+
+// renumberLocked moves the data attached to values (aliases, descriptions, deprecation,
+// display names, version ranges, and secondary keys) from each old value to its new one
+// in renumber, dropping it for values renumber does not map. The caller must hold the
+// write lock.
+func (g *Generator[T]) renumberLocked(renumber map[T]T) {
+	for alias, value := range g.aliases {
+		if next, ok := renumber[value]; ok {
+			g.aliases[alias] = next
+		} else {
+			delete(g.aliases, alias)
+		}
+	}
+	g.descriptions = renumberMap(g.descriptions, renumber)
+	g.deprecated = renumberMap(g.deprecated, renumber)
+	g.ranges = renumberMap(g.ranges, renumber)
+	for locale, names := range g.display {
+		g.display[locale] = renumberMap(names, renumber)
+	}
+	if g.keys != nil {
+		keys := &keyIndex[T]{byKey: make(map[string]map[string]T), byValue: renumberMap(g.keys.byValue, renumber)}
+		for value, spaces := range keys.byValue {
+			for space, key := range spaces {
+				if keys.byKey[space] == nil {
+					keys.byKey[space] = make(map[string]T)
+				}
+				keys.byKey[space][key] = value
+			}
+		}
+		g.keys = keys
+	}
+}
+
+// renumberMap returns m re-keyed by renumber, without the keys renumber does not map.
+// It returns nil for a nil map.
+func renumberMap[T TypesValue, V any](m map[T]V, renumber map[T]T) map[T]V {
+	if m == nil {
+		return nil
+	}
+	out := make(map[T]V, len(m))
+	for value, v := range m {
+		if next, ok := renumber[value]; ok {
+			out[next] = v
+		}
+	}
+	return out
+}
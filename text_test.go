@@ -0,0 +1,106 @@
+package enum
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGenerator_Text(t *testing.T) {
+	t.Run("Not a TextMarshaler", func(t *testing.T) {
+		// Otherwise encoding/json would write Generator fields, and map keys, as text.
+		var g any = NewGenerator[int]()
+		if _, ok := g.(encoding.TextMarshaler); ok {
+			t.Error("Expected Generator not to implement encoding.TextMarshaler")
+		}
+		if _, ok := g.(encoding.TextUnmarshaler); ok {
+			t.Error("Expected Generator not to implement encoding.TextUnmarshaler")
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("Pending")
+		g.Next("Active")
+		g.Next("Closed")
+		text, err := g.MarshalCatalogText()
+		if err != nil || string(text) != "Pending,Active,Closed" {
+			t.Errorf("Expected \"Pending,Active,Closed\", got %q, err: %v", text, err)
+		}
+	})
+
+	t.Run("Comma in name", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Open, pending": 1})
+		if _, err := g.MarshalCatalogText(); err == nil {
+			t.Error("Expected an error for a name containing a comma")
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Old": 7})
+		if err := g.UnmarshalCatalogText([]byte(" Pending, Active ,Closed")); err != nil {
+			t.Fatal(err)
+		}
+		want := map[int]string{0: "Pending", 1: "Active", 2: "Closed"}
+		if got := g.ValueMap(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+		if v := g.Next("Archived"); v.Get() != 3 {
+			t.Errorf("Expected Next to continue at 3, got %d", v.Get())
+		}
+	})
+
+	t.Run("Unmarshal moves attached data", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Active": 5, "Gone": 6})
+		g.AddAlias("Active", "Live")
+		g.AddAlias("Gone", "Dead")
+		g.SetDescription(5, "In use")
+		g.SetDisplayName(6, "de", "Weg")
+		if err := g.UnmarshalCatalogText([]byte("Pending,Active")); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := g.Parse("Live"); err != nil || v.Get() != 1 || v.String() != "Active" {
+			t.Errorf("Expected the alias to follow Active to 1, got %v, err: %v", v, err)
+		}
+		if _, err := g.Parse("Dead"); err == nil {
+			t.Error("Expected the alias of a dropped name to be removed")
+		}
+		if got, _ := g.Description(1); got != "In use" {
+			t.Errorf("Expected the description to follow Active, got %q", got)
+		}
+		if d, _ := g.DisplayName(0, "de"); d != "Pending" {
+			t.Errorf("Expected no display name for Pending, got %q", d)
+		}
+	})
+
+	t.Run("Round trip strings", func(t *testing.T) {
+		var g Generator[string]
+		if err := g.UnmarshalCatalogText([]byte("Red,Green")); err != nil {
+			t.Fatal(err)
+		}
+		text, _ := g.MarshalCatalogText()
+		if string(text) != "Red,Green" {
+			t.Errorf("Expected round trip, got %q", text)
+		}
+		red, _ := g.Get("Red")
+		if green, ok := g.Get("Green"); !ok || green == red {
+			t.Errorf("Expected distinct values, got %q and %q", red, green)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		g := NewFromSlice([]string{"Keep"}, 0)
+		for _, in := range []string{"A,,B", "A,B,A", "A,"} {
+			if err := g.UnmarshalCatalogText([]byte(in)); !errors.Is(err, ErrInvalidName) {
+				t.Errorf("%q: expected ErrInvalidName, got %v", in, err)
+			}
+		}
+		if names := g.Names(); !reflect.DeepEqual(names, []string{"Keep"}) {
+			t.Errorf("Expected the Generator unchanged, got %v", names)
+		}
+		if err := g.UnmarshalCatalogText(nil); err != nil || g.Len() != 0 {
+			t.Errorf("Expected empty text to clear the Generator, got %d entries, err: %v", g.Len(), err)
+		}
+	})
+}
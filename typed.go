@@ -0,0 +1,205 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Typed is a registry for a named integer type the caller defines (e.g., type
+// OrderStatus int), so that each enum is its own Go type: an OrderStatus cannot be
+// passed where a PaymentStatus is expected without an explicit conversion, as with
+// iota constants. Add numbers entries like BasicRegistry.Add but returns the raw typed
+// value, so constants are declared the usual way, and Typed implements, as functions
+// of a value, the methods such a type needs (String, text, JSON, and SQL encoding);
+// the type's methods delegate to it.
+//
+// GenerateGo with IncludeMethods writes the type, its constants, a Typed registry, and
+// the delegating methods, so none of the wiring is written by hand.
+//
+// Example:
+//
+//	type Status int
+//
+//	var statuses = NewTyped[Status]()
+//
+//	var (
+//		Pending = statuses.Add("Pending") // Status(0)
+//		Active  = statuses.Add("Active")  // Status(1)
+//	)
+//
+//	func (s Status) String() string { return statuses.String(s) }
+type Typed[E TypesMake] struct {
+	name string
+	g    *Generator[E]
+}
+
+// NewTyped returns an empty Typed registry, backed by a Generator created with opts
+// (e.g., WithStart or WithLabel). The label, or else the name of E without its package,
+// identifies the type in error messages and String's fallback.
+func NewTyped[E TypesMake](opts ...Option[E]) *Typed[E] {
+	return TypedOf(NewGenerator[E](opts...))
+}
+
+// TypedOf returns a Typed registry backed by g, for entries registered by other means
+// (e.g., MustRegisterConstants, as in code written by GenerateGo). Changes to g are
+// visible through the registry.
+//
+// Panics if g is nil.
+func TypedOf[E TypesMake](g *Generator[E]) *Typed[E] {
+	var zero E
+	name := fmt.Sprintf("%T", zero)
+	if g == nil {
+		panic(fmt.Sprintf("enum.TypedOf: nil Generator for %s", name))
+	}
+	if g.label != "" {
+		name = g.label
+	} else if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return &Typed[E]{name: name, g: g}
+}
+
+// Add registers name with the next value in the sequence and returns the value.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Panics, as BasicRegistry.Add does, if the name already exists or is rejected; under
+// WithErrorMode, it returns the zero value and records the error instead. Use TryAdd to
+// receive these conditions as an error.
+func (t *Typed[E]) Add(name string) E {
+	return t.g.Next(name).Get()
+}
+
+// TryAdd is like Add but returns an error instead of panicking.
+func (t *Typed[E]) TryAdd(name string) (E, error) {
+	v, err := t.g.TryNext(name)
+	return v.Get(), err
+}
+
+// AddValue registers name with the given value rather than the next in the sequence,
+// like BasicRegistry.FromValue, and returns the value.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Panics if the name or value already exists or the name is rejected. Use TryAddValue
+// to receive these conditions as an error.
+//
+// Example:
+//
+//	var (
+//		Pending = statuses.Add("Pending")          // Status(0)
+//		Legacy  = statuses.AddValue("Legacy", 100) // Status(100)
+//	)
+func (t *Typed[E]) AddValue(name string, value E) E {
+	v, err := t.TryAddValue(name, value)
+	if err != nil {
+		t.g.raise(err)
+	}
+	return v
+}
+
+// TryAddValue is like AddValue but returns an error instead of panicking.
+func (t *Typed[E]) TryAddValue(name string, value E) (E, error) {
+	v, err := t.g.addValue(name, value)
+	return v.Get(), err
+}
+
+// Registry returns the Generator backing t.
+func (t *Typed[E]) Registry() *Generator[E] {
+	return t.g
+}
+
+// String returns the name of v, or the type name and value (e.g., "Status(7)") if v is
+// not registered.
+func (t *Typed[E]) String(v E) string {
+	if name, ok := t.g.Name(v); ok {
+		return name
+	}
+	return t.name + "(" + t.g.formatText(v) + ")"
+}
+
+// Parse returns the value named s, resolved like Generator.Parse (so aliases and value
+// literals work). Returns an error wrapping ErrUnknownValue if s matches no entry.
+func (t *Typed[E]) Parse(s string) (E, error) {
+	v, err := t.g.Parse(s)
+	if err != nil {
+		return 0, fmt.Errorf("enum: invalid %s %q: %w", t.name, s, ErrUnknownValue)
+	}
+	return v.Get(), nil
+}
+
+// MarshalText returns the name of v, implementing encoding.TextMarshaler for the
+// named type. Returns an error wrapping ErrUnknownValue if v is not registered.
+func (t *Typed[E]) MarshalText(v E) ([]byte, error) {
+	name, ok := t.g.Name(v)
+	if !ok {
+		return nil, fmt.Errorf("enum: invalid %s %s: %w", t.name, t.g.formatValue(v), ErrUnknownValue)
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText parses text with Parse into *v, implementing encoding.TextUnmarshaler.
+func (t *Typed[E]) UnmarshalText(text []byte, v *E) error {
+	parsed, err := t.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// EncodeJSON writes the name of v as a JSON string, implementing MarshalJSON for the
+// named type.
+func (t *Typed[E]) EncodeJSON(v E) ([]byte, error) {
+	name, err := t.MarshalText(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(name))
+}
+
+// DecodeJSON reads a JSON string (a name, as written by EncodeJSON) or number into
+// *v, rejecting unregistered values. It implements UnmarshalJSON for the named type.
+func (t *Typed[E]) DecodeJSON(data []byte, v *E) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var n json.Number
+		if json.Unmarshal(data, &n) != nil {
+			return fmt.Errorf("enum: cannot unmarshal %s into %s", data, t.name)
+		}
+		s = n.String()
+	}
+	return t.UnmarshalText([]byte(s), v)
+}
+
+// Scan reads a database value into *v, implementing sql.Scanner: an integer is taken
+// as the value and a string or []byte is parsed with Parse. NULL is an error, as the
+// named type has no unset state; scan into a pointer to it for nullable columns.
+func (t *Typed[E]) Scan(src any, v *E) error {
+	switch s := src.(type) {
+	case int64:
+		return t.UnmarshalText([]byte(strconv.FormatInt(s, 10)), v)
+	case string:
+		return t.UnmarshalText([]byte(s), v)
+	case []byte:
+		return t.UnmarshalText(s, v)
+	case nil:
+		return fmt.Errorf("enum: cannot scan NULL into %s", t.name)
+	default:
+		return fmt.Errorf("enum: unsupported type %T for scan into %s", src, t.name)
+	}
+}
+
+// Value returns v as an int64, implementing driver.Valuer. Returns an error wrapping
+// ErrUnknownValue if v is not registered, or strconv.ErrRange if v is an unsigned value
+// above math.MaxInt64, which an int64 cannot hold without changing sign.
+func (t *Typed[E]) Value(v E) (driver.Value, error) {
+	if !t.g.Contains(v) {
+		return nil, fmt.Errorf("enum: invalid %s %s: %w", t.name, t.g.formatValue(v), ErrUnknownValue)
+	}
+	if v > 0 && int64(v) < 0 {
+		return nil, fmt.Errorf("enum: cannot store %s %s as an int64: %w", t.name, t.g.formatValue(v), strconv.ErrRange)
+	}
+	return int64(v), nil
+}
@@ -0,0 +1,160 @@
+package enum
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// typedLevel is a named type wired to a Typed registry as GenerateGo would wire it.
+type typedLevel int8
+
+var typedLevels = TypedOf(MustRegisterConstants(map[string]typedLevel{"Low": 1, "High": 2}))
+
+func TestTyped(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		if got := typedLevels.String(2); got != "High" {
+			t.Errorf("String(2) = %q, want High", got)
+		}
+		if got := typedLevels.String(9); got != "typedLevel(9)" {
+			t.Errorf("String(9) = %q, want typedLevel(9)", got)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		if v, err := typedLevels.Parse("Low"); err != nil || v != 1 {
+			t.Errorf("Parse(Low) = %v, %v; want 1", v, err)
+		}
+		if v, err := typedLevels.Parse("2"); err != nil || v != 2 {
+			t.Errorf("Parse(2) = %v, %v; want 2", v, err)
+		}
+		if _, err := typedLevels.Parse("Medium"); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Parse(Medium) error = %v, want ErrUnknownValue", err)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := typedLevels.EncodeJSON(2)
+		if err != nil || string(data) != `"High"` {
+			t.Fatalf("EncodeJSON(2) = %s, %v", data, err)
+		}
+		var v typedLevel
+		if err := typedLevels.DecodeJSON(data, &v); err != nil || v != 2 {
+			t.Errorf("DecodeJSON(%s) = %v, %v", data, v, err)
+		}
+		if err := typedLevels.DecodeJSON([]byte("1"), &v); err != nil || v != 1 {
+			t.Errorf("DecodeJSON(1) = %v, %v", v, err)
+		}
+		if _, err := typedLevels.EncodeJSON(9); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("EncodeJSON(9) error = %v, want ErrUnknownValue", err)
+		}
+		for _, in := range []string{`"Medium"`, `7`, `true`} {
+			if err := typedLevels.DecodeJSON([]byte(in), &v); err == nil {
+				t.Errorf("DecodeJSON(%s) succeeded", in)
+			}
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		var v typedLevel
+		for _, src := range []any{int64(2), "High", []byte("High")} {
+			v = 0
+			if err := typedLevels.Scan(src, &v); err != nil || v != 2 {
+				t.Errorf("Scan(%#v) = %v, %v; want 2", src, v, err)
+			}
+		}
+		for _, src := range []any{nil, int64(7), int64(1 << 40), 2.0} {
+			if err := typedLevels.Scan(src, &v); err == nil {
+				t.Errorf("Scan(%#v) succeeded", src)
+			}
+		}
+		if dv, err := typedLevels.Value(1); err != nil || dv != int64(1) {
+			t.Errorf("Value(1) = %v, %v; want int64 1", dv, err)
+		}
+		if _, err := typedLevels.Value(9); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Value(9) error = %v, want ErrUnknownValue", err)
+		}
+	})
+
+	t.Run("SQLUnsignedRange", func(t *testing.T) {
+		type wide uint64
+		r := NewTyped[wide]()
+		fits := r.AddValue("Fits", math.MaxInt64)
+		above := r.AddValue("Above", math.MaxInt64+1)
+		top := r.AddValue("Top", math.MaxUint64)
+		if dv, err := r.Value(fits); err != nil || dv != int64(math.MaxInt64) {
+			t.Errorf("Value(MaxInt64) = %v, %v; want int64 %d", dv, err, int64(math.MaxInt64))
+		}
+		var back wide
+		if err := r.Scan(int64(math.MaxInt64), &back); err != nil || back != fits {
+			t.Errorf("Scan(MaxInt64) = %v, %v; want %d", back, err, fits)
+		}
+		for _, v := range []wide{above, top} {
+			if dv, err := r.Value(v); !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("Value(%d) = %v, %v; want strconv.ErrRange", v, dv, err)
+			}
+		}
+	})
+
+	t.Run("NilGenerator", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected a panic for a nil Generator")
+			}
+		}()
+		TypedOf[typedLevel](nil)
+	})
+}
+
+func TestNewTyped(t *testing.T) {
+	type status uint8
+	statuses := NewTyped[status](WithLabel[status]("Status"))
+	var (
+		pending = statuses.Add("Pending")
+		active  = statuses.Add("Active")
+		legacy  = statuses.AddValue("Legacy", 100)
+	)
+
+	t.Run("Add", func(t *testing.T) {
+		if pending != 0 || active != 1 || legacy != 100 {
+			t.Errorf("Expected 0, 1, 100; got %d, %d, %d", pending, active, legacy)
+		}
+		if got := statuses.String(active); got != "Active" {
+			t.Errorf("String(active) = %q, want Active", got)
+		}
+		if got := statuses.String(7); got != "Status(7)" {
+			t.Errorf("Expected the label in the fallback, got %q", got)
+		}
+		if v, err := statuses.Parse("Legacy"); err != nil || v != legacy {
+			t.Errorf("Parse(Legacy) = %v, %v", v, err)
+		}
+		if names := statuses.Registry().Names(); len(names) != 3 {
+			t.Errorf("Expected 3 entries in the registry, got %v", names)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := statuses.TryAdd("Active"); err == nil {
+			t.Error("Expected an error for a duplicate name")
+		}
+		if _, err := statuses.TryAddValue("Other", 100); err == nil {
+			t.Error("Expected an error for a duplicate value")
+		}
+		defer func() {
+			if err, ok := recover().(error); !ok || !strings.Contains(err.Error(), `(in enum "Status")`) {
+				t.Errorf("Expected a panic naming the registry, got %v", err)
+			}
+		}()
+		statuses.Add("Pending")
+	})
+
+	t.Run("ErrorMode", func(t *testing.T) {
+		r := NewTyped[status](WithErrorMode[status]())
+		r.Add("A")
+		if v := r.AddValue("B", 0); v != 0 || r.Registry().Err() == nil {
+			t.Errorf("Expected the zero value and a recorded error, got %v, %v", v, r.Registry().Err())
+		}
+	})
+}
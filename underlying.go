@@ -0,0 +1,114 @@
+package enum
+
+import "unsafe"
+
+// The helpers below read and write a value of T as its underlying type, chosen by the
+// kind of T (see kindOf and comparableKind). A named type such as type Status int has
+// the memory layout of its underlying type, so they serve named types without
+// reflection, which keeps the core of the package free of reflect under the noreflect
+// build tag. Each must only be called for a T of the kind it names.
+
+// intOf returns v, of a signed integer kind, widened to int64.
+func intOf[T comparable](v T) int64 {
+	p := unsafe.Pointer(&v)
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return int64(*(*int8)(p))
+	case 2:
+		return int64(*(*int16)(p))
+	case 4:
+		return int64(*(*int32)(p))
+	default:
+		return *(*int64)(p)
+	}
+}
+
+// uintOf returns v, of an unsigned integer kind, widened to uint64.
+func uintOf[T comparable](v T) uint64 {
+	p := unsafe.Pointer(&v)
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return uint64(*(*uint8)(p))
+	case 2:
+		return uint64(*(*uint16)(p))
+	case 4:
+		return uint64(*(*uint32)(p))
+	default:
+		return *(*uint64)(p)
+	}
+}
+
+// floatOf returns v, of a float kind, widened to float64.
+func floatOf[T comparable](v T) float64 {
+	p := unsafe.Pointer(&v)
+	if unsafe.Sizeof(v) == 4 {
+		return float64(*(*float32)(p))
+	}
+	return *(*float64)(p)
+}
+
+// stringOf returns v, of a string kind, as a string.
+func stringOf[T comparable](v T) string {
+	return *(*string)(unsafe.Pointer(&v))
+}
+
+// fromInt returns n as a T of a signed integer kind, truncated to its width, and
+// whether n fits in T.
+func fromInt[T comparable](n int64) (T, bool) {
+	var out T
+	p := unsafe.Pointer(&out)
+	switch unsafe.Sizeof(out) {
+	case 1:
+		*(*int8)(p) = int8(n)
+	case 2:
+		*(*int16)(p) = int16(n)
+	case 4:
+		*(*int32)(p) = int32(n)
+	default:
+		*(*int64)(p) = n
+	}
+	return out, intOf(out) == n
+}
+
+// fromUint returns n as a T of an unsigned integer kind, truncated to its width, and
+// whether n fits in T.
+func fromUint[T comparable](n uint64) (T, bool) {
+	var out T
+	p := unsafe.Pointer(&out)
+	switch unsafe.Sizeof(out) {
+	case 1:
+		*(*uint8)(p) = uint8(n)
+	case 2:
+		*(*uint16)(p) = uint16(n)
+	case 4:
+		*(*uint32)(p) = uint32(n)
+	default:
+		*(*uint64)(p) = n
+	}
+	return out, uintOf(out) == n
+}
+
+// fromFloat returns f as a T of a float kind, rounded to float32 if T is 32 bits wide.
+func fromFloat[T comparable](f float64) T {
+	var out T
+	p := unsafe.Pointer(&out)
+	if unsafe.Sizeof(out) == 4 {
+		*(*float32)(p) = float32(f)
+	} else {
+		*(*float64)(p) = f
+	}
+	return out
+}
+
+// fromString returns s as a T of a string kind.
+func fromString[T comparable](s string) T {
+	var out T
+	*(*string)(unsafe.Pointer(&out)) = s
+	return out
+}
+
+// bitsOf returns the width of T in bits, for numeric kinds.
+func bitsOf[T comparable]() int {
+	var zero T
+	return int(unsafe.Sizeof(zero)) * 8
+}
@@ -0,0 +1,130 @@
+//go:build noreflect
+
+package enum
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"unsafe"
+)
+
+// namedKinds caches the kinds found by namedKind, keyed by a nil *T.
+var namedKinds sync.Map
+
+// underlyingKind returns the ValueKind of a named type T (e.g., type Status int), which
+// the type switch of kindOf does not match. Without reflection, named types of the
+// platform-sized int and uint report their width (e.g., int64) rather than Bits 0.
+func underlyingKind[T TypesValue]() ValueKind {
+	if k, ok := namedKind[T](); ok {
+		return k
+	}
+	// T formats itself, but as a TypesValue it is a string if it is as wide as one;
+	// on 32-bit platforms, where 64-bit numbers are as wide, it is taken as a number.
+	var zero T
+	if size := unsafe.Sizeof(zero); size == unsafe.Sizeof("") && size != 8 {
+		return ValueKind{Class: ClassString}
+	}
+	return probeKind[T]()
+}
+
+// namedKind returns the ValueKind of a type the type switch of kindOf does not match,
+// reporting false for types outside TypesValue (e.g., structs). Without reflection,
+// the kind is read from the Go syntax of the zero value, "" for strings, 0 for signed
+// integers and floats, and 0x0 for unsigned integers, so types with their own
+// GoString or Format methods cannot be recognized.
+func namedKind[T comparable]() (ValueKind, bool) {
+	key := any((*T)(nil))
+	if k, ok := namedKinds.Load(key); ok {
+		return k.(ValueKind), true
+	}
+	var zero T
+	var k ValueKind
+	switch any(zero).(type) {
+	case fmt.GoStringer, fmt.Formatter:
+		return k, false
+	}
+	switch fmt.Sprintf("%#v", zero) {
+	case `""`:
+		k = ValueKind{Class: ClassString}
+	case "0":
+		// Only floats have a value with every bit set that is unequal to itself (NaN).
+		k = ValueKind{Class: ClassInt, Bits: int(unsafe.Sizeof(zero)) * 8}
+		if ones, _ := fromUint[T](math.MaxUint64); ones != ones {
+			k.Class = ClassFloat
+		}
+	case "0x0":
+		k = ValueKind{Class: ClassUint, Bits: int(unsafe.Sizeof(zero)) * 8}
+	default:
+		return k, false
+	}
+	namedKinds.Store(key, k)
+	return k, true
+}
+
+// probeKind returns the ValueKind of a numeric T from the order of a value with every
+// bit set: NaN for floats, negative for signed integers.
+func probeKind[T TypesValue]() ValueKind {
+	var zero T
+	bits := int(unsafe.Sizeof(zero)) * 8
+	ones, _ := fromUint[T](math.MaxUint64)
+	switch {
+	case ones != ones:
+		return ValueKind{Class: ClassFloat, Bits: bits}
+	case ones < zero:
+		return ValueKind{Class: ClassInt, Bits: bits}
+	default:
+		return ValueKind{Class: ClassUint, Bits: bits}
+	}
+}
+
+// convertAny is the fallback of underlyingOf for inputs other than T and Entry[T].
+// Without reflection, it understands pointers to T and the predeclared types with the
+// kind of T (e.g., int64 for an enum of type Status int64).
+func convertAny[T TypesValue](value any) (T, bool) {
+	var zero T
+	switch v := value.(type) {
+	case *T:
+		if v == nil {
+			return zero, false
+		}
+		return *v, true
+	case string:
+		return convertPredeclared[T](v)
+	case int:
+		return convertPredeclared[T](v)
+	case int8:
+		return convertPredeclared[T](v)
+	case int16:
+		return convertPredeclared[T](v)
+	case int32:
+		return convertPredeclared[T](v)
+	case int64:
+		return convertPredeclared[T](v)
+	case uint:
+		return convertPredeclared[T](v)
+	case uint8:
+		return convertPredeclared[T](v)
+	case uint16:
+		return convertPredeclared[T](v)
+	case uint32:
+		return convertPredeclared[T](v)
+	case uint64:
+		return convertPredeclared[T](v)
+	case float32:
+		return convertPredeclared[T](v)
+	case float64:
+		return convertPredeclared[T](v)
+	}
+	return zero, false
+}
+
+// convertPredeclared converts v to T if both have the same class and width, so that
+// they share a memory layout.
+func convertPredeclared[T, V TypesValue](v V) (T, bool) {
+	var zero T
+	if kindOf[V]().Class != kindOf[T]().Class || unsafe.Sizeof(v) != unsafe.Sizeof(zero) {
+		return zero, false
+	}
+	return *(*T)(unsafe.Pointer(&v)), true
+}
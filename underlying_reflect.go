@@ -0,0 +1,52 @@
+//go:build !noreflect
+
+package enum
+
+import "reflect"
+
+// underlyingKind returns the ValueKind of a named type T (e.g., type Status int), which
+// the type switch of kindOf does not match, from its reflected kind.
+func underlyingKind[T TypesValue]() ValueKind {
+	k, _ := namedKind[T]()
+	return k
+}
+
+// namedKind returns the ValueKind of T from its reflected kind, reporting false for
+// kinds outside TypesValue.
+func namedKind[T comparable]() (ValueKind, bool) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	switch k := t.Kind(); {
+	case k == reflect.String:
+		return ValueKind{Class: ClassString}, true
+	case k == reflect.Int:
+		return ValueKind{Class: ClassInt}, true
+	case k == reflect.Uint:
+		return ValueKind{Class: ClassUint}, true
+	case k >= reflect.Int8 && k <= reflect.Int64:
+		return ValueKind{Class: ClassInt, Bits: t.Bits()}, true
+	case k >= reflect.Uint8 && k <= reflect.Uint64:
+		return ValueKind{Class: ClassUint, Bits: t.Bits()}, true
+	case k == reflect.Float32 || k == reflect.Float64:
+		return ValueKind{Class: ClassFloat, Bits: t.Bits()}, true
+	}
+	return ValueKind{}, false
+}
+
+// convertAny is the fallback of underlyingOf for inputs other than T and Entry[T]: it
+// follows pointers and converts defined types whose kind matches T.
+func convertAny[T TypesValue](value any) (T, bool) {
+	var zero T
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return zero, false
+		}
+		return underlyingOf[T](rv.Elem().Interface())
+	}
+
+	target := reflect.TypeOf(zero)
+	if rv.Kind() == target.Kind() && rv.Type().ConvertibleTo(target) {
+		return rv.Convert(target).Interface().(T), true
+	}
+	return zero, false
+}
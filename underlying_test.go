@@ -0,0 +1,60 @@
+package enum
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUnderlying(t *testing.T) {
+	type (
+		Code  string
+		Level int8
+		Mask  uint16
+		Ratio float32
+	)
+
+	t.Run("Round trip", func(t *testing.T) {
+		if v := fromString[Code]("abc"); v != "abc" || stringOf(v) != "abc" {
+			t.Errorf("Expected abc, got %q", v)
+		}
+		if v, ok := fromInt[Level](-7); !ok || v != -7 || intOf(v) != -7 {
+			t.Errorf("Expected -7, got %d (%v)", v, ok)
+		}
+		if v, ok := fromUint[Mask](math.MaxUint16); !ok || v != math.MaxUint16 || uintOf(v) != math.MaxUint16 {
+			t.Errorf("Expected %d, got %d (%v)", math.MaxUint16, v, ok)
+		}
+		if v := fromFloat[Ratio](0.5); v != 0.5 || floatOf(v) != 0.5 {
+			t.Errorf("Expected 0.5, got %v", v)
+		}
+	})
+
+	t.Run("Truncation", func(t *testing.T) {
+		if v, ok := fromInt[Level](200); ok || v != -56 {
+			t.Errorf("Expected 200 to truncate to -56 and not fit, got %d (%v)", v, ok)
+		}
+		if v, ok := fromUint[Mask](1 << 16); ok || v != 0 {
+			t.Errorf("Expected 1<<16 to truncate to 0 and not fit, got %d (%v)", v, ok)
+		}
+		if _, ok := fromInt[int64](math.MinInt64); !ok {
+			t.Error("Expected MinInt64 to fit in int64")
+		}
+	})
+
+	t.Run("Bits", func(t *testing.T) {
+		if bitsOf[Level]() != 8 || bitsOf[Mask]() != 16 || bitsOf[float64]() != 64 {
+			t.Errorf("Unexpected widths %d, %d, %d", bitsOf[Level](), bitsOf[Mask](), bitsOf[float64]())
+		}
+	})
+
+	t.Run("Convert", func(t *testing.T) {
+		g := NewMapped(map[string]Level{"Low": 1, "High": 10})
+		valid := g.ValidatorFunc()
+		high := Level(10)
+		if !valid(int8(10)) || !valid(&high) || valid(int8(3)) {
+			t.Error("Expected int8 values and pointers to convert to Level")
+		}
+		if valid("10") || valid(uint8(10)) || valid((*Level)(nil)) {
+			t.Error("Expected values of another kind to be rejected")
+		}
+	})
+}
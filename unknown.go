@@ -0,0 +1,95 @@
+package enum
+
+// WithUnknown registers the Generator's start value under name as the "unknown" (or
+// "unspecified") sentinel, following the Protobuf convention of reserving the first
+// value, usually 0. The sentinel is a regular entry that IsUnknown recognizes and
+// ParseOrUnknown falls back to. It is registered after all other options are applied,
+// so option order does not matter. For NewMapped, an existing entry with that name
+// becomes the sentinel; otherwise the zero value is registered under it.
+//
+// Example:
+//
+//	g := NewGenerator[int](WithUnknown[int]("Unspecified"))
+//	g.Next("Active")                              // value: 1
+//	fmt.Println(g.IsUnknown(0))                   // Output: true
+//	fmt.Println(g.ParseOrUnknown("Bogus").String()) // Output: Unspecified
+func WithUnknown[T TypesValue](name string) Option[T] {
+	return func(g *Generator[T]) {
+		g.unknownName = name
+	}
+}
+
+// OmitUnknown excludes the sentinel registered with WithUnknown from Names and
+// ValidValues. It remains in Values, lookups, Parse, and serialized forms.
+func OmitUnknown[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.omitUnknown = true
+	}
+}
+
+// IsUnknown reports whether value is the sentinel registered with WithUnknown.
+// It returns false if the Generator has no sentinel. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) IsUnknown(value T) bool {
+	g.rlock()
+	defer g.runlock()
+	return g.isUnknown(value)
+}
+
+// Unknown returns the sentinel entry registered with WithUnknown, and false if there is none.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) Unknown() (Value[T], bool) {
+	g.rlock()
+	defer g.runlock()
+	if !g.hasUnknown {
+		return Value[T]{}, false
+	}
+	return g.entry(g.unknown, g.valueMap[g.unknown]), true
+}
+
+// ParseOrUnknown is like Parse but returns the sentinel registered with WithUnknown
+// when s does not match. Without a sentinel it returns the zero Value on failure.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ParseOrUnknown(s string) Value[T] {
+	if v, err := g.Parse(s); err == nil {
+		return v
+	}
+	v, _ := g.Unknown()
+	return v
+}
+
+// isUnknown implements IsUnknown. The caller must hold the read lock.
+func (g *Generator[T]) isUnknown(value T) bool {
+	return g.hasUnknown && value == g.unknown
+}
+
+// registerUnknown registers the sentinel requested with WithUnknown, if any.
+// It is called by constructors once options have been applied.
+func (g *Generator[T]) registerUnknown() {
+	if g.unknownName == "" {
+		return
+	}
+	if value, ok := g.nameMap[g.unknownName]; ok {
+		g.unknown, g.hasUnknown = value, true
+		return
+	}
+	if g.incrementer != nil {
+		entry, err := g.TryNext(g.unknownName)
+		if err != nil {
+			g.raise(err)
+			return
+		}
+		g.unknown, g.hasUnknown = entry.value, true
+		return
+	}
+	var zero T
+	if existing, ok := g.valueMap[zero]; ok && g.bijective {
+		g.raise(sharedValueError(existing, g.unknownName, zero))
+		return
+	}
+	entry := g.entry(zero, g.unknownName)
+	g.values = append(g.values, entry)
+	sortByValue(g.values)
+	g.nameMap[entry.name] = zero
+	g.addName(zero, entry.name)
+	g.unknown, g.hasUnknown = zero, true
+}
@@ -0,0 +1,80 @@
+package enum
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestGenerator_WithUnknown(t *testing.T) {
+	t.Run("Sequential", func(t *testing.T) {
+		g := NewGenerator[int](WithUnknown[int]("Unspecified"), WithStart(0))
+		g.Next("Active")
+		if v, ok := g.Get("Active"); !ok || v != 1 {
+			t.Errorf("Expected Active=1 after the sentinel, got %d", v)
+		}
+		if !g.IsUnknown(0) || g.IsUnknown(1) {
+			t.Error("IsUnknown returned unexpected results")
+		}
+		if u, ok := g.Unknown(); !ok || u.String() != "Unspecified" {
+			t.Errorf("Expected Unspecified sentinel, got %v", u)
+		}
+		if !reflect.DeepEqual(g.Names(), []string{"Unspecified", "Active"}) {
+			t.Errorf("Expected sentinel in Names by default, got %v", g.Names())
+		}
+	})
+
+	t.Run("OmitUnknown", func(t *testing.T) {
+		g := NewGenerator[int](WithUnknown[int]("Unspecified"), OmitUnknown[int]())
+		g.Next("Active")
+		g.Next("Closed")
+		if !reflect.DeepEqual(g.Names(), []string{"Active", "Closed"}) {
+			t.Errorf("Expected sentinel to be omitted, got %v", g.Names())
+		}
+		vals := g.ValidValues()
+		sort.Ints(vals)
+		if !reflect.DeepEqual(vals, []int{1, 2}) {
+			t.Errorf("Expected sentinel to be omitted, got %v", vals)
+		}
+		if len(g.Values()) != 3 || !g.Contains(0) {
+			t.Error("Expected sentinel to remain a regular entry")
+		}
+	})
+
+	t.Run("ParseOrUnknown", func(t *testing.T) {
+		g := NewGenerator[int](WithUnknown[int]("Unspecified"))
+		g.Next("Active")
+		if v := g.ParseOrUnknown("Active"); v.Get() != 1 {
+			t.Errorf("Expected Active, got %v", v)
+		}
+		if v := g.ParseOrUnknown("Bogus"); v.Get() != 0 || v.String() != "Unspecified" {
+			t.Errorf("Expected sentinel, got %v", v)
+		}
+		plain := NewGenerator[int]()
+		if v := plain.ParseOrUnknown("Bogus"); v != (Value[int]{}) {
+			t.Errorf("Expected zero Value without sentinel, got %v", v)
+		}
+	})
+
+	t.Run("Mapped", func(t *testing.T) {
+		g := NewMapped(map[string]int{"Active": 1, "Closed": 2}, WithUnknown[int]("Unspecified"))
+		if !reflect.DeepEqual(g.Names(), []string{"Unspecified", "Active", "Closed"}) {
+			t.Errorf("Unexpected names %v", g.Names())
+		}
+		existing := NewMapped(map[string]int{"None": 5, "Some": 6}, WithUnknown[int]("None"))
+		if !existing.IsUnknown(5) || existing.Len() != 2 {
+			t.Error("Expected existing entry to become the sentinel")
+		}
+	})
+
+	t.Run("Remove clears sentinel", func(t *testing.T) {
+		g := NewGenerator[int](WithUnknown[int]("Unspecified"))
+		g.Remove("Unspecified")
+		if g.IsUnknown(0) {
+			t.Error("Expected removed sentinel to be forgotten")
+		}
+		if _, ok := g.Unknown(); ok {
+			t.Error("Expected no sentinel")
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package enum
+
+// ValidatorFunc returns a function reporting whether its argument is a member of
+// the enum set. It is designed to be plugged into struct-tag validators such as
+// go-playground/validator without this package depending on them.
+//
+// The returned function accepts the raw underlying type T (or a defined type with
+// the same kind), Value[T], any Entry[T] (including types embedding Value[T]), and
+// pointers to these. Under the noreflect build tag, defined types other than T are
+// only accepted through their predeclared form (e.g., int64(level)). Any other input
+// is reported as invalid. It is safe for
+// concurrent use and always reflects the current state of the Generator.
+//
+// Example:
+//
+//	valid := g.ValidatorFunc()
+//	valid(1)                        // true if 1 is registered
+//	valid(enum.NewValue(1, "One")) // same check via Value[int]
+func (g *Generator[T]) ValidatorFunc() func(value any) bool {
+	return func(value any) bool {
+		v, ok := underlyingOf[T](value)
+		return ok && g.Contains(v)
+	}
+}
+
+// RegisterWith registers the Generator's ValidatorFunc under tag using fn,
+// which typically adapts a validator library's registration method.
+//
+// Example:
+//
+//	err := g.RegisterWith(func(tag string, ok func(any) bool) error {
+//	    return v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+//	        return ok(fl.Field().Interface())
+//	    })
+//	}, "status")
+func (g *Generator[T]) RegisterWith(fn func(tag string, v func(any) bool) error, tag string) error {
+	return fn(tag, g.ValidatorFunc())
+}
+
+// ValidatorFunc returns a function reporting whether its argument is a member of
+// the Basic registry. It accepts int values, Value[int], and Basic values (or
+// pointers to these). Basic values created from a different registry are rejected.
+// The returned function is safe for concurrent use.
+func (r *BasicRegistry) ValidatorFunc() func(value any) bool {
+	contains := r.meta.ValidatorFunc()
+	return func(value any) bool {
+		switch b := value.(type) {
+		case Basic:
+			if b.meta != nil && b.meta != r.meta {
+				return false
+			}
+		case *Basic:
+			if b == nil || (b.meta != nil && b.meta != r.meta) {
+				return false
+			}
+		}
+		return contains(value)
+	}
+}
+
+// RegisterWith registers the Basic registry's ValidatorFunc under tag using fn.
+func (r *BasicRegistry) RegisterWith(fn func(tag string, v func(any) bool) error, tag string) error {
+	return fn(tag, r.ValidatorFunc())
+}
+
+// ValidatorFunc is like BasicRegistry.ValidatorFunc.
+//
+// Deprecated: Call ValidatorFunc on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) ValidatorFunc() func(value any) bool {
+	return e.registry("ValidatorFunc").ValidatorFunc()
+}
+
+// RegisterWith is like BasicRegistry.RegisterWith.
+//
+// Deprecated: Call RegisterWith on the *BasicRegistry (see Basic.Registry).
+func (e *Basic) RegisterWith(fn func(tag string, v func(any) bool) error, tag string) error {
+	return e.registry("RegisterWith").RegisterWith(fn, tag)
+}
+
+// underlyingOf extracts a value of type T from an arbitrary input. It understands
+// T itself, Entry[T] implementations, pointers to either, and defined types whose
+// kind matches T (see convertAny for builds with the noreflect tag).
+func underlyingOf[T TypesValue](value any) (T, bool) {
+	var zero T
+	switch v := value.(type) {
+	case nil:
+		return zero, false
+	case T:
+		return v, true
+	case Entry[T]:
+		return v.Get(), true
+	}
+	return convertAny[T](value)
+}
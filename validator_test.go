@@ -0,0 +1,114 @@
+package enum
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// fakeRegistrar mimics the registration surface of a struct-tag validator.
+type fakeRegistrar struct {
+	funcs map[string]func(any) bool
+}
+
+func (f *fakeRegistrar) register(tag string, v func(any) bool) error {
+	if _, ok := f.funcs[tag]; ok {
+		return errors.New("tag already registered")
+	}
+	f.funcs[tag] = v
+	return nil
+}
+
+func TestGenerator_ValidatorFunc(t *testing.T) {
+	type Level int
+	g := NewMapped(map[string]int{"Low": 1, "High": 10})
+	valid := g.ValidatorFunc()
+
+	testCases := []struct {
+		name  string
+		input any
+		want  bool
+	}{
+		{"raw valid", 1, true},
+		{"raw invalid", 5, false},
+		{"defined type", Level(10), reflectBuild},
+		{"Value", NewValue(10, "High"), true},
+		{"Value pointer", &Value[int]{value: 1}, true},
+		{"Value invalid", NewValue(3, "Three"), false},
+		{"embedded Value", Status{Value: NewValue(1, "Low")}, true},
+		{"wrong kind", "1", false},
+		{"nil", nil, false},
+		{"nil pointer", (*int)(nil), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := valid(tc.input); got != tc.want {
+				t.Errorf("Expected %v for %v, got %v", tc.want, tc.input, got)
+			}
+		})
+	}
+}
+
+func TestBasic_ValidatorFunc(t *testing.T) {
+	b := NewBasicRegistry()
+	red := b.Add("Red")
+	other := NewBasicRegistry()
+	foreign := other.Add("Foreign")
+	valid := b.ValidatorFunc()
+
+	if !valid(red) || !valid(&red) || !valid(0) {
+		t.Error("Expected Red to be valid")
+	}
+	if valid(7) {
+		t.Error("Expected 7 to be invalid")
+	}
+	if valid(foreign) {
+		t.Error("Expected Basic from another registry to be invalid")
+	}
+}
+
+func TestRegisterWith(t *testing.T) {
+	r := &fakeRegistrar{funcs: make(map[string]func(any) bool)}
+	g := NewMapped(map[string]string{"Red": "red"})
+	if err := g.RegisterWith(r.register, "color"); err != nil {
+		t.Fatalf("RegisterWith failed: %v", err)
+	}
+	if err := g.RegisterWith(r.register, "color"); err == nil {
+		t.Error("Expected registrar error to be returned")
+	}
+	if !r.funcs["color"]("red") || r.funcs["color"]("blue") {
+		t.Error("Registered func returned unexpected results")
+	}
+
+	b := NewBasicRegistry()
+	b.Add("Pending")
+	if err := b.RegisterWith(r.register, "status"); err != nil {
+		t.Fatalf("RegisterWith failed: %v", err)
+	}
+	if !r.funcs["status"](0) {
+		t.Error("Expected 0 to be a valid status")
+	}
+}
+
+func TestValidatorFunc_Concurrency(t *testing.T) {
+	g := NewGenerator[int]()
+	valid := g.ValidatorFunc()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			g.Next(string(rune('A' + i)))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			valid(i)
+		}
+	}()
+	wg.Wait()
+	if !valid(99) {
+		t.Error("Expected 99 to be valid after generation")
+	}
+}
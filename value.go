@@ -27,24 +27,104 @@
 package enum
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"math"
 	"strconv"
+	"strings"
+	"unicode"
 )
 
 // Value is a generic struct that serves as a reusable base for enum types.
 // It stores the underlying value and its string representation, and implements
 // methods for JSON marshaling/unmarshaling and SQL scanning/valuering.
 // Typically, Value[T] is embedded in a custom enum type that implements Entry[T].
+//
+// A Value created with NewValue (or returned by Next, Parse, and friends) is set; the
+// zero Value[T]{} literal is unset, which IsSet distinguishes from a registered entry
+// whose value happens to be the zero value. Unset values marshal to JSON null (see
+// WithUnsetAsZero) and are stored as SQL NULL.
+//
+// encoding/json never treats a struct as empty, so `json:",omitempty"` keeps a Value
+// field even when unset. Tag it `json:",omitzero"` (Go 1.24+) instead: IsZero reports
+// unset values, so the field is omitted when unset and written when set, including when
+// set to the zero value. On older versions, use a *Value[T] field with omitempty.
+//
+// Entries handed out by a Generator created with WithProvenance also carry its ID, so
+// ValidateEntry can reject entries of another Generator; such a Value compares unequal
+// to NewValue(v.Get(), v.String()). Decoding or scanning into a Value clears the ID.
 type Value[T comparable] struct {
-	value T
-	name  string
+	value  T
+	name   string
+	set    bool
+	origin uint32       // Provenance ID of the Generator that handed out the entry, 0 if none.
+	flags  marshalFlags // JSON encoding options of the Generator that handed out the entry.
+}
+
+// marshalFlags are the JSON encoding options of a Generator. Entries it hands out carry
+// them, so a Value encodes the same way wherever it ends up.
+type marshalFlags uint8
+
+const (
+	marshalUnsetAsZero   marshalFlags = 1 << iota // Set by WithUnsetAsZero.
+	marshalInt64AsString                          // Set by WithInt64AsString.
+)
+
+// WithUnsetAsZero makes Value.MarshalJSON and Basic.MarshalJSON encode an unset value
+// as the zero value, the behavior before they tracked whether they are set, instead of
+// as null. It applies to the Generator's entries, including after Scan(nil) or a JSON
+// null unsets them, and to the values of a BasicRegistry; a Value created elsewhere,
+// such as the zero Value, still encodes as null.
+//
+// Example:
+//
+//	b := NewBasicRegistry(WithUnsetAsZero[int]())
+//	data, _ := json.Marshal(b.Empty()) // 0
+func WithUnsetAsZero[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.marshal |= marshalUnsetAsZero
+	}
+}
+
+// WithInt64AsString makes the Generator write 64-bit integer values (int64, uint64, and
+// int and uint on 64-bit platforms) to JSON as strings, e.g. "18446744073709551615",
+// following protobuf's JSON mapping. JavaScript and other consumers that decode JSON
+// numbers as float64 lose precision above 2^53; strings keep such values exact. It
+// applies to the Generator's entries (Value.MarshalJSON), to Basic values of a
+// BasicRegistry, and to MarshalOrderedJSON, State, MarshalVerboseJSON, Catalog, and the
+// journal. Decoding accepts both strings and numbers regardless of this option.
+//
+// Example:
+//
+//	g := NewGenerator[uint64](WithStart[uint64](1<<63), WithInt64AsString[uint64]())
+//	data, _ := json.Marshal(g.Next("Big")) // "9223372036854775808"
+func WithInt64AsString[T TypesValue]() Option[T] {
+	return func(g *Generator[T]) {
+		g.marshal |= marshalInt64AsString
+	}
+}
+
+// marshalValue encodes an enum value as JSON, as a string for 64-bit integers under
+// WithInt64AsString.
+func marshalValue[T comparable](v T, flags marshalFlags) ([]byte, error) {
+	if flags&marshalInt64AsString != 0 && is64BitInt[T]() {
+		return json.Marshal(formatKey(v))
+	}
+	return json.Marshal(v)
+}
+
+// is64BitInt reports whether T is a 64-bit integer kind on this platform.
+func is64BitInt[T comparable]() bool {
+	k, ok := comparableKind[T]()
+	return ok && k.IsInteger() && (k.Bits == 64 || k.Bits == 0 && strconv.IntSize == 64)
 }
 
 // NewValue creates a new enum value with the given underlying value and name.
-// It is used to initialize enum entries.
+// It is used to initialize enum entries. NewValue is permissive and accepts any name,
+// including an empty one; use NewValueChecked to validate input from outside the program.
 //
 // Example:
 //
@@ -52,7 +132,50 @@ type Value[T comparable] struct {
 //	fmt.Println(red.String()) // Output: Red
 //	fmt.Println(red.Get())    // Output: red
 func NewValue[T comparable](value T, name string) Value[T] {
-	return Value[T]{value: value, name: name}
+	return Value[T]{value: value, name: name, set: true}
+}
+
+// NameRule is an additional check applied to names by NewValueChecked.
+// It returns a non-nil error describing why the name is rejected.
+type NameRule func(name string) error
+
+// IdentifierRule is a NameRule accepting only valid Go identifiers: a letter or
+// underscore followed by letters, digits, or underscores.
+func IdentifierRule(name string) error {
+	for i, r := range name {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return fmt.Errorf("%q is not a valid identifier", name)
+	}
+	return nil
+}
+
+// NewValueChecked is like NewValue but validates its input. It rejects empty names,
+// names with leading or trailing whitespace, and, for string types, empty values.
+// Any rules (e.g., IdentifierRule) are applied to the name afterwards.
+// Errors wrap ErrInvalidName.
+//
+// Example:
+//
+//	v, err := NewValueChecked("red", "Red", IdentifierRule) // {red Red} <nil>
+//	_, err = NewValueChecked("red", " Red")                  // error: leading or trailing whitespace
+func NewValueChecked[T comparable](value T, name string, rules ...NameRule) (Value[T], error) {
+	if name == "" {
+		return Value[T]{}, fmt.Errorf("%w: empty name for value %v", ErrInvalidName, value)
+	}
+	if strings.TrimSpace(name) != name {
+		return Value[T]{}, fmt.Errorf("%w: %q has leading or trailing whitespace", ErrInvalidName, name)
+	}
+	if k, ok := comparableKind[T](); ok && k.IsString() && stringOf(value) == "" {
+		return Value[T]{}, fmt.Errorf("%w: empty value for %q", ErrInvalidName, name)
+	}
+	for _, rule := range rules {
+		if err := rule(name); err != nil {
+			return Value[T]{}, fmt.Errorf("%w: %w", ErrInvalidName, err)
+		}
+	}
+	return NewValue(value, name), nil
 }
 
 // Get returns the underlying value of the enum entry.
@@ -60,96 +183,217 @@ func (e Value[T]) Get() T {
 	return e.value
 }
 
-// String returns the name of the enum entry, as set during creation.
+// IsSet reports whether the Value holds an entry: true for values created with NewValue
+// or successfully unmarshaled or scanned, false for the zero Value[T]{} and after
+// unmarshaling JSON null or scanning SQL NULL.
+func (e Value[T]) IsSet() bool {
+	return e.set
+}
+
+// IsZero reports whether the Value is unset (see IsSet). encoding/json (Go 1.24+) calls
+// it for fields tagged `json:",omitzero"`, so an unset field is omitted while one set
+// to the zero value of T is kept.
+//
+// Example:
+//
+//	type Order struct {
+//	    Status Value[int] `json:"status,omitzero"`
+//	}
+//	b, _ := json.Marshal(Order{})                          // {}
+//	b, _ = json.Marshal(Order{Status: NewValue(0, "New")}) // {"status":0}
+func (e Value[T]) IsZero() bool {
+	return !e.set
+}
+
+// String returns the name of the enum entry, as set during creation. A Value without a
+// name (the zero Value, or one decoded by UnmarshalJSON or Scan, which have no registry
+// to resolve names from) formats its underlying value instead, e.g. "7".
 func (e Value[T]) String() string {
+	if e.name == "" {
+		return formatKey(e.value)
+	}
 	return e.name
 }
 
 // MarshalJSON implements json.Marshaler, serializing the enum's underlying value
-// to JSON. The value is marshaled as its raw type (e.g., string, int, float).
+// to JSON. The value is marshaled as its raw type (e.g., string, int, float), or as a
+// string for 64-bit integers if it comes from a Generator created with WithInt64AsString.
+// An unset Value is marshaled as null, unless it comes from a Generator created with
+// WithUnsetAsZero.
 func (e Value[T]) MarshalJSON() ([]byte, error) {
-	return json.Marshal(e.value)
+	if !e.set && e.flags&marshalUnsetAsZero == 0 {
+		return []byte("null"), nil
+	}
+	return marshalValue(e.value, e.flags)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for text-first config formats
+// (YAML, TOML, environment variables), where a field holds a name such as "Active".
+// Value has no registry, so only the name is recorded: the Value stays unset until
+// ResolveStruct (or Generator.ParseInto with String()) resolves it. encoding/json uses
+// UnmarshalJSON instead.
+//
+// Example:
+//
+//	var v Value[int]
+//	_ = v.UnmarshalText([]byte("Active")) // v.String() == "Active", v.IsSet() == false
+func (e *Value[T]) UnmarshalText(text []byte) error {
+	var zero T
+	e.value, e.name, e.set, e.origin = zero, string(text), false, 0
+	return nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler, deserializing a JSON value into
-// the enum's underlying value. After unmarshaling, it attempts to set the name
-// field by calling the Name method on the embedding type, if available. This
-// allows the enum to maintain its string representation based on the deserialized
-// value.
+// the enum's underlying value. It accepts both quoted and unquoted forms:
+// a JSON string such as "2" is parsed with the same rules as Generator.Parse
+// value literals, and for string-typed T a JSON number is stored as its text.
+//
+// JSON null resets the Value to unset.
 //
-// If the embedding type does not provide a Name method, the name field remains
-// unchanged. Errors are returned if the JSON data cannot be unmarshaled to type T.
+// The name field is left unchanged, as Value has no registry to resolve it from.
+// Errors are returned if the JSON data cannot be converted to type T.
 func (e *Value[T]) UnmarshalJSON(data []byte) error {
-	var val T
-	if err := json.Unmarshal(data, &val); err != nil {
+	if string(bytes.TrimSpace(data)) == "null" {
+		var zero T
+		e.value, e.set, e.origin = zero, false, 0
+		return nil
+	}
+	val, err := unmarshalValue[T](data)
+	if err != nil {
 		return err
 	}
-	e.value = val
+	e.value, e.set, e.origin = val, true, 0
 	return nil
 }
 
+// unmarshalValue decodes a JSON string or number into type T, accepting quoted
+// numbers for numeric T and bare numbers for string T.
+func unmarshalValue[T comparable](data []byte) (T, error) {
+	var val T
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return val, err
+		}
+		return parseStringToValue[T](s)
+	}
+	if k, ok := comparableKind[T](); ok && k.IsString() && isJSONNumber(trimmed) {
+		return parseStringToValue[T](string(trimmed))
+	}
+	err := json.Unmarshal(data, &val)
+	return val, err
+}
+
+// isJSONNumber reports whether data starts like a JSON number token.
+func isJSONNumber(data []byte) bool {
+	return len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9'))
+}
+
 // Value implements driver.Valuer, returning the enum's underlying value for
-// database storage. The value is returned as-is, compatible with SQL drivers.
+// database storage as one of the standard driver types: int64 for integers, float64
+// for floats, and string for strings, including named types such as type Priority int.
+// An unset Value is stored as NULL.
+//
+// Returns an error wrapping strconv.ErrRange for unsigned values above math.MaxInt64,
+// which an int64 cannot hold without changing sign.
 func (e Value[T]) Value() (driver.Value, error) {
-	// Convert numeric types to the standard driver types (int64, float64)
-	// to ensure database compatibility.
-	val := any(e.value)
-	switch v := val.(type) {
-	case int, int8, int16, int32, int64:
-		return reflect.ValueOf(v).Int(), nil
-	case uint, uint8, uint16, uint32, uint64:
-		return int64(reflect.ValueOf(v).Uint()), nil
-	case float32, float64:
-		return reflect.ValueOf(v).Float(), nil
+	if !e.set {
+		return nil, nil
+	}
+	// Convert on the underlying kind, as driver types must be the builtin ones.
+	k, ok := comparableKind[T]()
+	if !ok {
+		return e.value, nil
+	}
+	switch k.Class {
+	case ClassInt:
+		return intOf(e.value), nil
+	case ClassUint:
+		n := uintOf(e.value)
+		if n > math.MaxInt64 {
+			return nil, fmt.Errorf("enum: cannot store %d as an int64: %w", n, strconv.ErrRange)
+		}
+		return int64(n), nil
+	case ClassFloat:
+		return floatOf(e.value), nil
+	default:
+		return stringOf(e.value), nil
 	}
-	return e.value, nil
 }
 
 // Scan implements sql.Scanner, populating the enum from a database value.
 // It supports scanning from int64, float64, string, and []byte types, converting
-// them to the enum's underlying type T. After scanning, it attempts to set the
-// name field by calling the Name method on the embedding type, if available.
+// them to the enum's underlying type T:
+//
+//   - int64 and float64 are cross-assigned to any numeric T with range checking,
+//     and formatted as text for string T.
+//   - string and []byte are parsed like Generator.Parse value literals, and stored
+//     as-is for string T (e.g., TEXT columns from lib/pq arrive as []byte).
 //
-// Errors are returned if the database value cannot be converted to type T or
-// if the type is unsupported.
+// A NULL resets the Value to unset. The name field is left unchanged, as Value has no
+// registry to resolve it from. Errors are returned as *ScanError if the database value
+// cannot be converted to type T or if the source type is unsupported.
 func (e *Value[T]) Scan(value interface{}) error {
 	if value == nil {
+		var zero T
+		e.value, e.set, e.origin = zero, false, 0
 		return nil
 	}
 
 	var val T
 	var err error
+	k, ok := comparableKind[T]()
+	isString := ok && k.IsString()
 
 	// Handle different database value types
 	switch v := value.(type) {
 	case int64:
-		val, err = safeCast[T](v)
-		if err != nil {
-			return fmt.Errorf("failed to scan enum: %w", err)
+		if isString {
+			val, err = parseStringToValue[T](strconv.FormatInt(v, 10))
+		} else {
+			val, err = safeCast[T](v)
 		}
 	case float64:
-		val, err = safeCast[T](v)
-		if err != nil {
-			return fmt.Errorf("failed to scan enum: %w", err)
+		if isString {
+			val, err = parseStringToValue[T](strconv.FormatFloat(v, 'g', -1, 64))
+		} else {
+			val, err = safeCast[T](v)
 		}
 	case []byte:
 		val, err = parseStringToValue[T](string(v))
-		if err != nil {
-			return fmt.Errorf("failed to scan enum from bytes: %w", err)
-		}
 	case string:
 		val, err = parseStringToValue[T](v)
-		if err != nil {
-			return fmt.Errorf("failed to scan enum from string: %w", err)
-		}
 	default:
-		return fmt.Errorf("unsupported type for enum scan: %T", value)
+		err = errors.New("unsupported source type")
+	}
+	if err != nil {
+		return &ScanError{Source: fmt.Sprintf("%T", value), Target: fmt.Sprintf("%T", val), Value: value, Err: err}
 	}
 
-	e.value = val
+	e.value, e.set, e.origin = val, true, 0
 	return nil
 }
 
+// ScanError reports a database value that could not be scanned into an enum.
+// It names the source Go type delivered by the driver and the target type T.
+type ScanError struct {
+	Source string // Go type of the driver value (e.g., "int64", "[]uint8").
+	Target string // Go type of the enum's underlying value (e.g., "int8").
+	Value  any    // The driver value that failed to scan.
+	Err    error  // Underlying conversion error.
+}
+
+// Error implements the error interface.
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("failed to scan enum from %s into %s: %v", e.Source, e.Target, e.Err)
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
 // safeCast converts a numeric value (int64 or float64) to type T, checking for
 // out-of-range errors to prevent silent truncation or overflow. It ensures the
 // conversion is safe by verifying that converting the value back to the original
@@ -159,74 +403,150 @@ func (e *Value[T]) Scan(value interface{}) error {
 // range for the target type.
 func safeCast[T comparable, N int64 | float64](n N) (T, error) {
 	var zero T
-	targetType := reflect.TypeOf(zero)
-	val := reflect.ValueOf(n)
-
-	if !val.CanConvert(targetType) {
+	k, ok := comparableKind[T]()
+	if !ok || k.IsString() {
 		return zero, fmt.Errorf("cannot convert %T to %T", n, zero)
 	}
-
-	converted := val.Convert(targetType)
-	// Skip round-trip check for floating-point types to avoid precision issues.
-	if targetType.Kind() == reflect.Float32 || targetType.Kind() == reflect.Float64 {
-		return converted.Interface().(T), nil
+	// Skip range checks for floating-point types to avoid precision issues.
+	if k.IsFloat() {
+		return fromFloat[T](float64(n)), nil
 	}
 
-	// Check if the conversion resulted in an overflow by converting back.
-	if converted.Type().ConvertibleTo(val.Type()) {
-		roundTrip := converted.Convert(val.Type())
-		if roundTrip.Interface() != val.Interface() {
-			return zero, fmt.Errorf("value %v is out of range for type %T", n, zero)
-		}
-	} else {
-		// This case is unlikely with standard numeric types but is a safeguard.
-		return zero, fmt.Errorf("non-reciprocal conversion from %T to %T", zero, n)
+	// Floats must be whole and within 64 bits before the conversion to an integer,
+	// whose result is otherwise implementation-defined.
+	if f, isFloat := any(n).(float64); isFloat && (f != math.Trunc(f) || f < math.MinInt64 || f >= 1<<64 ||
+		k.Class == ClassInt && f >= 1<<63) {
+		return zero, fmt.Errorf("value %v is out of range for type %T", n, zero)
 	}
-	return converted.Interface().(T), nil
+	var converted T
+	var fits bool
+	switch {
+	case k.Class == ClassInt:
+		converted, fits = fromInt[T](int64(n))
+	case n >= 0:
+		// Negative values would silently wrap around when converted to unsigned types.
+		converted, fits = fromUint[T](uint64(n))
+	}
+	if !fits {
+		return zero, fmt.Errorf("value %v is out of range for type %T", n, zero)
+	}
+	return converted, nil
 }
 
 // parseStringToValue converts a string to the enum's underlying type T.
-// It supports string, integer, unsigned integer, and floating-point types.
-// For numeric types, it parses the string using strconv and checks for
-// out-of-range errors to prevent overflow or truncation.
+// It dispatches on the kind of T rather than its exact type, so user-defined types
+// such as `type Priority int` are supported alongside the built-in types.
+//
+// Integers accept Go-style literals (e.g., "42", "0x1F", "0o17", "0b101", "1_000"),
+// except that a leading "0" without a prefix keeps its decimal meaning ("010" is 10).
+// Floats accept decimal, exponent, and hexadecimal forms (e.g., "1.5", "1e3",
+// "0x1p-2"). Underscores are permitted only between digits, as in Go source. Numeric values are checked for out-of-range errors to prevent
+// overflow or truncation.
 //
 // Returns an error if the string cannot be parsed or if the type is unsupported.
 func parseStringToValue[T comparable](s string) (T, error) {
-	var zero T
-	switch any(zero).(type) {
-	case string:
-		return any(s).(T), nil
-	case int, int8, int16, int32, int64:
-		val, err := strconv.ParseInt(s, 10, 64)
+	var out T
+	k, ok := comparableKind[T]()
+	if !ok {
+		return out, fmt.Errorf("unsupported type for string parsing: %T", out)
+	}
+	switch k.Class {
+	case ClassString:
+		return fromString[T](s), nil
+	case ClassInt:
+		val, err := strconv.ParseInt(s, intLiteralBase(s), 64)
 		if err != nil {
-			return zero, err
+			return out, err
 		}
-		return safeCast[T](val)
-	case uint, uint8, uint16, uint32, uint64:
-		val, err := strconv.ParseUint(s, 10, 64)
+		v, fits := fromInt[T](val)
+		if !fits {
+			return out, fmt.Errorf("value %v is out of range for type %T", val, out)
+		}
+		return v, nil
+	case ClassUint:
+		val, err := strconv.ParseUint(s, intLiteralBase(s), 64)
 		if err != nil {
-			return zero, err
+			return out, err
+		}
+		v, fits := fromUint[T](val)
+		if !fits {
+			return out, fmt.Errorf("value %v is out of range for type %T", val, out)
+		}
+		return v, nil
+	default:
+		val, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return out, err
 		}
+		return fromFloat[T](val), nil
+	}
+}
 
-		var uzero T
-		t := reflect.TypeOf(uzero)
-		bitSize := t.Bits()
+// intLiteralBase returns the base to parse the integer literal s with: 0, so that
+// strconv accepts prefixes and underscores as Go does, unless s starts with a 0 digit
+// not followed by a prefix letter, which is parsed as decimal rather than octal.
+func intLiteralBase(s string) int {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) > 1 && s[0] == '0' && (s[1] == '_' || (s[1] >= '0' && s[1] <= '9')) {
+		return 10
+	}
+	return 0
+}
 
-		if bitSize < 64 {
-			maxValue := uint64(1)<<bitSize - 1
-			if val > maxValue {
-				return zero, fmt.Errorf("value %v is out of range for type %T", val, zero)
-			}
-		}
+// formatKey formats a value as a JSON object key: strings as-is, integers in decimal,
+// and floats in the shortest form that parses back to the same value.
+func formatKey[T comparable](v T) string {
+	k, ok := comparableKind[T]()
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	switch k.Class {
+	case ClassString:
+		return stringOf(v)
+	case ClassInt:
+		return strconv.FormatInt(intOf(v), 10)
+	case ClassUint:
+		return strconv.FormatUint(uintOf(v), 10)
+	default:
+		return strconv.FormatFloat(floatOf(v), 'g', -1, bitsOf[T]())
+	}
+}
 
-		return reflect.ValueOf(val).Convert(t).Interface().(T), nil
-	case float32, float64:
-		val, err := strconv.ParseFloat(s, 64)
+// parseKey is the inverse of formatKey. Unlike parseStringToValue, integers are
+// strictly decimal, so a key like "010" means 10 as it did with encoding/json.
+func parseKey[T comparable](s string) (T, error) {
+	var zero T
+	k, ok := comparableKind[T]()
+	if !ok {
+		return zero, fmt.Errorf("unsupported key type %T", zero)
+	}
+	switch k.Class {
+	case ClassString:
+		return fromString[T](s), nil
+	case ClassInt:
+		n, err := strconv.ParseInt(s, 10, bitsOf[T]())
 		if err != nil {
 			return zero, err
 		}
-		return safeCast[T](val)
+		v, _ := fromInt[T](n)
+		return v, nil
+	case ClassUint:
+		n, err := strconv.ParseUint(s, 10, bitsOf[T]())
+		if err != nil {
+			return zero, err
+		}
+		v, _ := fromUint[T](n)
+		return v, nil
 	default:
-		return zero, fmt.Errorf("unsupported type for string parsing: %T", zero)
+		f, err := strconv.ParseFloat(s, bitsOf[T]())
+		if err != nil {
+			return zero, err
+		}
+		if f != f {
+			return zero, errors.New("NaN cannot be used as an enum key")
+		}
+		return fromFloat[T](f), nil
 	}
 }
@@ -1,7 +1,13 @@
 package enum
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -57,8 +63,8 @@ func TestValue_JSON(t *testing.T) {
 		if v.Get() != 456 {
 			t.Errorf("Expected value to be 456, got %d", v.Get())
 		}
-		if v.String() != "" {
-			t.Errorf("Expected name to be empty, got %q", v.String())
+		if v.String() != "456" {
+			t.Errorf("Expected String to fall back to the value, got %q", v.String())
 		}
 	})
 
@@ -71,9 +77,9 @@ func TestValue_JSON(t *testing.T) {
 		if status.Get() != 2 {
 			t.Errorf("Expected value to be 2, got %d", status.Get())
 		}
-		// This feature is flawed, so we expect an empty name now.
-		if status.String() != "" {
-			t.Errorf("Expected name to be empty, got %q", status.String())
+		// The name cannot be resolved, so String falls back to the value.
+		if status.String() != "2" {
+			t.Errorf("Expected String to fall back to the value, got %q", status.String())
 		}
 	})
 
@@ -139,6 +145,31 @@ func TestValue_SQL(t *testing.T) {
 		}
 	})
 
+	t.Run("Value with named types", func(t *testing.T) {
+		type priority int
+		type code string
+		type ratio float32
+		type wide uint64
+		for _, tc := range []struct {
+			name string
+			v    driver.Valuer
+			want driver.Value
+		}{
+			{"int", NewValue(priority(3), "High"), int64(3)},
+			{"string", NewValue(code("x"), "X"), "x"},
+			{"float", NewValue(ratio(0.5), "Half"), float64(0.5)},
+			{"uint", NewValue(wide(math.MaxInt64), "Max"), int64(math.MaxInt64)},
+		} {
+			dv, err := tc.v.Value()
+			if err != nil || dv != tc.want || !driver.IsValue(dv) {
+				t.Errorf("%s: Value() = %T %v, %v; want %T %v", tc.name, dv, dv, err, tc.want, tc.want)
+			}
+		}
+		if _, err := NewValue(wide(math.MaxUint64), "Top").Value(); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Expected strconv.ErrRange above MaxInt64, got %v", err)
+		}
+	})
+
 	t.Run("Scan", func(t *testing.T) {
 		testCases := []struct {
 			name        string
@@ -177,10 +208,9 @@ func TestValue_SQL(t *testing.T) {
 					if status.Get() != tc.expectedVal {
 						t.Errorf("Expected value %d, got %d", tc.expectedVal, status.Get())
 					}
-					// After Scan, the name is expected to be the zero value (empty string)
-					// because the flawed reflection lookup has been removed.
-					if status.String() != "" {
-						t.Errorf("Expected name to be empty, got %q", status.String())
+					// Scan cannot resolve the name, so String falls back to the value.
+					if want := strconv.Itoa(tc.expectedVal); status.String() != want {
+						t.Errorf("Expected String %q, got %q", want, status.String())
 					}
 				}
 			})
@@ -244,3 +274,454 @@ func TestParseStringToValue(t *testing.T) {
 		}
 	})
 }
+
+func TestParseStringToValue_NamedTypes(t *testing.T) {
+	type Priority int
+	type Mask uint16
+	type Ratio float64
+	type Code string
+
+	t.Run("named int", func(t *testing.T) {
+		testCases := []struct {
+			in   string
+			want Priority
+		}{
+			{"42", 42},
+			{"-7", -7},
+			{"0x1F", 31},
+			{"0o17", 15},
+			{"0b101", 5},
+			{"1_000", 1000},
+			{"0x_1F", 31},
+			{"010", 10}, // Decimal, not octal.
+			{"-007", -7},
+		}
+		for _, tc := range testCases {
+			v, err := parseStringToValue[Priority](tc.in)
+			if err != nil || v != tc.want {
+				t.Errorf("parse %q: expected %d, got %d, err: %v", tc.in, tc.want, v, err)
+			}
+		}
+		for _, in := range []string{"_1", "1__0", "1_", "0_7", "0x", ""} {
+			if v, err := parseStringToValue[Priority](in); err == nil {
+				t.Errorf("parse %q: expected an error, got %d", in, v)
+			}
+		}
+	})
+	t.Run("named uint", func(t *testing.T) {
+		v, err := parseStringToValue[Mask]("0xFFFF")
+		if err != nil || v != 0xFFFF {
+			t.Errorf("Expected 65535, got %d, err: %v", v, err)
+		}
+		if _, err := parseStringToValue[Mask]("0x10000"); err == nil {
+			t.Error("Expected overflow error, got nil")
+		}
+	})
+	t.Run("named float", func(t *testing.T) {
+		testCases := []struct {
+			in   string
+			want Ratio
+		}{
+			{"1.5", 1.5},
+			{"1e3", 1000},
+			{"1_000.5", 1000.5},
+			{"0x1p-2", 0.25},
+		}
+		for _, in := range []string{"_1.5", "1__0.5", "1.5_"} {
+			if v, err := parseStringToValue[Ratio](in); err == nil {
+				t.Errorf("parse %q: expected an error, got %v", in, v)
+			}
+		}
+		for _, tc := range testCases {
+			v, err := parseStringToValue[Ratio](tc.in)
+			if err != nil || v != tc.want {
+				t.Errorf("parse %q: expected %v, got %v, err: %v", tc.in, tc.want, v, err)
+			}
+		}
+	})
+	t.Run("named string", func(t *testing.T) {
+		v, err := parseStringToValue[Code]("1_000")
+		if err != nil || v != "1_000" {
+			t.Errorf(`Expected "1_000" unchanged, got %q, err: %v`, v, err)
+		}
+	})
+	t.Run("named int overflow", func(t *testing.T) {
+		type Small int8
+		if _, err := parseStringToValue[Small]("0x80"); err == nil {
+			t.Error("Expected overflow error, got nil")
+		}
+	})
+	t.Run("Generator with named type", func(t *testing.T) {
+		g := NewMapped(map[string]Priority{"Low": 1, "High": 16})
+		v, err := g.Parse("0x10")
+		if err != nil || v.String() != "High" {
+			t.Errorf("Expected High, got %q, err: %v", v.String(), err)
+		}
+	})
+}
+
+func TestValue_UnmarshalJSON_Tolerant(t *testing.T) {
+	type Code string
+
+	testCases := []struct {
+		name    string
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{name: "int from number", input: `2`, want: 2},
+		{name: "int from string", input: `"2"`, want: 2},
+		{name: "int from hex string", input: `"0x10"`, want: 16},
+		{name: "int from word", input: `"two"`, wantErr: true},
+		{name: "int malformed", input: `"2`, wantErr: true},
+		{name: "int from bool", input: `true`, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var v Value[int]
+			err := json.Unmarshal([]byte(tc.input), &v)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if !tc.wantErr && v.Get() != tc.want {
+				t.Errorf("Expected %v, got %v", tc.want, v.Get())
+			}
+		})
+	}
+
+	t.Run("string from string", func(t *testing.T) {
+		var v Value[Code]
+		if err := json.Unmarshal([]byte(`"abc"`), &v); err != nil || v.Get() != "abc" {
+			t.Errorf("Expected abc, got %q, err: %v", v.Get(), err)
+		}
+	})
+	t.Run("string from number", func(t *testing.T) {
+		var v Value[Code]
+		if err := json.Unmarshal([]byte(`-1.50`), &v); err != nil || v.Get() != "-1.50" {
+			t.Errorf("Expected -1.50 as text, got %q, err: %v", v.Get(), err)
+		}
+	})
+	t.Run("float from string", func(t *testing.T) {
+		var v Value[float64]
+		if err := json.Unmarshal([]byte(`"1e3"`), &v); err != nil || v.Get() != 1000 {
+			t.Errorf("Expected 1000, got %v, err: %v", v.Get(), err)
+		}
+	})
+	t.Run("string malformed", func(t *testing.T) {
+		var v Value[string]
+		if err := json.Unmarshal([]byte(`{`), &v); err == nil {
+			t.Error("Expected error for malformed JSON")
+		}
+	})
+}
+
+func TestValue_Scan_Matrix(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     any
+		wantErr [5]bool // Per target: string, int, int8, uint, float64.
+		wantVal [5]any
+	}{
+		{name: "int64", src: int64(65),
+			wantVal: [5]any{"65", 65, int8(65), uint(65), 65.0}},
+		{name: "int64 negative", src: int64(-1),
+			wantErr: [5]bool{false, false, false, true, false},
+			wantVal: [5]any{"-1", -1, int8(-1), nil, -1.0}},
+		{name: "int64 out of range", src: int64(500),
+			wantErr: [5]bool{false, false, true, false, false},
+			wantVal: [5]any{"500", 500, nil, uint(500), 500.0}},
+		{name: "float64", src: float64(2),
+			wantVal: [5]any{"2", 2, int8(2), uint(2), 2.0}},
+		{name: "float64 fractional", src: float64(2.5),
+			wantErr: [5]bool{false, true, true, true, false},
+			wantVal: [5]any{"2.5", nil, nil, nil, 2.5}},
+		{name: "string", src: "7",
+			wantVal: [5]any{"7", 7, int8(7), uint(7), 7.0}},
+		{name: "string text", src: "active",
+			wantErr: [5]bool{false, true, true, true, true},
+			wantVal: [5]any{"active", nil, nil, nil, nil}},
+		{name: "bytes", src: []byte("8"),
+			wantVal: [5]any{"8", 8, int8(8), uint(8), 8.0}},
+		{name: "bytes text", src: []byte("active"),
+			wantErr: [5]bool{false, true, true, true, true},
+			wantVal: [5]any{"active", nil, nil, nil, nil}},
+		{name: "bool", src: true,
+			wantErr: [5]bool{true, true, true, true, true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				s Value[string]
+				i Value[int]
+				n Value[int8]
+				u Value[uint]
+				f Value[float64]
+			)
+			targets := [5]interface{ Scan(any) error }{&s, &i, &n, &u, &f}
+			got := [5]func() any{
+				func() any { return s.Get() },
+				func() any { return i.Get() },
+				func() any { return n.Get() },
+				func() any { return u.Get() },
+				func() any { return f.Get() },
+			}
+			for k, target := range targets {
+				err := target.Scan(tc.src)
+				if (err != nil) != tc.wantErr[k] {
+					t.Errorf("target %d: expected error %v, got %v", k, tc.wantErr[k], err)
+					continue
+				}
+				if err != nil {
+					var se *ScanError
+					if !errors.As(err, &se) || se.Source != fmt.Sprintf("%T", tc.src) {
+						t.Errorf("target %d: expected *ScanError naming %T, got %v", k, tc.src, err)
+					}
+					continue
+				}
+				if v := got[k](); v != tc.wantVal[k] {
+					t.Errorf("target %d: expected %v (%T), got %v (%T)", k, tc.wantVal[k], tc.wantVal[k], v, v)
+				}
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		var v Value[int8]
+		err := v.Scan(int64(500))
+		if err == nil || !strings.Contains(err.Error(), "int64") || !strings.Contains(err.Error(), "int8") {
+			t.Errorf("Expected error naming int64 and int8, got %v", err)
+		}
+	})
+}
+
+func TestNewValueChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		rules   []NameRule
+		wantErr bool
+	}{
+		{"Valid", "Red", nil, false},
+		{"Empty", "", nil, true},
+		{"Leading space", " Red", nil, true},
+		{"Trailing newline", "Red\n", nil, true},
+		{"Inner space allowed", "Light Red", nil, false},
+		{"Identifier ok", "Light_Red2", []NameRule{IdentifierRule}, false},
+		{"Identifier space", "Light Red", []NameRule{IdentifierRule}, true},
+		{"Identifier digit first", "2Red", []NameRule{IdentifierRule}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewValueChecked(1, tt.input, tt.rules...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidName) {
+				t.Errorf("Expected ErrInvalidName, got %v", err)
+			}
+			if err == nil && (v.Get() != 1 || v.String() != tt.input) {
+				t.Errorf("Unexpected value %v", v)
+			}
+		})
+	}
+
+	t.Run("Empty string value", func(t *testing.T) {
+		if _, err := NewValueChecked("", "Red"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Expected ErrInvalidName, got %v", err)
+		}
+		if v := NewValue("", ""); v.String() != "" {
+			t.Error("Expected NewValue to stay permissive")
+		}
+	})
+}
+
+func TestValue_IsSet(t *testing.T) {
+	g := NewMapped(map[string]int{"Zero": 0, "One": 1})
+	zero := g.MustParse("Zero")
+	var unset Value[int]
+
+	t.Run("State", func(t *testing.T) {
+		if !zero.IsSet() || !NewValue(0, "").IsSet() || unset.IsSet() {
+			t.Error("Expected entries from NewValue and Parse to be set, and the zero literal unset")
+		}
+		if v, err := g.Parse("Bogus"); err == nil || v.IsSet() {
+			t.Error("Expected failed Parse to return an unset Value")
+		}
+	})
+
+	t.Run("ValidateEntry", func(t *testing.T) {
+		if err := g.ValidateEntry(zero); err != nil {
+			t.Errorf("Expected registered zero value to validate, got %v", err)
+		}
+		if err := g.ValidateEntry(unset); !errors.Is(err, ErrUnset) {
+			t.Errorf("Expected ErrUnset, got %v", err)
+		}
+		if err := g.ValidateEntry(NewValue(7, "Seven")); !errors.Is(err, ErrUnknownValue) {
+			t.Errorf("Expected ErrUnknownValue, got %v", err)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type payload struct {
+			Set   Value[int] `json:"set"`
+			Unset Value[int] `json:"unset"`
+		}
+		data, err := json.Marshal(payload{Set: zero})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"set":0,"unset":null}` {
+			t.Errorf("Expected set zero as 0 and unset as null, got %s", data)
+		}
+		var back payload
+		back.Unset = NewValue(1, "One")
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatal(err)
+		}
+		if !back.Set.IsSet() || back.Set.Get() != 0 || back.Unset.IsSet() || back.Unset.Get() != 0 {
+			t.Errorf("Expected set/unset states to round-trip, got %+v", back)
+		}
+
+		zeroed := NewGenerator[int](WithUnsetAsZero[int]())
+		v := zeroed.Next("A")
+		if err := v.Scan(nil); err != nil || v.IsSet() {
+			t.Fatalf("Expected Scan(nil) to unset, got %+v, err: %v", v, err)
+		}
+		if data, _ := json.Marshal(v); string(data) != "0" {
+			t.Errorf("Expected unset as zero under WithUnsetAsZero, got %s", data)
+		}
+		if data, _ := json.Marshal(unset); string(data) != "null" {
+			t.Errorf("Expected other Values to keep null, got %s", data)
+		}
+	})
+
+	t.Run("String fallback", func(t *testing.T) {
+		if got := unset.String(); got != "0" {
+			t.Errorf("Expected unset Value to format its value, got %q", got)
+		}
+		if got := NewValue(7, "").String(); got != "7" {
+			t.Errorf("Expected unnamed Value to format its value, got %q", got)
+		}
+		if got := zero.String(); got != "Zero" {
+			t.Errorf("Expected name, got %q", got)
+		}
+	})
+
+	t.Run("IsZero", func(t *testing.T) {
+		if !unset.IsZero() || zero.IsZero() {
+			t.Error("Expected IsZero to report unset values only")
+		}
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		if dv, err := zero.Value(); err != nil || dv != int64(0) {
+			t.Errorf("Expected set zero to store 0, got %v, err: %v", dv, err)
+		}
+		if dv, err := unset.Value(); err != nil || dv != nil {
+			t.Errorf("Expected unset to store NULL, got %v, err: %v", dv, err)
+		}
+		v := NewValue(1, "One")
+		if err := v.Scan(nil); err != nil || v.IsSet() || v.Get() != 0 {
+			t.Errorf("Expected Scan(nil) to unset, got %+v, err: %v", v, err)
+		}
+		if err := v.Scan(int64(0)); err != nil || !v.IsSet() || v.Get() != 0 {
+			t.Errorf("Expected Scan(0) to set zero, got %+v, err: %v", v, err)
+		}
+	})
+}
+
+func TestWithInt64AsString(t *testing.T) {
+	const big = uint64(1)<<63 + 1 // Not representable as a float64.
+
+	t.Run("Value", func(t *testing.T) {
+		g := NewGenerator[uint64](WithStart(big), WithInt64AsString[uint64]())
+		data, err := json.Marshal(g.Next("Big"))
+		if err != nil || string(data) != `"9223372036854775809"` {
+			t.Fatalf("Expected a JSON string, got %s, err: %v", data, err)
+		}
+		back := g.Next("Next") // Decoding keeps the options of the Generator's entry.
+		if err := json.Unmarshal(data, &back); err != nil || back.Get() != big {
+			t.Errorf("Expected exact round trip, got %d, err: %v", back.Get(), err)
+		}
+		if again, _ := json.Marshal(back); string(again) != string(data) {
+			t.Errorf("Expected the decoded entry to keep encoding as a string, got %s", again)
+		}
+		if err := json.Unmarshal([]byte("9223372036854775809"), &back); err != nil || back.Get() != big {
+			t.Errorf("Expected numbers accepted, got %d, err: %v", back.Get(), err)
+		}
+		neg, _ := json.Marshal(NewGenerator[int64](WithStart(int64(-1)<<62-1), WithInt64AsString[int64]()).Next("Neg"))
+		if string(neg) != `"-4611686018427387905"` {
+			t.Errorf("Expected a JSON string for int64, got %s", neg)
+		}
+		small, _ := json.Marshal(NewGenerator[int32](WithStart(int32(7)), WithInt64AsString[int32]()).Next("Small"))
+		if string(small) != "7" {
+			t.Errorf("Expected 32-bit values to stay numbers, got %s", small)
+		}
+	})
+
+	t.Run("Basic", func(t *testing.T) {
+		if strconv.IntSize != 64 {
+			t.Skip("int is not 64 bits")
+		}
+		b := NewBasicRegistry(WithInt64AsString[int]())
+		huge := b.Add("Huge").With(1<<60 + 1)
+		data, _ := json.Marshal(huge)
+		if string(data) != `"1152921504606846977"` {
+			t.Fatalf("Expected a JSON string, got %s", data)
+		}
+		back := b.Empty()
+		if err := json.Unmarshal(data, &back); err != nil || back != huge {
+			t.Errorf("Expected exact round trip, got %v, err: %v", back, err)
+		}
+	})
+
+	t.Run("Generator formats", func(t *testing.T) {
+		g := NewMapped(map[string]uint64{"Zero": 0, "Big": big, "Max": 1<<64 - 1}, WithSortedEntries[uint64](), WithInt64AsString[uint64]())
+		g.SetDescription(big, "Snowflake")
+
+		ordered, _ := g.MarshalOrderedJSON()
+		if !strings.Contains(string(ordered), `{"value":"9223372036854775809","name":"Big"}`) {
+			t.Errorf("Unexpected ordered JSON %s", ordered)
+		}
+		var back Generator[uint64]
+		if err := json.Unmarshal(ordered, &back); err != nil || string(back.CanonicalBytes()) != string(g.CanonicalBytes()) {
+			t.Errorf("Expected exact round trip, got %v, err: %v", back.Values(), err)
+		}
+
+		state, _ := json.Marshal(g.State())
+		if !strings.Contains(string(state), `"value":"9223372036854775809"`) {
+			t.Errorf("Unexpected state JSON %s", state)
+		}
+		var decoded State[uint64]
+		if err := json.Unmarshal(state, &decoded); err != nil || decoded.Entries[1].Value != big {
+			t.Errorf("Expected exact state round trip, got %+v, err: %v", decoded, err)
+		}
+
+		verbose, _ := g.MarshalVerboseJSON()
+		if !strings.Contains(string(verbose), `"value":"18446744073709551615"`) {
+			t.Errorf("Unexpected verbose JSON %s", verbose)
+		}
+
+		catalog, _ := json.Marshal(g.Catalog())
+		if !strings.Contains(string(catalog), `{"id":"9223372036854775809","code":"Big"`) {
+			t.Errorf("Unexpected catalog JSON %s", catalog)
+		}
+		var entries []CatalogEntry[uint64]
+		if err := json.Unmarshal(catalog, &entries); err != nil || entries[1].ID != big || entries[1].Description != "Snowflake" {
+			t.Errorf("Expected exact catalog round trip, got %+v, err: %v", entries, err)
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		g := NewMapped(map[string]uint64{"Big": big})
+		for _, v := range []any{NewValue(big, "Big"), g.Values()[0], g.Pairs()[0]} {
+			if data, _ := json.Marshal(v); !strings.Contains(string(data), "9223372036854775809") || strings.Contains(string(data), `"9223372036854775809"`) {
+				t.Errorf("Expected a JSON number by default, got %s", data)
+			}
+		}
+		if data, _ := g.MarshalOrderedJSON(); string(data) != `[{"value":9223372036854775809,"name":"Big"}]` {
+			t.Errorf("Expected numbers in the Generator's formats, got %s", data)
+		}
+	})
+}
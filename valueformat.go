@@ -0,0 +1,32 @@
+package enum
+
+// WithValueFormatter makes the Generator render values as text with fn, so that a value
+// reads the same everywhere the Generator writes it: FormatValue and error messages,
+// FormatValueMap and FormatNameMap, the values of ExportTypeScript, and the fallback
+// String of a Typed registry. It takes precedence over WithRuneFormatting in those
+// places. Without it, integers are written in plain decimal and floats in the shortest
+// form that parses back to the same value (strconv.FormatFloat with precision -1).
+//
+// The formatter only affects text meant for people and generated code. JSON, SQL, JSON
+// object keys, and Parse are unchanged, and a value written by fn need not parse back.
+// In ExportTypeScript its output becomes a literal, so it must be valid there.
+//
+// Example:
+//
+//	rates := NewMapped(map[string]float64{"Reduced": 0.1 * 0.75, "Standard": 0.2},
+//		WithValueFormatter(func(v float64) string { return strconv.FormatFloat(v, 'f', 3, 64) }))
+//	fmt.Println(rates.FormatValueMap()) // Output: 0.075=Reduced, 0.200=Standard
+func WithValueFormatter[T TypesValue](fn func(T) string) Option[T] {
+	return func(g *Generator[T]) {
+		g.formatter = fn
+	}
+}
+
+// formatText formats value with the WithValueFormatter function, or as formatKey does.
+// Unlike formatValue, it ignores WithRuneFormatting, for output that lists numbers.
+func (g *Generator[T]) formatText(value T) string {
+	if g.formatter != nil {
+		return g.formatter(value)
+	}
+	return formatKey(value)
+}
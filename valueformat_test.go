@@ -0,0 +1,87 @@
+package enum
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWithValueFormatter(t *testing.T) {
+	t.Run("Float64Default", func(t *testing.T) {
+		g := NewMapped(map[string]float64{"Reduced": 0.075, "Tiny": 1e-7, "Huge": 1e21})
+		if got, want := g.FormatValueMap(), "1e-07=Tiny, 0.075=Reduced, 1e+21=Huge"; got != want {
+			t.Errorf("FormatValueMap() = %q, want %q", got, want)
+		}
+		if err := g.Validate(0.5); err == nil || !strings.HasSuffix(err.Error(), ": 0.5") {
+			t.Errorf("Expected the value in shortest form, got %v", err)
+		}
+	})
+
+	t.Run("Uint64Above2To53", func(t *testing.T) {
+		const big = uint64(1)<<53 + 1
+		g := NewMapped(map[string]uint64{"Big": big})
+		want := "9007199254740993"
+		if got := g.FormatValue(big); got != want {
+			t.Errorf("FormatValue = %q, want %q", got, want)
+		}
+		if got := g.FormatNameMap(); got != "Big="+want {
+			t.Errorf("FormatNameMap() = %q", got)
+		}
+		if err := g.Validate(big + 2); err == nil || !strings.HasSuffix(err.Error(), ": 9007199254740995") {
+			t.Errorf("Expected the exact value in the error, got %v", err)
+		}
+		var buf bytes.Buffer
+		if err := g.ExportTypeScript(&buf, "Sizes"); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "Big = "+want+",") {
+			t.Errorf("Expected the exact value in TypeScript, got:\n%s", buf.String())
+		}
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		rate := 0.1 * 0.75 // 0.07500000000000001
+		g := NewMapped(map[string]float64{"Reduced": rate, "Standard": 0.2},
+			WithValueFormatter(func(v float64) string { return strconv.FormatFloat(v, 'f', 3, 64) }))
+		if got, want := g.FormatValueMap(), "0.075=Reduced, 0.200=Standard"; got != want {
+			t.Errorf("FormatValueMap() = %q, want %q", got, want)
+		}
+		if err := g.Validate(0.3); err == nil || !strings.HasSuffix(err.Error(), ": 0.300") {
+			t.Errorf("Expected the formatted value in the error, got %v", err)
+		}
+		if err := g.TrySetDescription(0.3, "x"); err == nil || !strings.Contains(err.Error(), "unknown value 0.300") {
+			t.Errorf("Expected the formatted value in the error, got %v", err)
+		}
+		var buf bytes.Buffer
+		if err := g.ExportTypeScript(&buf, "Rate"); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "Reduced = 0.075,") {
+			t.Errorf("Expected the formatted value in TypeScript, got:\n%s", buf.String())
+		}
+		if got := g.Clone().FormatValue(rate); got != "0.075" {
+			t.Errorf("Expected clones to keep the formatter, got %q", got)
+		}
+	})
+
+	t.Run("OverridesRunes", func(t *testing.T) {
+		hex := func(v rune) string { return "0x" + strconv.FormatInt(int64(v), 16) }
+		g := NewRuneGenerator(WithValueFormatter(hex))
+		g.Next("A")
+		if got := g.FormatValue('a'); got != "0x61" {
+			t.Errorf("FormatValue('a') = %q, want 0x61", got)
+		}
+	})
+
+	t.Run("Typed", func(t *testing.T) {
+		levels := NewTyped[typedLevel](WithValueFormatter(func(v typedLevel) string { return "#" + strconv.Itoa(int(v)) }))
+		levels.Add("Low")
+		if got := levels.String(9); got != "typedLevel(#9)" {
+			t.Errorf("String(9) = %q, want typedLevel(#9)", got)
+		}
+		if _, err := levels.MarshalText(9); err == nil || !strings.Contains(err.Error(), "typedLevel #9") {
+			t.Errorf("Expected the formatted value in the error, got %v", err)
+		}
+	})
+}
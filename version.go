@@ -0,0 +1,202 @@
+package enum
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// stateMagic identifies version 1 of the State binary layout.
+const stateMagic = "enum-state-v1"
+
+// Version returns a counter incremented by every mutation of the Generator (Next,
+// Remove, Rename, aliases, display names, descriptions, sorting, UnmarshalJSON, ...).
+// Comparing versions is a cheap way to detect that data derived from the Generator
+// is stale. It is lock-free and thread-safe.
+func (g *Generator[T]) Version() uint64 {
+	return g.version.Load()
+}
+
+// SnapshotVersioned returns a copy of the entries together with the version they
+// correspond to, read atomically, so callers can later re-snapshot only if Version
+// has changed. It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	entries, ver := g.SnapshotVersioned()
+//	// ...
+//	if g.Version() != ver {
+//	    entries, ver = g.SnapshotVersioned()
+//	}
+func (g *Generator[T]) SnapshotVersioned() ([]Value[T], uint64) {
+	g.rlock()
+	defer g.runlock()
+	entries := make([]Value[T], len(g.values))
+	copy(entries, g.values)
+	return entries, g.version.Load()
+}
+
+// bump records a mutation. The caller must hold the write lock.
+func (g *Generator[T]) bump() {
+	g.version.Add(1)
+}
+
+// State is a persisted snapshot of a Generator's entries, in entry order, tagged with
+// the version they were taken at, so it can be matched against a live Generator.
+// It marshals to JSON as {"version":3,"kind":"int","entries":[{"value":1,"name":"Pending"},...]}
+// and to binary with MarshalBinary.
+type State[T TypesValue] struct {
+	Version uint64    `json:"version"`
+	Kind    ValueKind `json:"kind"` // Kind of T, checked when decoding.
+	Entries []Pair[T] `json:"entries"`
+
+	flags marshalFlags // JSON encoding options of the Generator the state was taken from.
+}
+
+// State returns the Generator's entries and version as a State.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) State() State[T] {
+	g.rlock()
+	defer g.runlock()
+	return State[T]{Version: g.version.Load(), Kind: g.kind, Entries: g.pairsLocked(false), flags: g.marshal}
+}
+
+// MarshalJSON implements json.Marshaler. Values are strings for 64-bit integers if the
+// state was taken from a Generator created with WithInt64AsString.
+func (s State[T]) MarshalJSON() ([]byte, error) {
+	entries, err := marshalPairs(s.Entries, s.flags)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Version uint64          `json:"version"`
+		Kind    ValueKind       `json:"kind"`
+		Entries json.RawMessage `json:"entries"`
+	}{s.Version, s.Kind, entries})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It fails if the state records a kind
+// other than T's; states without a kind are accepted.
+func (s *State[T]) UnmarshalJSON(data []byte) error {
+	type plain State[T] // Drops the methods, avoiding recursion.
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	want := kindOf[T]()
+	if decoded.Kind != (ValueKind{}) && decoded.Kind != want {
+		return fmt.Errorf("enum: invalid state: kind %s does not match %s", decoded.Kind, want)
+	}
+	decoded.Kind = want
+	*s = State[T](decoded)
+	return nil
+}
+
+// Current reports whether the state was taken from g at its current version.
+// Versions are only comparable for the Generator the state was taken from.
+func (s State[T]) Current(g *Generator[T]) bool {
+	return s.Version == g.Version()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The layout is the magic
+// "enum-state-v1" 0x00, the version as a uvarint, then the entries in the layout
+// of Generator.CanonicalBytes.
+func (s State[T]) MarshalBinary() ([]byte, error) {
+	entries := make([]Value[T], len(s.Entries))
+	for i, p := range s.Entries {
+		entries[i] = NewValue(p.Value, p.Name)
+	}
+	buf := append([]byte(stateMagic), 0)
+	buf = binary.AppendUvarint(buf, s.Version)
+	return append(buf, canonicalBytes(entries)...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding the layout written
+// by MarshalBinary. It fails if the data is truncated or was written for a different
+// kind of underlying type.
+func (s *State[T]) UnmarshalBinary(data []byte) error {
+	fail := func(what string) error {
+		return fmt.Errorf("enum: invalid state: %s", what)
+	}
+	if len(data) < len(stateMagic)+1 || string(data[:len(stateMagic)]) != stateMagic || data[len(stateMagic)] != 0 {
+		return fail("bad magic")
+	}
+	data = data[len(stateMagic)+1:]
+	version, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fail("bad version")
+	}
+	entries, err := decodeCanonical[T](data[n:])
+	if err != nil {
+		return fail(err.Error())
+	}
+	s.Version, s.Kind, s.Entries = version, kindOf[T](), entries
+	return nil
+}
+
+// decodeCanonical is the inverse of canonicalBytes.
+func decodeCanonical[T TypesValue](data []byte) ([]Pair[T], error) {
+	var zero T
+	kind := canonicalKind(kindOf[T]())
+	header := len(canonicalMagic) + 2
+	if len(data) < header || string(data[:len(canonicalMagic)]) != canonicalMagic || data[len(canonicalMagic)] != 0 {
+		return nil, errors.New("bad canonical magic")
+	}
+	if data[header-1] != kind {
+		return nil, fmt.Errorf("kind %q does not match %T", data[header-1], zero)
+	}
+	data = data[header:]
+
+	// next reads a length-prefixed field; ok is false if data is truncated.
+	next := func() ([]byte, bool) {
+		l, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < l {
+			return nil, false
+		}
+		field := data[n : n+int(l)]
+		data = data[n+int(l):]
+		return field, true
+	}
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, errors.New("bad entry count")
+	}
+	data = data[n:]
+	var pairs []Pair[T]
+	for i := uint64(0); i < count; i++ {
+		name, ok := next()
+		if !ok {
+			return nil, fmt.Errorf("entry %d: truncated name", i)
+		}
+		var value T
+		if kind == canonicalString {
+			s, ok := next()
+			if !ok {
+				return nil, fmt.Errorf("entry %d: truncated value", i)
+			}
+			value = fromString[T](string(s))
+		} else {
+			if len(data) < 8 {
+				return nil, fmt.Errorf("entry %d: truncated value", i)
+			}
+			bits := binary.BigEndian.Uint64(data)
+			data = data[8:]
+			switch kind {
+			case canonicalSigned:
+				value, _ = fromInt[T](int64(bits))
+			case canonicalUnsigned:
+				value, _ = fromUint[T](bits)
+			default:
+				value = fromFloat[T](math.Float64frombits(bits))
+			}
+		}
+		pairs = append(pairs, Pair[T]{Value: value, Name: string(name)})
+	}
+	if len(data) != 0 {
+		return nil, errors.New("trailing data")
+	}
+	return pairs, nil
+}
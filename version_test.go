@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestGenerator_Version(t *testing.T) {
+	t.Run("Mutations", func(t *testing.T) {
+		g := NewGenerator[int]()
+		steps := []struct {
+			name   string
+			mutate func()
+		}{
+			{"Next", func() { g.Next("A"); g.Next("B") }},
+			{"Rename", func() { g.Rename("B", "Bee") }},
+			{"AddAlias", func() { g.AddAlias("A", "Ay") }},
+			{"SetDisplayName", func() { g.SetDisplayName(0, "de", "Ah") }},
+			{"SetDescription", func() { g.SetDescription(0, "First") }},
+			{"SortByName", func() { g.SortByName() }},
+			{"Remove", func() { g.Remove("Bee") }},
+			{"UnmarshalJSON", func() { g.UnmarshalJSON([]byte(`{"5":"Five"}`)) }},
+		}
+		prev := g.Version()
+		for _, step := range steps {
+			step.mutate()
+			if v := g.Version(); v <= prev {
+				t.Errorf("%s: expected version to increase from %d, got %d", step.name, prev, v)
+			} else {
+				prev = v
+			}
+		}
+		g.Values()
+		g.Parse("Five")
+		if g.Version() != prev {
+			t.Error("Expected reads not to change the version")
+		}
+		if err := g.Rename("Missing", "X"); err == nil || g.Version() != prev {
+			t.Error("Expected failed mutations not to change the version")
+		}
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		g := NewGenerator[int]()
+		g.Next("A")
+		g.Next("B")
+		c := g.Clone()
+		if c.Version() != 0 {
+			t.Errorf("Expected the clone to start at version 0, got %d", c.Version())
+		}
+		c.Next("C")
+		if c.Version() != 1 || g.Version() != 2 {
+			t.Errorf("Expected independent versions, got %d and %d", c.Version(), g.Version())
+		}
+	})
+
+	t.Run("SnapshotVersioned", func(t *testing.T) {
+		g := NewGenerator[int]()
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					g.Next(string(rune('a'+i)) + string(rune('A'+j)))
+				}
+			}(i)
+		}
+		for i := 0; i < 50; i++ {
+			entries, ver := g.SnapshotVersioned()
+			if uint64(len(entries)) != ver {
+				t.Fatalf("Snapshot of %d entries does not match version %d", len(entries), ver)
+			}
+		}
+		wg.Wait()
+	})
+}
+
+func TestState(t *testing.T) {
+	g := NewMapped(map[string]int8{"Low": -1, "High": 100}, WithSortedEntries[int8]())
+	g.Rename("High", "Top")
+	state := g.State()
+	if !state.Current(g) || state.Version != 1 {
+		t.Errorf("Expected current state at version 1, got %d", state.Version)
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"version":1,"kind":"int8","entries":[{"value":-1,"name":"Low"},{"value":100,"name":"Top"}]}`
+		if string(data) != want {
+			t.Errorf("Expected %s, got %s", want, data)
+		}
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		data, err := state.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back State[int8]
+		if err := back.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(back, state) {
+			t.Errorf("Expected %+v, got %+v", state, back)
+		}
+		var wrong State[string]
+		if err := wrong.UnmarshalBinary(data); err == nil {
+			t.Error("Expected error for mismatched kind")
+		}
+		if err := back.UnmarshalBinary(data[:len(data)-3]); err == nil {
+			t.Error("Expected error for truncated data")
+		}
+		sg := NewMapped(map[string]string{"A": "alpha"})
+		sdata, _ := sg.State().MarshalBinary()
+		if err := wrong.UnmarshalBinary(sdata); err != nil || wrong.Entries[0] != (Pair[string]{"alpha", "A"}) {
+			t.Errorf("Expected string state round trip, got %+v, err: %v", wrong, err)
+		}
+	})
+}
@@ -0,0 +1,268 @@
+package enum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// VersionRange is the range of API versions in which an entry exists, from Since
+// (inclusive) up to Until (exclusive). An empty bound leaves that side open.
+type VersionRange struct {
+	Since string `json:"since,omitempty"`
+	Until string `json:"until,omitempty"`
+}
+
+// String describes the range, e.g. "v3 up to v4" or "before v4".
+func (r VersionRange) String() string {
+	switch {
+	case r.Since != "" && r.Until != "":
+		return r.Since + " up to " + r.Until
+	case r.Since != "":
+		return r.Since + " and later"
+	case r.Until != "":
+		return "before " + r.Until
+	}
+	return "all versions"
+}
+
+// contains reports whether version lies in the range, comparing with cmp.
+func (r VersionRange) contains(version string, cmp func(a, b string) int) bool {
+	return (r.Since == "" || cmp(version, r.Since) >= 0) && (r.Until == "" || cmp(version, r.Until) < 0)
+}
+
+// CompareVersions compares two semver-like versions, returning -1, 0, or +1. A leading
+// "v" is ignored, dot-separated components are compared numerically when both are
+// numbers and lexically otherwise, and missing components count as 0, so "v3" equals
+// "3.0". A pre-release suffix after "-" sorts before the release ("1.0-beta" < "1.0").
+// It is the default comparator of SetVersionRange (see WithVersionComparator).
+func CompareVersions(a, b string) int {
+	a, aPre, aHasPre := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(a, "v"), "V"), "-")
+	b, bPre, bHasPre := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(b, "v"), "V"), "-")
+	if c := compareComponents(strings.Split(a, "."), strings.Split(b, ".")); c != 0 {
+		return c
+	}
+	switch {
+	case aHasPre && !bHasPre:
+		return -1
+	case !aHasPre && bHasPre:
+		return 1
+	}
+	return compareComponents(strings.Split(aPre, "."), strings.Split(bPre, "."))
+}
+
+// compareComponents compares version components pairwise, padding the shorter list with "0".
+func compareComponents(a, b []string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := "0", "0"
+		if i < len(a) && a[i] != "" {
+			x = a[i]
+		}
+		if i < len(b) && b[i] != "" {
+			y = b[i]
+		}
+		xn, xErr := strconv.ParseUint(x, 10, 64)
+		yn, yErr := strconv.ParseUint(y, 10, 64)
+		switch {
+		case xErr == nil && yErr == nil && xn != yn:
+			if xn < yn {
+				return -1
+			}
+			return 1
+		case (xErr != nil || yErr != nil) && x != y:
+			return strings.Compare(x, y)
+		}
+	}
+	return 0
+}
+
+// WithVersionComparator sets the function ordering API versions for SetVersionRange and
+// the version-filtered views. It must return a negative number, zero, or a positive
+// number as a is before, equal to, or after b. The default is CompareVersions.
+func WithVersionComparator[T TypesValue](cmp func(a, b string) int) Option[T] {
+	return func(g *Generator[T]) {
+		g.versionCmp = cmp
+	}
+}
+
+// SetVersionRange restricts the entry name (and any other name of its value) to API
+// versions from since (inclusive) up to until (exclusive); either bound may be empty to
+// leave it open, and clearing both removes the restriction. Entries without a range exist
+// in every version. The range affects only the version-aware methods (ForVersion,
+// ValuesForVersion, ParseVersion, and CatalogForVersion); Parse and Names are unchanged.
+// It is thread-safe, using a write lock to protect state modifications.
+//
+// Returns an error wrapping ErrNotFound if name is not registered, or an error if since
+// is not before until.
+//
+// Example:
+//
+//	g.SetVersionRange("Archived", "v3", "") // exists since v3
+//	g.SetVersionRange("Draft", "", "v4")    // removed in v4
+//	g.ForVersion("v4").Names()              // every name except Draft
+func (g *Generator[T]) SetVersionRange(name, since, until string) error {
+	g.lock()
+	defer g.unlock()
+	value, ok := g.nameMap[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+	return g.setVersionRangeLocked(name, value, since, until)
+}
+
+// setVersionRangeLocked implements SetVersionRange for the entry name with value value.
+// The caller must hold the write lock.
+func (g *Generator[T]) setVersionRangeLocked(name string, value T, since, until string) error {
+	if since != "" && until != "" && g.compareVersions(since, until) >= 0 {
+		return fmt.Errorf("enum: version range of %q is empty: %q is not before %q", name, since, until)
+	}
+	if since == "" && until == "" {
+		delete(g.ranges, value)
+	} else {
+		if g.ranges == nil {
+			g.ranges = make(map[T]VersionRange)
+		}
+		g.ranges[value] = VersionRange{Since: since, Until: until}
+	}
+	g.journalLocked(journalLine{Op: "range", Name: name, Since: since, Until: until}, value)
+	g.bump()
+	return nil
+}
+
+// VersionRange returns the range set for name with SetVersionRange, and false if name
+// has no range or is not registered. It is thread-safe, using a read lock for access.
+func (g *Generator[T]) VersionRange(name string) (VersionRange, bool) {
+	g.rlock()
+	defer g.runlock()
+	value, ok := g.nameMap[name]
+	if !ok {
+		return VersionRange{}, false
+	}
+	r, ok := g.ranges[value]
+	return r, ok
+}
+
+// ValuesForVersion returns the entries that exist in API version v, in entry order.
+// It is thread-safe, using a read lock for access.
+func (g *Generator[T]) ValuesForVersion(v string) []Value[T] {
+	return g.ForVersion(v).Values()
+}
+
+// ParseVersion is like Parse but also rejects entries that do not exist in API version
+// v, with an error wrapping ErrUnknownValue that names the entry's valid range.
+// It is thread-safe, using a read lock for access.
+//
+// Example:
+//
+//	_, err := g.ParseVersion("Draft", "v4") // error: ... "Draft" does not exist in version v4 (valid: before v4)
+func (g *Generator[T]) ParseVersion(s, v string) (Value[T], error) {
+	entry, err := g.Parse(s)
+	if err != nil {
+		return Value[T]{}, err
+	}
+	g.rlock()
+	defer g.runlock()
+	if r, ok := g.ranges[entry.value]; ok && !r.contains(v, g.compareVersions) {
+		return Value[T]{}, fmt.Errorf("%w: %q does not exist in version %s (valid: %s)", ErrUnknownValue, entry.name, v, r)
+	}
+	return entry, nil
+}
+
+// CatalogForVersion makes Catalog list only the entries that exist in API version v.
+func CatalogForVersion(v string) CatalogOption {
+	return func(c *catalogConfig) {
+		c.version, c.hasVersion = v, true
+	}
+}
+
+// ForVersion returns a view of the entries that exist in API version v. The view reads
+// the Generator on each call instead of copying it, so it stays current.
+func (g *Generator[T]) ForVersion(v string) VersionView[T] {
+	return VersionView[T]{g: g, version: v}
+}
+
+// VersionView is a version-filtered view of a Generator, returned by ForVersion.
+// Its methods are thread-safe, using the Generator's read lock for access.
+type VersionView[T TypesValue] struct {
+	g       *Generator[T]
+	version string
+}
+
+// Version returns the API version the view filters for.
+func (v VersionView[T]) Version() string {
+	return v.version
+}
+
+// Values returns the entries that exist in the view's version, in entry order.
+func (v VersionView[T]) Values() []Value[T] {
+	v.g.rlock()
+	defer v.g.runlock()
+	out := make([]Value[T], 0, len(v.g.values))
+	for _, entry := range v.g.values {
+		if v.g.inVersionLocked(entry.value, v.version) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+// Names is like Generator.Names, restricted to the view's version.
+func (v VersionView[T]) Names() []string {
+	v.g.rlock()
+	defer v.g.runlock()
+	names := make([]string, 0, len(v.g.values))
+	for _, entry := range v.g.values {
+		if (v.g.omitUnknown && v.g.isUnknown(entry.value)) || !v.g.inVersionLocked(entry.value, v.version) {
+			continue
+		}
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+// ValidValues is like Generator.ValidValues, restricted to the view's version.
+func (v VersionView[T]) ValidValues() []T {
+	v.g.rlock()
+	defer v.g.runlock()
+	values := make([]T, 0, len(v.g.valueMap))
+	for value := range v.g.valueMap {
+		if (v.g.omitUnknown && v.g.isUnknown(value)) || !v.g.inVersionLocked(value, v.version) {
+			continue
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// Contains reports whether value is registered and exists in the view's version.
+func (v VersionView[T]) Contains(value T) bool {
+	v.g.rlock()
+	defer v.g.runlock()
+	_, ok := v.g.valueMap[value]
+	return ok && v.g.inVersionLocked(value, v.version)
+}
+
+// Parse is like Generator.ParseVersion for the view's version.
+func (v VersionView[T]) Parse(s string) (Value[T], error) {
+	return v.g.ParseVersion(s, v.version)
+}
+
+// Catalog is like Generator.Catalog, restricted to the view's version.
+func (v VersionView[T]) Catalog(opts ...CatalogOption) []CatalogEntry[T] {
+	return v.g.Catalog(append(opts, CatalogForVersion(v.version))...)
+}
+
+// inVersionLocked reports whether value exists in API version v.
+// The caller must hold the read lock.
+func (g *Generator[T]) inVersionLocked(value T, v string) bool {
+	r, ok := g.ranges[value]
+	return !ok || r.contains(v, g.compareVersions)
+}
+
+// compareVersions compares API versions with the configured comparator.
+func (g *Generator[T]) compareVersions(a, b string) int {
+	if g.versionCmp != nil {
+		return g.versionCmp(a, b)
+	}
+	return CompareVersions(a, b)
+}
@@ -0,0 +1,122 @@
+package enum
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"v3", "3.0", 0},
+		{"v3", "v4", -1},
+		{"v10", "v9", 1},
+		{"1.2.3", "1.10", -1},
+		{"1.0-beta", "1.0", -1},
+		{"1.0-alpha", "1.0-beta", -1},
+		{"2024-01", "2024-01", 0},
+	}
+	for _, tc := range testCases {
+		if got := CompareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestGenerator_VersionRange(t *testing.T) {
+	newStatus := func(opts ...Option[int]) *Generator[int] {
+		g := NewGenerator[int](opts...)
+		g.Next("Draft")
+		g.Next("Active")
+		g.Next("Archived")
+		if err := g.SetVersionRange("Archived", "v3", ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.SetVersionRange("Draft", "", "v4"); err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+	g := newStatus()
+
+	t.Run("Views", func(t *testing.T) {
+		testCases := map[string][]string{
+			"v2":   {"Draft", "Active"},
+			"v3":   {"Draft", "Active", "Archived"},
+			"v4.1": {"Active", "Archived"},
+		}
+		for version, want := range testCases {
+			view := g.ForVersion(version)
+			if got := view.Names(); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: expected names %v, got %v", version, want, got)
+			}
+			if got := g.ValuesForVersion(version); len(got) != len(want) {
+				t.Errorf("%s: expected %d values, got %v", version, len(want), got)
+			}
+			if got := view.Catalog(); len(got) != len(want) {
+				t.Errorf("%s: expected %d catalog entries, got %v", version, len(want), got)
+			}
+			values := view.ValidValues()
+			sort.Ints(values)
+			if len(values) != len(want) {
+				t.Errorf("%s: expected %d valid values, got %v", version, len(want), values)
+			}
+		}
+		if g.ForVersion("v4").Contains(0) || !g.ForVersion("v3").Contains(0) {
+			t.Error("Expected Draft to exist only before v4")
+		}
+		if got := g.Names(); len(got) != 3 {
+			t.Errorf("Expected unfiltered Names, got %v", got)
+		}
+	})
+
+	t.Run("ParseVersion", func(t *testing.T) {
+		if v, err := g.ParseVersion("Archived", "v3"); err != nil || v.Get() != 2 {
+			t.Errorf("Expected Archived in v3, got %v, err: %v", v, err)
+		}
+		_, err := g.ParseVersion("Draft", "v4")
+		if !errors.Is(err, ErrUnknownValue) || !strings.Contains(err.Error(), "before v4") {
+			t.Errorf("Expected an error naming the range, got %v", err)
+		}
+		if _, err := g.ForVersion("v2").Parse("Archived"); err == nil || !strings.Contains(err.Error(), "v3 and later") {
+			t.Errorf("Expected an error naming the range, got %v", err)
+		}
+		if _, err := g.Parse("Draft"); err != nil {
+			t.Errorf("Expected plain Parse to ignore ranges, got %v", err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if err := g.SetVersionRange("Missing", "v1", ""); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+		if err := g.SetVersionRange("Active", "v4", "v3"); err == nil {
+			t.Error("Expected an error for an empty range")
+		}
+	})
+
+	t.Run("Clear and clone", func(t *testing.T) {
+		c := g.Clone()
+		if err := c.SetVersionRange("Draft", "", ""); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := c.VersionRange("Draft"); ok {
+			t.Error("Expected the range to be cleared")
+		}
+		if r, ok := g.VersionRange("Draft"); !ok || r.Until != "v4" {
+			t.Errorf("Expected the original range kept, got %v", r)
+		}
+	})
+
+	t.Run("Custom comparator", func(t *testing.T) {
+		dates := newStatus(WithVersionComparator[int](strings.Compare))
+		if got := dates.ForVersion("v10").Names(); !reflect.DeepEqual(got, []string{"Draft", "Active"}) {
+			t.Errorf("Expected lexical comparison, got %v", got)
+		}
+	})
+}
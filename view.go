@@ -0,0 +1,64 @@
+package enum
+
+// GeneratorView is a read-only handle to a Generator. It exposes lookup, parsing,
+// and serialization but none of the Generator's mutating methods, so it can be
+// handed to other packages safely.
+//
+// A view shares the Generator's underlying data rather than copying it, so it always
+// reflects the current state as the owner adds entries. It is safe for concurrent use
+// and cheap to create and pass by value.
+type GeneratorView[T TypesValue] struct {
+	g *Generator[T]
+}
+
+// View returns a read-only view of the Generator.
+//
+// Example:
+//
+//	g := NewNumeric(1)
+//	v := g.View()
+//	g.Next("One")
+//	fmt.Println(v.Name(1)) // Output: One true
+func (g *Generator[T]) View() GeneratorView[T] {
+	return GeneratorView[T]{g: g}
+}
+
+// Name returns the name associated with a given value, if it exists.
+func (v GeneratorView[T]) Name(value T) (string, bool) {
+	return v.g.Name(value)
+}
+
+// Get returns the value associated with a given name, if it exists.
+func (v GeneratorView[T]) Get(name string) (T, bool) {
+	return v.g.Get(name)
+}
+
+// Contains checks if a value exists in the enum set.
+func (v GeneratorView[T]) Contains(value T) bool {
+	return v.g.Contains(value)
+}
+
+// Parse attempts to parse a name or value literal into an enum value.
+func (v GeneratorView[T]) Parse(s string) (Value[T], error) {
+	return v.g.Parse(s)
+}
+
+// Values returns a copy of all enum entries.
+func (v GeneratorView[T]) Values() []Value[T] {
+	return v.g.Values()
+}
+
+// Names returns a slice of all enum names.
+func (v GeneratorView[T]) Names() []string {
+	return v.g.Names()
+}
+
+// Len returns the number of enum entries.
+func (v GeneratorView[T]) Len() int {
+	return v.g.Len()
+}
+
+// MarshalJSON implements json.Marshaler, serializing the value-to-name map.
+func (v GeneratorView[T]) MarshalJSON() ([]byte, error) {
+	return v.g.MarshalJSON()
+}
@@ -0,0 +1,69 @@
+package enum
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// Compile-time assertion that the view can be serialized.
+var _ json.Marshaler = GeneratorView[int]{}
+
+func TestGeneratorView(t *testing.T) {
+	g := NewNumeric(1)
+	v := g.View()
+	g.Next("One")
+
+	t.Run("Stays current", func(t *testing.T) {
+		if name, ok := v.Name(1); !ok || name != "One" {
+			t.Errorf("Expected One for 1, got %q", name)
+		}
+		g.Next("Two")
+		if v.Len() != 2 || !v.Contains(2) {
+			t.Errorf("Expected view to see new entry, got Len()=%d", v.Len())
+		}
+	})
+
+	t.Run("Lookups", func(t *testing.T) {
+		if val, ok := v.Get("Two"); !ok || val != 2 {
+			t.Errorf("Expected 2 for Two, got %d", val)
+		}
+		if p, err := v.Parse("1"); err != nil || p.String() != "One" {
+			t.Errorf("Expected One, got %q, err: %v", p.String(), err)
+		}
+		if !reflect.DeepEqual(v.Names(), []string{"One", "Two"}) {
+			t.Errorf("Unexpected names %v", v.Names())
+		}
+		if len(v.Values()) != 2 {
+			t.Errorf("Expected 2 values, got %d", len(v.Values()))
+		}
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		if string(b) != `{"1":"One","2":"Two"}` {
+			t.Errorf("Unexpected JSON %s", b)
+		}
+	})
+}
+
+func TestGeneratorView_NoMutators(t *testing.T) {
+	allowed := []string{"Contains", "Get", "Len", "MarshalJSON", "Name", "Names", "Parse", "Values"}
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(GeneratorView[int]{}),
+		reflect.TypeOf(&GeneratorView[int]{}),
+	} {
+		var methods []string
+		for i := 0; i < typ.NumMethod(); i++ {
+			methods = append(methods, typ.Method(i).Name)
+		}
+		sort.Strings(methods)
+		if !reflect.DeepEqual(methods, allowed) {
+			t.Errorf("%v exposes %v, expected only %v", typ, methods, allowed)
+		}
+	}
+}